@@ -0,0 +1,21 @@
+package main
+
+import (
+	"flag"
+	"loan_service/internal/clients/asrleasingstub"
+	"log"
+	"net/http"
+)
+
+// asr_leasing_stub serves the ASR Leasing API from memory. Point
+// clients.asr_leasing.base_url at it to run the service without ASR Leasing.
+func main() {
+	addr := flag.String("addr", ":8081", "address to listen on")
+	token := flag.String("token", "", "bearer token to require, any if empty")
+	flag.Parse()
+
+	log.Printf("ASR Leasing stub listening on %s", *addr)
+	if err := http.ListenAndServe(*addr, asrleasingstub.New(*token)); err != nil {
+		log.Fatalf("Failed to serve: %s", err)
+	}
+}
@@ -1,16 +1,22 @@
 package main
 
 import (
+	"context"
 	"loan_service/configs"
+	"loan_service/internal/catalog"
 	"loan_service/internal/clients"
+	"loan_service/internal/exchange"
 	"loan_service/internal/handler"
 	"loan_service/internal/platform/database"
 	messagebroker "loan_service/internal/platform/message_broker"
 	loanpb "loan_service/internal/proto/loan"
 	"loan_service/internal/repository"
 	"loan_service/internal/usecase"
+	"loan_service/internal/worker"
+	"loan_service/pkg/money"
 	"log"
 	"net"
+	"strings"
 
 	"google.golang.org/grpc"
 )
@@ -21,6 +27,18 @@ func main() {
 		log.Fatalf("Failed to load config: %s", err)
 	}
 
+	// Viper lowercases map keys, so currency codes are upper-cased back.
+	for code, currencyCfg := range cfg.Currencies {
+		err := money.Register(money.Currency{
+			Code:       strings.ToUpper(code),
+			MinorUnits: currencyCfg.MinorUnits,
+			Rounding:   money.RoundingMode(currencyCfg.Rounding),
+		})
+		if err != nil {
+			log.Fatalf("Invalid currency config: %s", err)
+		}
+	}
+
 	dbPool, err := database.NewPostgresConnection(cfg.Database)
 	if err != nil {
 		log.Fatalf("DB connection failed: %s", err)
@@ -45,7 +63,72 @@ func main() {
 		log.Fatalf("Failed to instantiate ASR LEASING client: %s", err)
 	}
 
-	loanUC := usecase.New(queries, asrLeasingClient, koinotAutoClient)
+	paymentServiceClient, err := clients.NewPaymentServiceClient(cfg.Clients.PaymentService)
+	if err != nil {
+		log.Fatalf("Failed to instantiate payment service client: %s", err)
+	}
+	defer paymentServiceClient.Close()
+
+	vehicleCatalog, err := catalog.NewCache(koinotAutoClient, cfg.VehicleCatalog)
+	if err != nil {
+		log.Fatalf("Failed to instantiate vehicle catalog: %s", err)
+	}
+
+	loanUC, err := usecase.New(dbPool, queries, asrLeasingClient, koinotAutoClient, paymentServiceClient, exchange.NewDBProvider(queries), vehicleCatalog, cfg.Payments, cfg.Penalties, cfg.Payoff, cfg.VehicleHold, cfg.Applications)
+	if err != nil {
+		log.Fatalf("Failed to instantiate loan usecase: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	overdueWorker, err := worker.NewOverdueWorker(loanUC, cfg.Workers.Overdue)
+	if err != nil {
+		log.Fatalf("Failed to instantiate overdue worker: %s", err)
+	}
+	go overdueWorker.Run(ctx)
+
+	reconciliationWorker, err := worker.NewReconciliationWorker(loanUC, cfg.Workers.Reconciliation)
+	if err != nil {
+		log.Fatalf("Failed to instantiate reconciliation worker: %s", err)
+	}
+	go reconciliationWorker.Run(ctx)
+
+	vehicleHoldExpirer, err := worker.NewVehicleHoldExpirer(loanUC, cfg.Workers.VehicleHoldExpirer)
+	if err != nil {
+		log.Fatalf("Failed to instantiate vehicle hold expirer: %s", err)
+	}
+	go vehicleHoldExpirer.Run(ctx)
+
+	eventPublisher, err := messagebroker.NewPublisher(rabbitMQConn, cfg.RabbitMQ)
+	if err != nil {
+		log.Fatalf("Failed to instantiate event publisher: %s", err)
+	}
+	defer eventPublisher.Close()
+
+	outboxRelay, err := worker.NewOutboxRelay(loanUC, eventPublisher, cfg.Workers.OutboxRelay)
+	if err != nil {
+		log.Fatalf("Failed to instantiate outbox relay: %s", err)
+	}
+	go outboxRelay.Run(ctx)
+
+	dealerNotifier, err := worker.NewDealerNotifier(loanUC, cfg.Workers.DealerNotifier)
+	if err != nil {
+		log.Fatalf("Failed to instantiate dealer notifier: %s", err)
+	}
+	go dealerNotifier.Run(ctx)
+
+	paymentConfirmedConsumer, err := messagebroker.NewConsumer(rabbitMQConn, cfg.RabbitMQ.PaymentConfirmed, messagebroker.NewPaymentConfirmedHandler(loanUC))
+	if err != nil {
+		log.Fatalf("Failed to instantiate payment confirmation consumer: %s", err)
+	}
+	go paymentConfirmedConsumer.Run(ctx)
+
+	paymentFailedConsumer, err := messagebroker.NewConsumer(rabbitMQConn, cfg.RabbitMQ.PaymentFailed, messagebroker.NewPaymentFailedHandler(loanUC))
+	if err != nil {
+		log.Fatalf("Failed to instantiate payment failure consumer: %s", err)
+	}
+	go paymentFailedConsumer.Run(ctx)
 
 	loanHandler := handler.New(loanUC)
 
@@ -54,7 +137,7 @@ func main() {
 		log.Fatalf("Failed to listen: %s", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(handler.CorrelationInterceptor))
 
 	loanpb.RegisterLoansServiceServer(grpcServer, loanHandler)
 
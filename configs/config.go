@@ -7,10 +7,18 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"database"`
-	RabbitMQ RabbitMQConfig `mapstructure:"rabbitmq"`
-	Clients  ClientsConfig  `mapstructure:"clients"`
+	Server         ServerConfig              `mapstructure:"server"`
+	Database       DatabaseConfig            `mapstructure:"database"`
+	RabbitMQ       RabbitMQConfig            `mapstructure:"rabbitmq"`
+	Clients        ClientsConfig             `mapstructure:"clients"`
+	Workers        WorkersConfig             `mapstructure:"workers"`
+	Payments       PaymentsConfig            `mapstructure:"payments"`
+	Penalties      PenaltiesConfig           `mapstructure:"penalties"`
+	Payoff         PayoffConfig              `mapstructure:"payoff"`
+	Currencies     map[string]CurrencyConfig `mapstructure:"currencies"`
+	VehicleCatalog VehicleCatalogConfig      `mapstructure:"vehicle_catalog"`
+	VehicleHold    VehicleHoldConfig         `mapstructure:"vehicle_hold"`
+	Applications   ApplicationsConfig        `mapstructure:"applications"`
 }
 
 type ServerConfig struct {
@@ -27,10 +35,28 @@ type DatabaseConfig struct {
 }
 
 type RabbitMQConfig struct {
-	Host     string `mapstructure:"host"`
-	Port     string `mapstructure:"port"`
-	User     string `mapstructure:"user"`
-	Password string `mapstructure:"password"`
+	Host       string   `mapstructure:"host"`
+	Port       string   `mapstructure:"port"`
+	User       string   `mapstructure:"user"`
+	Password   string   `mapstructure:"password"`
+	Exchange   string   `mapstructure:"exchange"`
+	QueueNames []string `mapstructure:"queue_names"`
+
+	PaymentConfirmed ConsumerConfig `mapstructure:"payment_confirmed"`
+	PaymentFailed    ConsumerConfig `mapstructure:"payment_failed"`
+}
+
+// ConsumerConfig describes a queue the service consumes. Exchange and
+// RoutingKey are optional; when set, the queue is bound to the exchange.
+// Messages that can never be processed are dead-lettered to DeadLetterQueue,
+// others are requeued after RetryDelay.
+type ConsumerConfig struct {
+	Queue           string `mapstructure:"queue"`
+	DeadLetterQueue string `mapstructure:"dead_letter_queue"`
+	Exchange        string `mapstructure:"exchange"`
+	RoutingKey      string `mapstructure:"routing_key"`
+	Prefetch        int    `mapstructure:"prefetch"`
+	RetryDelay      string `mapstructure:"retry_delay"`
 }
 
 type ClientsConfig struct {
@@ -46,7 +72,97 @@ type HTTPClientConfig struct {
 }
 
 type GRPCClientConfig struct {
+	Host     string `mapstructure:"host"`
 	GRPCPort string `mapstructure:"grpc_port"`
+	Timeout  string `mapstructure:"timeout"`
+}
+
+type WorkersConfig struct {
+	Overdue            WorkerConfig         `mapstructure:"overdue"`
+	OutboxRelay        OutboxRelayConfig    `mapstructure:"outbox_relay"`
+	Reconciliation     ReconciliationConfig `mapstructure:"reconciliation"`
+	DealerNotifier     DealerNotifierConfig `mapstructure:"dealer_notifier"`
+	VehicleHoldExpirer WorkerConfig         `mapstructure:"vehicle_hold_expirer"`
+}
+
+type WorkerConfig struct {
+	Interval string `mapstructure:"interval"`
+}
+
+type OutboxRelayConfig struct {
+	Interval      string `mapstructure:"interval"`
+	BatchSize     int32  `mapstructure:"batch_size"`
+	RetryDelay    string `mapstructure:"retry_delay"`
+	MaxRetryDelay string `mapstructure:"max_retry_delay"`
+}
+
+// DealerNotifierConfig sets how applications are delivered to the Koinot Auto
+// dealer. A delivery that fails MaxAttempts times is given up on as FAILED.
+type DealerNotifierConfig struct {
+	Interval      string `mapstructure:"interval"`
+	BatchSize     int32  `mapstructure:"batch_size"`
+	RetryDelay    string `mapstructure:"retry_delay"`
+	MaxRetryDelay string `mapstructure:"max_retry_delay"`
+	MaxAttempts   int32  `mapstructure:"max_attempts"`
+}
+
+// ReconciliationConfig sets how often loans are compared with ASR Leasing.
+// With AutoCorrect on, a balance that differs from ASR Leasing's by at most
+// Tolerance (in major units of the loan currency) is overwritten with it.
+type ReconciliationConfig struct {
+	Interval    string  `mapstructure:"interval"`
+	BatchSize   int32   `mapstructure:"batch_size"`
+	AutoCorrect bool    `mapstructure:"auto_correct"`
+	Tolerance   float64 `mapstructure:"tolerance"`
+}
+
+type PaymentsConfig struct {
+	AllocationOrder []string `mapstructure:"allocation_order"`
+}
+
+type PenaltiesConfig struct {
+	FixedFee       int64   `mapstructure:"fixed_fee"`
+	DailyRate      float64 `mapstructure:"daily_rate"`
+	CapRate        float64 `mapstructure:"cap_rate"`
+	CharityAccount string  `mapstructure:"charity_account"`
+}
+
+// PayoffConfig sets payoff quotes and early repayment. IbraPercent is the
+// share, in percent, of the markup on the cost prepaid that a MURABAHA or
+// IJARA loan is rebated on a prepayment or a payoff.
+type PayoffConfig struct {
+	ValidityDays      int     `mapstructure:"validity_days"`
+	SettlementFeeRate float64 `mapstructure:"settlement_fee_rate"`
+	IbraPercent       float64 `mapstructure:"ibra_percent"`
+}
+
+// VehicleCatalogConfig sets how long the Koinot Auto vehicle catalog is cached.
+// A catalog older than TTL is refreshed in the background and still served
+// for up to StaleTTL more.
+type VehicleCatalogConfig struct {
+	TTL      string `mapstructure:"ttl"`
+	StaleTTL string `mapstructure:"stale_ttl"`
+}
+
+// VehicleHoldConfig sets how long Koinot Auto holds a vehicle for an AUTO
+// application under review before the hold lapses.
+type VehicleHoldConfig struct {
+	TTL string `mapstructure:"ttl"`
+}
+
+// ApplicationsConfig sets how the figures of a new application are checked
+// against the service's own calculation. Net price and monthly payment may
+// differ from it by at most Tolerance, in major units of the loan currency.
+// Rates and limits come from the loan product of the application.
+type ApplicationsConfig struct {
+	Tolerance float64 `mapstructure:"tolerance"`
+}
+
+// CurrencyConfig sets how amounts in a currency are rounded. Rounding is
+// HALF_UP or HALF_EVEN.
+type CurrencyConfig struct {
+	MinorUnits int    `mapstructure:"minor_units"`
+	Rounding   string `mapstructure:"rounding"`
 }
 
 func LoadConfig(path string) (Config, error) {
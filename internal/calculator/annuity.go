@@ -0,0 +1,53 @@
+package calculator
+
+import (
+	"loan_service/internal/dto"
+	"loan_service/pkg/money"
+	"math"
+	"time"
+)
+
+// annuity repays the loan in equal installments; the margin is charged
+// monthly on the declining balance, so the principal share grows over time.
+type annuity struct{}
+
+func (annuity) Schedule(net money.Money, termMonths int32, marginRate float64, start time.Time) []dto.RepaymentInstallment {
+	if termMonths <= 0 {
+		return nil
+	}
+
+	currency := money.Lookup(net.Currency)
+	rate := monthlyRate(marginRate)
+
+	var monthly money.Amount
+	if rate == 0 {
+		monthly = currency.Round(float64(net.Amount) / float64(termMonths))
+	} else {
+		monthly = currency.Round(float64(net.Amount) * rate / (1 - math.Pow(1+rate, -float64(termMonths))))
+	}
+
+	schedule := make([]dto.RepaymentInstallment, termMonths)
+	balance := net.Amount
+	for i := int32(1); i <= termMonths; i++ {
+		margin := currency.Round(float64(balance) * rate)
+		principal := monthly - margin
+
+		// The last installment clears whatever balance rounding has left.
+		if i == termMonths || principal > balance {
+			principal = balance
+		}
+		balance -= principal
+
+		schedule[i-1] = dto.RepaymentInstallment{
+			Number:             i,
+			DueDate:            AddMonths(start, int(i)),
+			CurrencyCode:       net.Currency,
+			Payment:            principal + margin,
+			Principal:          principal,
+			Margin:             margin,
+			OutstandingBalance: balance,
+		}
+	}
+
+	return schedule
+}
@@ -0,0 +1,108 @@
+package calculator
+
+import (
+	"errors"
+	"fmt"
+	"loan_service/internal/dto"
+	"loan_service/pkg/money"
+	"sync"
+	"time"
+)
+
+var ErrUnknownMethod = errors.New("unknown repayment method")
+
+type Method string
+
+const (
+	MethodFlat           Method = "FLAT"
+	MethodAnnuity        Method = "ANNUITY"
+	MethodDifferentiated Method = "DIFFERENTIATED"
+
+	// Sharia-compliant contract modes. Their margin rate is a markup or a
+	// rental rate rather than interest.
+	MethodMurabaha Method = "MURABAHA"
+	MethodIjara    Method = "IJARA"
+)
+
+// Calculator builds the repayment schedule for financing net over termMonths
+// at an annual marginRate given in percent. The first installment is due one
+// month after start. Amounts are rounded with the rules of net's currency and
+// the principal parts always add up to net exactly.
+type Calculator interface {
+	Schedule(net money.Money, termMonths int32, marginRate float64, start time.Time) []dto.RepaymentInstallment
+}
+
+var (
+	mu          sync.RWMutex
+	calculators = map[Method]Calculator{
+		MethodFlat:           flat{},
+		MethodAnnuity:        annuity{},
+		MethodDifferentiated: differentiated{},
+		MethodMurabaha:       murabaha{},
+		MethodIjara:          ijara{},
+	}
+)
+
+// Register adds a calculator for a repayment method or replaces the existing one.
+func Register(method Method, calculator Calculator) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	calculators[method] = calculator
+}
+
+// ForMethod returns the calculator registered for method. An empty method
+// selects FLAT, the only formula the service supported originally.
+func ForMethod(method string) (Calculator, error) {
+	if method == "" {
+		method = string(MethodFlat)
+	}
+
+	mu.RLock()
+	defer mu.RUnlock()
+
+	calculator, ok := calculators[Method(method)]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownMethod, method)
+	}
+
+	return calculator, nil
+}
+
+// ShariaCompliant reports whether method is an Islamic contract mode. Such
+// contracts earn nothing from late payment: their late charges are given to
+// charity.
+func ShariaCompliant(method string) bool {
+	return Method(method) == MethodMurabaha || Method(method) == MethodIjara
+}
+
+// Quote summarises a schedule as the first monthly installment and the total
+// amount payable over the term.
+func Quote(schedule []dto.RepaymentInstallment) (money.Amount, money.Amount) {
+	if len(schedule) == 0 {
+		return 0, 0
+	}
+
+	var total money.Amount
+	for _, installment := range schedule {
+		total += installment.Payment
+	}
+
+	return schedule[0].Payment, total
+}
+
+func monthlyRate(marginRate float64) float64 {
+	return marginRate / 100 / 12
+}
+
+// cumulativeShare is the part of amount repaid by the end of installment i of
+// termMonths when it is spread evenly. Differences of consecutive shares are
+// on the currency's grid, never negative for a non-negative amount, and add
+// up to amount exactly.
+func cumulativeShare(currency money.Currency, amount money.Amount, i, termMonths int32) money.Amount {
+	if i == termMonths {
+		return amount
+	}
+
+	return currency.Round(float64(amount) * float64(i) / float64(termMonths))
+}
@@ -0,0 +1,243 @@
+package calculator
+
+import (
+	"errors"
+	"fmt"
+	"loan_service/internal/dto"
+	"loan_service/pkg/money"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+var methods = []Method{MethodFlat, MethodAnnuity, MethodDifferentiated, MethodMurabaha, MethodIjara}
+
+// TestScheduleInvariants checks what every schedule owes its callers: the
+// principal parts add up to net, each payment is its principal plus its
+// margin, nothing is negative or off the currency's grid, the outstanding
+// balance runs down to zero and installments fall due a month apart.
+func TestScheduleInvariants(t *testing.T) {
+	tests := []struct {
+		net        money.Money
+		termMonths int32
+		marginRate float64
+	}{
+		{money.New(20000000, "TJS"), 36, 18},
+		{money.New(20000000, "TJS"), 36, 0},
+		{money.New(20000000, "TJS"), 1, 18},
+		{money.New(12345679, "USD"), 60, 12.75},
+		{money.New(100, "TJS"), 60, 24},
+		{money.New(7, "TJS"), 12, 18},
+		{money.New(150000000, "JPY"), 24, 9.5},
+		{money.New(99999999999, "TJS"), 84, 35},
+	}
+
+	for _, method := range methods {
+		for _, tt := range tests {
+			name := fmt.Sprintf("%s/%s/%dm/%g%%", method, tt.net, tt.termMonths, tt.marginRate)
+			t.Run(name, func(t *testing.T) {
+				calculator, err := ForMethod(string(method))
+				if err != nil {
+					t.Fatalf("ForMethod: %v", err)
+				}
+
+				schedule := calculator.Schedule(tt.net, tt.termMonths, tt.marginRate, start)
+				checkSchedule(t, schedule, tt.net, tt.termMonths)
+			})
+		}
+	}
+}
+
+func checkSchedule(t *testing.T, schedule []dto.RepaymentInstallment, net money.Money, termMonths int32) {
+	t.Helper()
+
+	if len(schedule) != int(termMonths) {
+		t.Fatalf("%d installments, want %d", len(schedule), termMonths)
+	}
+
+	currency := money.Lookup(net.Currency)
+	onGrid := func(a money.Amount) bool { return currency.Round(float64(a)) == a }
+
+	var principal money.Amount
+	outstanding := net.Amount
+	for i, installment := range schedule {
+		if installment.Number != int32(i+1) {
+			t.Errorf("installment %d has number %d", i+1, installment.Number)
+		}
+		if want := AddMonths(start, i+1); !installment.DueDate.Equal(want) {
+			t.Errorf("installment %d due %s, want %s", i+1, installment.DueDate.Format(time.DateOnly), want.Format(time.DateOnly))
+		}
+		if installment.CurrencyCode != net.Currency {
+			t.Errorf("installment %d in %s, want %s", i+1, installment.CurrencyCode, net.Currency)
+		}
+		if installment.Principal < 0 || installment.Margin < 0 || installment.Payment < 0 {
+			t.Errorf("installment %d has a negative component: %+v", i+1, installment)
+		}
+		if installment.Payment != installment.Principal+installment.Margin {
+			t.Errorf("installment %d: payment %s != principal %s + margin %s", i+1, installment.Payment, installment.Principal, installment.Margin)
+		}
+		if !onGrid(installment.Principal) || !onGrid(installment.Margin) {
+			t.Errorf("installment %d is off the %s grid: %+v", i+1, net.Currency, installment)
+		}
+
+		principal += installment.Principal
+		outstanding -= installment.Principal
+		if installment.OutstandingBalance != outstanding {
+			t.Errorf("installment %d: outstanding balance %s, want %s", i+1, installment.OutstandingBalance, outstanding)
+		}
+	}
+
+	if principal != net.Amount {
+		t.Errorf("principal adds up to %s, want %s", principal, net.Amount)
+	}
+	if last := schedule[len(schedule)-1].OutstandingBalance; last != 0 {
+		t.Errorf("final outstanding balance %s, want 0", last)
+	}
+}
+
+func TestScheduleEmptyTerm(t *testing.T) {
+	for _, method := range methods {
+		calculator, err := ForMethod(string(method))
+		if err != nil {
+			t.Fatalf("ForMethod(%s): %v", method, err)
+		}
+
+		if schedule := calculator.Schedule(money.New(20000000, "TJS"), 0, 18, start); len(schedule) != 0 {
+			t.Errorf("%s: %d installments for a term of 0 months, want none", method, len(schedule))
+		}
+	}
+}
+
+func TestFlatSchedule(t *testing.T) {
+	schedule := flat{}.Schedule(money.New(1200000, "TJS"), 12, 10, start)
+
+	// 12000.00 at 10% for a year carries 1200.00 of margin, 100.00 a month.
+	for _, installment := range schedule {
+		if installment.Principal != 100000 || installment.Margin != 10000 {
+			t.Errorf("installment %d = %s + %s, want 1000.00 + 100.00", installment.Number, installment.Principal, installment.Margin)
+		}
+	}
+}
+
+func TestAnnuitySchedule(t *testing.T) {
+	schedule := annuity{}.Schedule(money.New(20000000, "TJS"), 36, 18, start)
+
+	monthly, total := Quote(schedule)
+	if monthly != 723048 {
+		t.Errorf("monthly payment %s, want 7230.48", monthly)
+	}
+
+	// All but the last installment are equal; the last clears the rounding.
+	for _, installment := range schedule[:len(schedule)-1] {
+		if installment.Payment != monthly {
+			t.Errorf("installment %d pays %s, want %s", installment.Number, installment.Payment, monthly)
+		}
+	}
+	if diff := total - monthly*36; diff < -36 || diff > 36 {
+		t.Errorf("total %s is %s away from 36 equal payments", total, diff)
+	}
+
+	// The margin is charged on the declining balance.
+	for i := 1; i < len(schedule); i++ {
+		if schedule[i].Margin > schedule[i-1].Margin {
+			t.Errorf("margin of installment %d (%s) exceeds the previous one (%s)", i+1, schedule[i].Margin, schedule[i-1].Margin)
+		}
+	}
+}
+
+func TestDifferentiatedSchedule(t *testing.T) {
+	schedule := differentiated{}.Schedule(money.New(1200000, "TJS"), 12, 12, start)
+
+	for i, installment := range schedule {
+		if installment.Principal != 100000 {
+			t.Errorf("installment %d repays %s, want 1000.00", i+1, installment.Principal)
+		}
+		if i > 0 && installment.Payment > schedule[i-1].Payment {
+			t.Errorf("installment %d (%s) exceeds the previous one (%s)", i+1, installment.Payment, schedule[i-1].Payment)
+		}
+	}
+
+	if schedule[0].Margin != 12000 {
+		t.Errorf("first margin %s, want 120.00", schedule[0].Margin)
+	}
+}
+
+func TestShariaSchedules(t *testing.T) {
+	net := money.New(20000000, "TJS")
+
+	murabahaSchedule := murabaha{}.Schedule(net, 36, 18, start)
+	flatSchedule := flat{}.Schedule(net, 36, 18, start)
+	for i := range flatSchedule {
+		if murabahaSchedule[i] != flatSchedule[i] {
+			t.Errorf("MURABAHA installment %d = %+v, want the FLAT one %+v", i+1, murabahaSchedule[i], flatSchedule[i])
+		}
+	}
+
+	ijaraSchedule := ijara{}.Schedule(net, 36, 18, start)
+	annuitySchedule := annuity{}.Schedule(net, 36, 18, start)
+	for i := range annuitySchedule {
+		rental := ijaraSchedule[i]
+		if rental.OwnershipTransfer != (i == len(annuitySchedule)-1) {
+			t.Errorf("IJARA rental %d: ownership transfer %t", i+1, rental.OwnershipTransfer)
+		}
+		rental.OwnershipTransfer = false
+		if rental != annuitySchedule[i] {
+			t.Errorf("IJARA rental %d = %+v, want the ANNUITY one %+v", i+1, rental, annuitySchedule[i])
+		}
+	}
+}
+
+func TestForMethod(t *testing.T) {
+	calculator, err := ForMethod("")
+	if err != nil {
+		t.Fatalf("ForMethod(\"\"): %v", err)
+	}
+	if _, ok := calculator.(flat); !ok {
+		t.Errorf("ForMethod(\"\") = %T, want flat", calculator)
+	}
+
+	if _, err := ForMethod("BALLOON"); !errors.Is(err, ErrUnknownMethod) {
+		t.Errorf("ForMethod(BALLOON) error = %v, want %v", err, ErrUnknownMethod)
+	}
+}
+
+func TestShariaCompliant(t *testing.T) {
+	for _, method := range methods {
+		want := method == MethodMurabaha || method == MethodIjara
+		if got := ShariaCompliant(string(method)); got != want {
+			t.Errorf("ShariaCompliant(%s) = %t, want %t", method, got, want)
+		}
+	}
+}
+
+func TestQuote(t *testing.T) {
+	if monthly, total := Quote(nil); monthly != 0 || total != 0 {
+		t.Errorf("Quote(nil) = %s, %s, want 0, 0", monthly, total)
+	}
+
+	schedule := []dto.RepaymentInstallment{{Payment: 1000}, {Payment: 900}, {Payment: 800}}
+	if monthly, total := Quote(schedule); monthly != 1000 || total != 2700 {
+		t.Errorf("Quote = %s, %s, want 10.00, 27.00", monthly, total)
+	}
+}
+
+func TestAddMonths(t *testing.T) {
+	tests := []struct {
+		from time.Time
+		n    int
+		want time.Time
+	}{
+		{time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC), 1, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC), 1, time.Date(2023, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC), 3, time.Date(2024, time.April, 30, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, time.November, 15, 9, 30, 0, 0, time.UTC), 2, time.Date(2025, time.January, 15, 9, 30, 0, 0, time.UTC)},
+		{time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC), 12, time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		if got := AddMonths(tt.from, tt.n); !got.Equal(tt.want) {
+			t.Errorf("AddMonths(%s, %d) = %s, want %s", tt.from.Format(time.DateOnly), tt.n, got.Format(time.DateOnly), tt.want.Format(time.DateOnly))
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package calculator
+
+import "time"
+
+// AddMonths adds n calendar months to t, clamping the day to the end of the
+// target month (Jan 31 + 1 month = Feb 28/29) instead of overflowing into the
+// next one like time.AddDate does.
+func AddMonths(t time.Time, n int) time.Time {
+	year, month, day := t.Date()
+	firstOfTarget := time.Date(year, month+time.Month(n), 1, 0, 0, 0, 0, t.Location())
+	lastDay := firstOfTarget.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	hour, minute, sec := t.Clock()
+	return time.Date(firstOfTarget.Year(), firstOfTarget.Month(), day, hour, minute, sec, t.Nanosecond(), t.Location())
+}
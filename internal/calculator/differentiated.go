@@ -0,0 +1,43 @@
+package calculator
+
+import (
+	"loan_service/internal/dto"
+	"loan_service/pkg/money"
+	"time"
+)
+
+// differentiated repays an equal share of principal every month plus the
+// margin on the remaining balance, so installments decrease over time.
+type differentiated struct{}
+
+func (differentiated) Schedule(net money.Money, termMonths int32, marginRate float64, start time.Time) []dto.RepaymentInstallment {
+	if termMonths <= 0 {
+		return nil
+	}
+
+	currency := money.Lookup(net.Currency)
+	rate := monthlyRate(marginRate)
+
+	schedule := make([]dto.RepaymentInstallment, termMonths)
+	balance := net.Amount
+	var paidPrincipal money.Amount
+	for i := int32(1); i <= termMonths; i++ {
+		principal := cumulativeShare(currency, net.Amount, i, termMonths) - paidPrincipal
+		margin := currency.Round(float64(balance) * rate)
+
+		paidPrincipal += principal
+		balance -= principal
+
+		schedule[i-1] = dto.RepaymentInstallment{
+			Number:             i,
+			DueDate:            AddMonths(start, int(i)),
+			CurrencyCode:       net.Currency,
+			Payment:            principal + margin,
+			Principal:          principal,
+			Margin:             margin,
+			OutstandingBalance: balance,
+		}
+	}
+
+	return schedule
+}
@@ -0,0 +1,46 @@
+package calculator
+
+import (
+	"loan_service/internal/dto"
+	"loan_service/pkg/money"
+	"time"
+)
+
+// flat charges the margin on the full net amount for the whole term
+// (net × rate × years) and spreads principal and margin evenly over the
+// installments, so they are equal up to rounding. Each installment's margin
+// is its own share of the total margin, never a remainder, so it is never
+// negative.
+type flat struct{}
+
+func (flat) Schedule(net money.Money, termMonths int32, marginRate float64, start time.Time) []dto.RepaymentInstallment {
+	if termMonths <= 0 {
+		return nil
+	}
+
+	currency := money.Lookup(net.Currency)
+	years := float64(termMonths) / 12
+	margin := currency.Round(float64(net.Amount) * marginRate / 100 * years)
+
+	schedule := make([]dto.RepaymentInstallment, termMonths)
+	var paidPrincipal, paidMargin money.Amount
+	for i := int32(1); i <= termMonths; i++ {
+		principal := cumulativeShare(currency, net.Amount, i, termMonths) - paidPrincipal
+		installmentMargin := cumulativeShare(currency, margin, i, termMonths) - paidMargin
+
+		paidPrincipal += principal
+		paidMargin += installmentMargin
+
+		schedule[i-1] = dto.RepaymentInstallment{
+			Number:             i,
+			DueDate:            AddMonths(start, int(i)),
+			CurrencyCode:       net.Currency,
+			Payment:            principal + installmentMargin,
+			Principal:          principal,
+			Margin:             installmentMargin,
+			OutstandingBalance: net.Amount - paidPrincipal,
+		}
+	}
+
+	return schedule
+}
@@ -0,0 +1,23 @@
+package calculator
+
+import (
+	"loan_service/internal/dto"
+	"loan_service/pkg/money"
+	"time"
+)
+
+// ijara leases the asset, which stays the financier's, for equal monthly
+// rentals. Each rental recovers part of the asset's cost (Principal) and
+// carries the rental profit on the cost still unrecovered (Margin); the
+// rentals are set like ANNUITY installments. Ownership passes to the customer
+// with the last rental.
+type ijara struct{}
+
+func (ijara) Schedule(net money.Money, termMonths int32, marginRate float64, start time.Time) []dto.RepaymentInstallment {
+	schedule := annuity{}.Schedule(net, termMonths, marginRate, start)
+	if len(schedule) > 0 {
+		schedule[len(schedule)-1].OwnershipTransfer = true
+	}
+
+	return schedule
+}
@@ -0,0 +1,19 @@
+package calculator
+
+import (
+	"loan_service/internal/dto"
+	"loan_service/pkg/money"
+	"time"
+)
+
+// murabaha sells the asset to the customer at its cost plus a markup agreed at
+// the sale (cost × rate × years) and collects the sale price in equal
+// installments. The markup is fixed once the contract is signed: it is not
+// charged on the outstanding balance and never compounds, so the arithmetic is
+// that of FLAT: each installment repays an equal share of the cost and an
+// equal share of the markup.
+type murabaha struct{}
+
+func (murabaha) Schedule(net money.Money, termMonths int32, marginRate float64, start time.Time) []dto.RepaymentInstallment {
+	return flat{}.Schedule(net, termMonths, marginRate, start)
+}
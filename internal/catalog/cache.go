@@ -0,0 +1,121 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"loan_service/configs"
+	"loan_service/internal/dto"
+	"log"
+	"sync"
+	"time"
+)
+
+// VehicleSource loads the whole vehicle catalog.
+type VehicleSource interface {
+	ListVehicles(ctx context.Context) ([]dto.Vehicle, error)
+}
+
+// Cache keeps the vehicle catalog in memory. A catalog younger than ttl is
+// served as is. Up to staleTTL after that it is still served, while a refresh
+// runs in the background; an older one is refreshed before it is returned.
+// Only one refresh runs at a time. When a refresh fails, the last catalog
+// loaded keeps being served.
+type Cache struct {
+	source   VehicleSource
+	ttl      time.Duration
+	staleTTL time.Duration
+
+	mu       sync.Mutex
+	vehicles []dto.Vehicle
+	loadedAt time.Time
+	loadErr  error
+	loading  chan struct{} // closed when the refresh in progress is done
+}
+
+func NewCache(source VehicleSource, cfg configs.VehicleCatalogConfig) (*Cache, error) {
+	ttl, err := time.ParseDuration(cfg.TTL)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid ttl format for vehicle catalog: %w", err)
+	}
+
+	staleTTL, err := time.ParseDuration(cfg.StaleTTL)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid stale ttl format for vehicle catalog: %w", err)
+	}
+
+	return &Cache{
+		source:   source,
+		ttl:      ttl,
+		staleTTL: staleTTL,
+	}, nil
+}
+
+// Vehicles returns the catalog. The returned slice is shared and must not be
+// modified.
+func (c *Cache) Vehicles(ctx context.Context) ([]dto.Vehicle, error) {
+	c.mu.Lock()
+	if c.vehicles != nil {
+		age := time.Since(c.loadedAt)
+		if age < c.ttl+c.staleTTL {
+			if age >= c.ttl {
+				c.refresh(ctx)
+			}
+			vehicles := c.vehicles
+			c.mu.Unlock()
+			return vehicles, nil
+		}
+	}
+	loading := c.refresh(ctx)
+	c.mu.Unlock()
+
+	select {
+	case <-loading:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.vehicles == nil {
+		return nil, fmt.Errorf("failed to load vehicle catalog: %w", c.loadErr)
+	}
+
+	return c.vehicles, nil
+}
+
+// refresh starts loading the catalog unless a load is already in progress and
+// returns a channel closed once it is done. It must be called with mu held.
+// The load outlives ctx, so a caller giving up does not cancel it for others.
+func (c *Cache) refresh(ctx context.Context) <-chan struct{} {
+	if c.loading != nil {
+		return c.loading
+	}
+
+	loading := make(chan struct{})
+	c.loading = loading
+
+	go func() {
+		defer close(loading)
+
+		vehicles, err := c.source.ListVehicles(context.WithoutCancel(ctx))
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		c.loading = nil
+		c.loadErr = err
+		if err != nil {
+			log.Printf("Failed to refresh vehicle catalog: %s", err)
+			return
+		}
+
+		if vehicles == nil {
+			vehicles = []dto.Vehicle{}
+		}
+		c.vehicles = vehicles
+		c.loadedAt = time.Now()
+	}()
+
+	return loading
+}
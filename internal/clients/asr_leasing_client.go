@@ -1,12 +1,31 @@
 package clients
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"loan_service/configs"
+	"loan_service/internal/dto"
+	"loan_service/pkg/money"
 	"net/http"
+	"net/url"
 	"time"
 )
 
+var (
+	// ErrAsrNotFound is returned when ASR Leasing does not know the contract.
+	ErrAsrNotFound = errors.New("asr leasing: not found")
+	// ErrAsrRejected is returned when ASR Leasing refuses a request on
+	// business grounds, e.g. an application it cannot contract.
+	ErrAsrRejected = errors.New("asr leasing: rejected")
+	// ErrAsrUnavailable is returned when ASR Leasing cannot be reached or
+	// fails; the request can be retried later.
+	ErrAsrUnavailable = errors.New("asr leasing: unavailable")
+)
+
 type AsrLeasingClient struct {
 	httpClient *http.Client
 	baseURL    string
@@ -27,3 +46,129 @@ func NewAsrLeasingClient(cfg configs.HTTPClientConfig) (*AsrLeasingClient, error
 		token:   cfg.Token,
 	}, nil
 }
+
+// AsrApplication is the body of POST /applications. ExternalId is the
+// application id; ASR Leasing returns the existing contract when the same
+// application is submitted again.
+type AsrApplication struct {
+	ExternalId      string       `json:"externalId"`
+	UserId          int64        `json:"userId"`
+	VehicleVin      string       `json:"vehicleVin"`
+	CurrencyCode    string       `json:"currencyCode"`
+	Amount          money.Amount `json:"amount"`
+	TermMonths      int32        `json:"termMonths"`
+	MonthlyPayment  money.Amount `json:"monthlyPayment"`
+	MarginRate      float64      `json:"marginRate"`
+	RepaymentMethod string       `json:"repaymentMethod"`
+	TotalAmount     money.Amount `json:"totalAmount"`
+}
+
+// AsrContract is a contract as ASR Leasing reports it.
+type AsrContract struct {
+	ContractNumber   string       `json:"contractNumber"`
+	ExternalId       string       `json:"externalId"`
+	Status           string       `json:"status"`
+	CurrencyCode     string       `json:"currencyCode"`
+	RemainingBalance money.Amount `json:"remainingBalance"`
+	UpdatedAt        time.Time    `json:"updatedAt"`
+}
+
+type asrError struct {
+	Error string `json:"error"`
+}
+
+// SubmitApplication registers an approved application with ASR Leasing and
+// returns the contract it was assigned. total is the amount repayable over the
+// schedule, which becomes the contract's opening balance.
+func (c *AsrLeasingClient) SubmitApplication(ctx context.Context, loanApp *dto.LoanApplication, total money.Amount) (*dto.LeasingContract, error) {
+	body := AsrApplication{
+		ExternalId:      fmt.Sprint(loanApp.Id),
+		UserId:          loanApp.UserId,
+		VehicleVin:      loanApp.VehicleVin,
+		CurrencyCode:    loanApp.CurrencyCode,
+		Amount:          loanApp.NetPrice,
+		TermMonths:      loanApp.TermMonths,
+		MonthlyPayment:  loanApp.MonthlyPayment,
+		MarginRate:      loanApp.MarginRate,
+		RepaymentMethod: loanApp.RepaymentMethod,
+		TotalAmount:     total,
+	}
+
+	var contract AsrContract
+	if err := c.do(ctx, http.MethodPost, "/applications", body, &contract); err != nil {
+		return nil, err
+	}
+
+	return contractFromAsr(contract), nil
+}
+
+// GetContract returns the current state of a contract, including the balance
+// ASR Leasing holds for it.
+func (c *AsrLeasingClient) GetContract(ctx context.Context, contractNumber string) (*dto.LeasingContract, error) {
+	var contract AsrContract
+	if err := c.do(ctx, http.MethodGet, "/contracts/"+url.PathEscape(contractNumber), nil, &contract); err != nil {
+		return nil, err
+	}
+
+	return contractFromAsr(contract), nil
+}
+
+func (c *AsrLeasingClient) do(ctx context.Context, method, path string, body, result any) error {
+	var reqBody io.Reader
+	if body != nil {
+		jsonData, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("could not marshall request body: %w", err)
+		}
+		reqBody = bytes.NewReader(jsonData)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: failed to make request: %s", ErrAsrUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr asrError
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+
+		switch {
+		case resp.StatusCode == http.StatusNotFound:
+			return fmt.Errorf("%w: %s", ErrAsrNotFound, apiErr.Error)
+		case resp.StatusCode == http.StatusBadRequest ||
+			resp.StatusCode == http.StatusConflict ||
+			resp.StatusCode == http.StatusUnprocessableEntity:
+			return fmt.Errorf("%w: %s", ErrAsrRejected, apiErr.Error)
+		case resp.StatusCode >= 500:
+			return fmt.Errorf("%w: status %d", ErrAsrUnavailable, resp.StatusCode)
+		default:
+			return fmt.Errorf("asr leasing returned status %d: %s", resp.StatusCode, apiErr.Error)
+		}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+func contractFromAsr(contract AsrContract) *dto.LeasingContract {
+	return &dto.LeasingContract{
+		ContractNumber:   contract.ContractNumber,
+		Status:           contract.Status,
+		CurrencyCode:     contract.CurrencyCode,
+		RemainingBalance: contract.RemainingBalance,
+		UpdatedAt:        contract.UpdatedAt,
+	}
+}
@@ -0,0 +1,227 @@
+package clients_test
+
+import (
+	"context"
+	"errors"
+	"loan_service/configs"
+	"loan_service/internal/clients"
+	"loan_service/internal/clients/asrleasingstub"
+	"loan_service/internal/dto"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testToken = "test-token"
+
+func newTestClient(t *testing.T, baseURL, token string) *clients.AsrLeasingClient {
+	t.Helper()
+
+	client, err := clients.NewAsrLeasingClient(configs.HTTPClientConfig{
+		BaseURL: baseURL,
+		Token:   token,
+		Timeout: "5s",
+	})
+	if err != nil {
+		t.Fatalf("NewAsrLeasingClient: %v", err)
+	}
+
+	return client
+}
+
+func newStub(t *testing.T) (*asrleasingstub.Server, *clients.AsrLeasingClient) {
+	t.Helper()
+
+	stub := asrleasingstub.New(testToken)
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	return stub, newTestClient(t, server.URL, testToken)
+}
+
+func testApplication() *dto.LoanApplication {
+	return &dto.LoanApplication{
+		Id:              42,
+		UserId:          7,
+		VehicleVin:      "JTDBR32E720123456",
+		CurrencyCode:    "TJS",
+		NetPrice:        20000000,
+		TermMonths:      36,
+		MonthlyPayment:  664311,
+		MarginRate:      18,
+		RepaymentMethod: "ANNUITY",
+	}
+}
+
+func TestAsrSubmitApplication(t *testing.T) {
+	_, client := newStub(t)
+	ctx := context.Background()
+
+	contract, err := client.SubmitApplication(ctx, testApplication(), 23915196)
+	if err != nil {
+		t.Fatalf("SubmitApplication: %v", err)
+	}
+
+	if contract.ContractNumber != "ASR-000001" {
+		t.Errorf("ContractNumber = %q, want ASR-000001", contract.ContractNumber)
+	}
+	if contract.Status != "ACTIVE" {
+		t.Errorf("Status = %q, want ACTIVE", contract.Status)
+	}
+	if contract.CurrencyCode != "TJS" || contract.RemainingBalance != 23915196 {
+		t.Errorf("balance = %s %s, want 239151.96 TJS", contract.RemainingBalance, contract.CurrencyCode)
+	}
+
+	// Submitting the same application again returns its contract.
+	again, err := client.SubmitApplication(ctx, testApplication(), 23915196)
+	if err != nil {
+		t.Fatalf("second SubmitApplication: %v", err)
+	}
+	if again.ContractNumber != contract.ContractNumber {
+		t.Errorf("resubmitted ContractNumber = %q, want %q", again.ContractNumber, contract.ContractNumber)
+	}
+
+	other := testApplication()
+	other.Id = 43
+	second, err := client.SubmitApplication(ctx, other, 23915196)
+	if err != nil {
+		t.Fatalf("SubmitApplication of another application: %v", err)
+	}
+	if second.ContractNumber == contract.ContractNumber {
+		t.Errorf("another application got contract %q too", second.ContractNumber)
+	}
+}
+
+func TestAsrGetContract(t *testing.T) {
+	stub, client := newStub(t)
+	ctx := context.Background()
+
+	submitted, err := client.SubmitApplication(ctx, testApplication(), 23915196)
+	if err != nil {
+		t.Fatalf("SubmitApplication: %v", err)
+	}
+
+	stub.SetContract(clients.AsrContract{
+		ContractNumber:   submitted.ContractNumber,
+		ExternalId:       "42",
+		Status:           "CLOSED",
+		CurrencyCode:     "TJS",
+		RemainingBalance: 0,
+	})
+
+	contract, err := client.GetContract(ctx, submitted.ContractNumber)
+	if err != nil {
+		t.Fatalf("GetContract: %v", err)
+	}
+	if contract.Status != "CLOSED" || contract.RemainingBalance != 0 {
+		t.Errorf("contract = %+v, want CLOSED with nothing remaining", contract)
+	}
+
+	if _, err := client.GetContract(ctx, "ASR-999999"); !errors.Is(err, clients.ErrAsrNotFound) {
+		t.Errorf("GetContract of an unknown contract: error = %v, want %v", err, clients.ErrAsrNotFound)
+	}
+}
+
+func TestAsrSubmitInvalidApplication(t *testing.T) {
+	_, client := newStub(t)
+
+	// The stub answers 422 for an application without a total.
+	_, err := client.SubmitApplication(context.Background(), testApplication(), 0)
+	if !errors.Is(err, clients.ErrAsrRejected) {
+		t.Errorf("error = %v, want %v", err, clients.ErrAsrRejected)
+	}
+}
+
+func TestAsrErrorStatuses(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		want       error
+	}{
+		{http.StatusNotFound, clients.ErrAsrNotFound},
+		{http.StatusBadRequest, clients.ErrAsrRejected},
+		{http.StatusConflict, clients.ErrAsrRejected},
+		{http.StatusUnprocessableEntity, clients.ErrAsrRejected},
+		{http.StatusInternalServerError, clients.ErrAsrUnavailable},
+		{http.StatusBadGateway, clients.ErrAsrUnavailable},
+		{http.StatusServiceUnavailable, clients.ErrAsrUnavailable},
+		{http.StatusForbidden, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.statusCode), func(t *testing.T) {
+			stub, client := newStub(t)
+			stub.SetFailure(tt.statusCode)
+
+			_, err := client.GetContract(context.Background(), "ASR-000001")
+			if err == nil {
+				t.Fatal("GetContract succeeded")
+			}
+			checkAsrError(t, err, tt.want)
+
+			_, err = client.SubmitApplication(context.Background(), testApplication(), 23915196)
+			if err == nil {
+				t.Fatal("SubmitApplication succeeded")
+			}
+			checkAsrError(t, err, tt.want)
+		})
+	}
+}
+
+func TestAsrInvalidToken(t *testing.T) {
+	server := httptest.NewServer(asrleasingstub.New(testToken))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, "wrong-token")
+
+	_, err := client.GetContract(context.Background(), "ASR-000001")
+	if err == nil {
+		t.Fatal("GetContract succeeded with a wrong token")
+	}
+	checkAsrError(t, err, nil)
+}
+
+func TestAsrUnreachable(t *testing.T) {
+	server := httptest.NewServer(asrleasingstub.New(testToken))
+	server.Close()
+
+	client := newTestClient(t, server.URL, testToken)
+
+	if _, err := client.GetContract(context.Background(), "ASR-000001"); !errors.Is(err, clients.ErrAsrUnavailable) {
+		t.Errorf("error = %v, want %v", err, clients.ErrAsrUnavailable)
+	}
+}
+
+func TestAsrMalformedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("{not json"))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, testToken)
+
+	_, err := client.GetContract(context.Background(), "ASR-000001")
+	if err == nil {
+		t.Fatal("GetContract succeeded with a malformed response")
+	}
+	checkAsrError(t, err, nil)
+}
+
+// checkAsrError checks that err wraps want, or none of the ASR Leasing
+// errors if want is nil.
+func checkAsrError(t *testing.T, err, want error) {
+	t.Helper()
+
+	if want != nil {
+		if !errors.Is(err, want) {
+			t.Errorf("error = %v, want %v", err, want)
+		}
+		return
+	}
+
+	for _, asrErr := range []error{clients.ErrAsrNotFound, clients.ErrAsrRejected, clients.ErrAsrUnavailable} {
+		if errors.Is(err, asrErr) {
+			t.Errorf("error = %v, want none of the ASR Leasing errors", err)
+		}
+	}
+}
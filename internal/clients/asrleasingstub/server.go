@@ -0,0 +1,170 @@
+// Package asrleasingstub is an in-memory stand-in for the ASR Leasing HTTP
+// API, so the integration can be exercised without the real system.
+package asrleasingstub
+
+import (
+	"encoding/json"
+	"fmt"
+	"loan_service/internal/clients"
+	"loan_service/pkg/money"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Server implements POST /applications and GET /contracts/{number} like ASR
+// Leasing does, plus PATCH /contracts/{number} to change a contract's status
+// or balance the way payments and collections in ASR Leasing would.
+type Server struct {
+	token string
+
+	mu          sync.Mutex
+	contracts   map[string]*clients.AsrContract
+	byExternal  map[string]string
+	nextNumber  int
+	failureCode int
+}
+
+// New returns a stub that requires token as the bearer token, or accepts any
+// request if token is empty.
+func New(token string) *Server {
+	return &Server{
+		token:      token,
+		contracts:  map[string]*clients.AsrContract{},
+		byExternal: map[string]string{},
+		nextNumber: 1,
+	}
+}
+
+// SetFailure makes every request fail with statusCode until it is called
+// with 0.
+func (s *Server) SetFailure(statusCode int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failureCode = statusCode
+}
+
+// SetContract adds or replaces a contract.
+func (s *Server) SetContract(contract clients.AsrContract) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.contracts[contract.ContractNumber] = &contract
+	if contract.ExternalId != "" {
+		s.byExternal[contract.ExternalId] = contract.ContractNumber
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.token != "" && r.Header.Get("Authorization") != "Bearer "+s.token {
+		writeError(w, http.StatusUnauthorized, "invalid token")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.failureCode != 0 {
+		writeError(w, s.failureCode, "stub failure")
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/applications":
+		s.submitApplication(w, r)
+	case strings.HasPrefix(r.URL.Path, "/contracts/"):
+		number := strings.TrimPrefix(r.URL.Path, "/contracts/")
+		switch r.Method {
+		case http.MethodGet:
+			s.getContract(w, number)
+		case http.MethodPatch:
+			s.updateContract(w, r, number)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) submitApplication(w http.ResponseWriter, r *http.Request) {
+	var application clients.AsrApplication
+	if err := json.NewDecoder(r.Body).Decode(&application); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid body: "+err.Error())
+		return
+	}
+
+	if application.ExternalId == "" || application.CurrencyCode == "" ||
+		application.TotalAmount <= 0 || application.TermMonths <= 0 {
+		writeError(w, http.StatusUnprocessableEntity, "externalId, currencyCode, totalAmount and termMonths are required")
+		return
+	}
+
+	if number, ok := s.byExternal[application.ExternalId]; ok {
+		writeJSON(w, http.StatusOK, s.contracts[number])
+		return
+	}
+
+	contract := &clients.AsrContract{
+		ContractNumber:   fmt.Sprintf("ASR-%06d", s.nextNumber),
+		ExternalId:       application.ExternalId,
+		Status:           "ACTIVE",
+		CurrencyCode:     application.CurrencyCode,
+		RemainingBalance: application.TotalAmount,
+		UpdatedAt:        time.Now().UTC(),
+	}
+	s.nextNumber++
+	s.contracts[contract.ContractNumber] = contract
+	s.byExternal[contract.ExternalId] = contract.ContractNumber
+
+	writeJSON(w, http.StatusCreated, contract)
+}
+
+func (s *Server) getContract(w http.ResponseWriter, number string) {
+	contract, ok := s.contracts[number]
+	if !ok {
+		writeError(w, http.StatusNotFound, "contract "+number+" not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, contract)
+}
+
+func (s *Server) updateContract(w http.ResponseWriter, r *http.Request, number string) {
+	contract, ok := s.contracts[number]
+	if !ok {
+		writeError(w, http.StatusNotFound, "contract "+number+" not found")
+		return
+	}
+
+	var update struct {
+		Status           string        `json:"status"`
+		RemainingBalance *money.Amount `json:"remainingBalance"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid body: "+err.Error())
+		return
+	}
+
+	if update.Status != "" {
+		contract.Status = update.Status
+	}
+	if update.RemainingBalance != nil {
+		contract.RemainingBalance = *update.RemainingBalance
+	}
+	contract.UpdatedAt = time.Now().UTC()
+
+	writeJSON(w, http.StatusOK, contract)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, map[string]string{"error": message})
+}
@@ -2,15 +2,31 @@ package clients
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"loan_service/configs"
 	"loan_service/internal/dto"
 	"net/http"
+	"net/url"
 	"time"
 )
 
+var (
+	// ErrVehicleNotFound is returned when Koinot Auto has no vehicle with
+	// the VIN.
+	ErrVehicleNotFound = errors.New("koinot auto: vehicle not found")
+	// ErrKoinotUnavailable is returned when Koinot Auto cannot be reached or
+	// fails; the request can be retried later.
+	ErrKoinotUnavailable = errors.New("koinot auto: unavailable")
+	// ErrVehicleHeld is returned when the vehicle is already held for
+	// another buyer or sold, or a hold on it is no longer valid.
+	ErrVehicleHeld = errors.New("koinot auto: vehicle is held")
+)
+
 type KoinotAutoClient struct {
 	httpClient *http.Client
 	baseURL    string
@@ -56,6 +72,112 @@ func (c *KoinotAutoClient) ListVehicles(ctx context.Context) ([]dto.Vehicle, err
 	return vehicles, nil
 }
 
+// GetVehicle returns the vehicle with the VIN as Koinot Auto currently lists
+// it, including whether it is still available.
+func (c *KoinotAutoClient) GetVehicle(ctx context.Context, vin string) (*dto.Vehicle, error) {
+	var vehicle dto.Vehicle
+	if err := c.do(ctx, http.MethodGet, "/vehicles/"+url.PathEscape(vin), nil, &vehicle); err != nil {
+		return nil, err
+	}
+
+	return &vehicle, nil
+}
+
+// KoinotHold is a hold on a vehicle as Koinot Auto reports it. Koinot Auto
+// lets the hold lapse at ExpiresAt unless it is converted into a sale.
+type KoinotHold struct {
+	HoldId    string    `json:"holdId"`
+	Vin       string    `json:"vin"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type koinotHoldRequest struct {
+	TtlSeconds int64 `json:"ttlSeconds"`
+}
+
+// ReserveVehicle asks Koinot Auto to hold the vehicle for ttl, so it is not
+// sold to anyone else meanwhile. It fails with ErrVehicleHeld if the vehicle
+// is already held or sold.
+func (c *KoinotAutoClient) ReserveVehicle(ctx context.Context, vin string, ttl time.Duration) (*dto.VehicleHold, error) {
+	var hold KoinotHold
+	err := c.do(ctx, http.MethodPost, "/vehicles/"+url.PathEscape(vin)+"/holds", koinotHoldRequest{
+		TtlSeconds: int64(ttl / time.Second),
+	}, &hold)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.VehicleHold{
+		HoldId:    hold.HoldId,
+		Vin:       cmp.Or(hold.Vin, vin),
+		ExpiresAt: hold.ExpiresAt,
+	}, nil
+}
+
+// ReleaseVehicleHold gives the vehicle back for sale. A hold Koinot Auto no
+// longer knows, e.g. one that has lapsed, counts as released.
+func (c *KoinotAutoClient) ReleaseVehicleHold(ctx context.Context, vin, holdId string) error {
+	err := c.do(ctx, http.MethodDelete, "/vehicles/"+url.PathEscape(vin)+"/holds/"+url.PathEscape(holdId), nil, nil)
+	if err != nil && !errors.Is(err, ErrVehicleNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// SellVehicle converts a hold into a sale. Selling a hold that has already
+// been sold succeeds again. It fails with ErrVehicleHeld if the hold is no
+// longer valid.
+func (c *KoinotAutoClient) SellVehicle(ctx context.Context, vin, holdId string) error {
+	return c.do(ctx, http.MethodPost, "/vehicles/"+url.PathEscape(vin)+"/holds/"+url.PathEscape(holdId)+"/sale", nil, nil)
+}
+
+func (c *KoinotAutoClient) do(ctx context.Context, method, path string, body, result any) error {
+	var reqBody io.Reader
+	if body != nil {
+		jsonData, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("could not marshall request body: %w", err)
+		}
+		reqBody = bytes.NewReader(jsonData)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: failed to make request: %s", ErrKoinotUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("%w: %s", ErrVehicleNotFound, path)
+	case resp.StatusCode == http.StatusConflict:
+		return fmt.Errorf("%w: %s", ErrVehicleHeld, path)
+	case resp.StatusCode >= 500:
+		return fmt.Errorf("%w: status %d", ErrKoinotUnavailable, resp.StatusCode)
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return fmt.Errorf("koinot auto returned status %d", resp.StatusCode)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
 func (c *KoinotAutoClient) SendLoanApplication(ctx context.Context, loanApp *dto.LoanApplication) error {
 
 	jsonData, err := json.Marshal(loanApp)
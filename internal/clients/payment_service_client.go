@@ -0,0 +1,86 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"loan_service/configs"
+	"loan_service/internal/dto"
+	paymentpb "loan_service/internal/proto/payment"
+	"loan_service/pkg/money"
+	"net"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ErrChargeRejected is returned when the payment service refuses a charge,
+// e.g. because the payment source is invalid.
+var ErrChargeRejected = errors.New("payment service rejected the charge")
+
+type PaymentServiceClient struct {
+	conn    *grpc.ClientConn
+	client  paymentpb.PaymentServiceClient
+	timeout time.Duration
+}
+
+func NewPaymentServiceClient(cfg configs.GRPCClientConfig) (*PaymentServiceClient, error) {
+	timeout, err := time.ParseDuration(cfg.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid timeout format for payment service client: %w", err)
+	}
+
+	conn, err := grpc.NewClient(net.JoinHostPort(cfg.Host, cfg.GRPCPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment service connection: %w", err)
+	}
+
+	return &PaymentServiceClient{
+		conn:    conn,
+		client:  paymentpb.NewPaymentServiceClient(conn),
+		timeout: timeout,
+	}, nil
+}
+
+// CreateCharge asks the payment service to charge a customer. The
+// transaction id makes the call idempotent, so it is safe to retry.
+func (c *PaymentServiceClient) CreateCharge(ctx context.Context, charge *dto.Charge) (*dto.Charge, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	resp, err := c.client.CreateCharge(ctx, &paymentpb.CreateChargeRequest{
+		TransactionId: charge.TransactionId,
+		UserId:        strconv.FormatInt(charge.UserId, 10),
+		Amount: &paymentpb.Money{
+			Amount:       int64(charge.Amount),
+			CurrencyCode: charge.CurrencyCode,
+		},
+		Method:          charge.Method,
+		PaymentSourceId: charge.PaymentSourceId,
+		Description:     charge.Description,
+		Reference:       charge.Reference,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	if serviceErr := resp.GetPaymentServiceError(); serviceErr != nil && serviceErr.GetCode() != 0 {
+		return nil, fmt.Errorf("%w: %s (code %d)", ErrChargeRejected, serviceErr.GetDescription(), serviceErr.GetCode())
+	}
+
+	created := *charge
+	created.Status = resp.GetCharge().GetStatus()
+	created.FailureReason = resp.GetCharge().GetFailureReason()
+	if amount := resp.GetCharge().GetAmount(); amount != nil {
+		created.Amount = money.Amount(amount.GetAmount())
+		created.CurrencyCode = amount.GetCurrencyCode()
+	}
+
+	return &created, nil
+}
+
+func (c *PaymentServiceClient) Close() error {
+	return c.conn.Close()
+}
@@ -1,37 +1,60 @@
 package dto
 
-import "time"
+import (
+	"loan_service/pkg/money"
+	"time"
+)
 
 type LoanApplication struct {
-	Id             int64     `json:"id"`
-	UserId         int64     `json:"userId"`
-	Type           string    `json:"type"`
-	VehicleVin     string    `json:"vehicleVin"`
-	VehicleName    string    `json:"vehicleName"`
-	CurrencyCode   string    `json:"currencyCode"`
-	Price          int64     `json:"price"`
-	DownPayment    int64     `json:"downPayment"`
-	NetPrice       int64     `json:"netPrice"`
-	MarginRate     float64   `json:"marginRate"`
-	TermMonths     int32     `json:"termMonths"`
-	MonthlyPayment int64     `json:"monthlyPayment"`
-	Status         string    `json:"status"`
-	CreatedAt      time.Time `json:"createdAt"`
-	UpdatedAt      time.Time `json:"updatedAt"`
+	Id                  int64        `json:"id"`
+	UserId              int64        `json:"userId"`
+	Type                string       `json:"type"`
+	VehicleVin          string       `json:"vehicleVin"`
+	VehicleName         string       `json:"vehicleName"`
+	CurrencyCode        string       `json:"currencyCode"`
+	Price               money.Amount `json:"price"`
+	DownPayment         money.Amount `json:"downPayment"`
+	NetPrice            money.Amount `json:"netPrice"`
+	MarginRate          float64      `json:"marginRate"`
+	TermMonths          int32        `json:"termMonths"`
+	MonthlyPayment      money.Amount `json:"monthlyPayment"`
+	RepaymentMethod     string       `json:"repaymentMethod"`
+	Status              string       `json:"status"`
+	VehiclePrice        money.Amount `json:"vehiclePrice"`
+	VehicleCurrencyCode string       `json:"vehicleCurrencyCode"`
+	ExchangeRate        float64      `json:"exchangeRate"`
+	ContractNumber      string       `json:"contractNumber"`
+	ProductId           int64        `json:"productId"`
+	CreatedAt           time.Time    `json:"createdAt"`
+	UpdatedAt           time.Time    `json:"updatedAt"`
+
+	// Delivery of the application to the Koinot Auto dealer; not part of
+	// what is sent.
+	DealerNotificationStatus   string    `json:"-"`
+	DealerNotificationAttempts int32     `json:"-"`
+	DealerNotificationError    string    `json:"-"`
+	DealerNotifiedAt           time.Time `json:"-"`
 }
 
 type Loan struct {
-	Id               int64
-	ApplicationId    int64
-	UserId           int64
-	CurrencyCode     string
-	VehicleVin       string
-	Amount           int64
-	TermMonths       int32
-	MonthlyPayment   int64
-	RemainingBalance int64
-	Status           string
-	CreatedAt        time.Time
+	Id                 int64
+	ApplicationId      int64
+	UserId             int64
+	CurrencyCode       string
+	VehicleVin         string
+	Amount             money.Amount
+	TermMonths         int32
+	MonthlyPayment     money.Amount
+	RemainingBalance   money.Amount
+	ChargesOutstanding money.Amount
+	MarginRate         float64
+	RepaymentMethod    string
+	Status             string
+	DaysPastDue        int32
+	ContractNumber     string
+	CreatedAt          time.Time
+
+	OwnershipTransferredAt time.Time // IJARA loans, once paid off
 }
 
 type Payment struct {
@@ -39,7 +62,7 @@ type Payment struct {
 	LoanId        int64
 	CurrencyCode  string
 	PaymentDate   time.Time
-	Amount        int64
+	Amount        money.Amount
 	Method        string
 	Status        string
 	TransactionId string
@@ -52,6 +75,206 @@ type Vehicle struct {
 	Name          string
 	EngineType    string
 	Configuration string
-	Price         int64
+	Price         money.Amount
+	CurrencyCode  string
+	Status        string // AVAILABLE, RESERVED, SOLD; empty for dealers that do not report it
+}
+
+// VehicleQuery selects vehicles from the catalog. Prices are compared in the
+// currency of MinPrice and MaxPrice; a zero amount leaves that side open.
+type VehicleQuery struct {
+	EngineType   string
+	CurrencyCode string
+	Search       string // part of the vehicle name, case-insensitive
+	MinPrice     money.Money
+	MaxPrice     money.Money
+	Sort         string // NAME (default), PRICE_ASC, PRICE_DESC
+}
+
+// VehicleHold is a hold Koinot Auto keeps on a vehicle so it is not sold to
+// anyone else before ExpiresAt.
+type VehicleHold struct {
+	HoldId    string
+	Vin       string
+	ExpiresAt time.Time
+}
+
+type Calculation struct {
+	ProductId       int64
+	CurrencyCode    string
+	RepaymentMethod string
+	MarginRate      float64
+	Price           money.Amount
+	ExchangeRate    float64
+	NetPrice        money.Amount
+	MonthlyPayment  money.Amount
+	TotalAmount     money.Amount
+}
+
+type RepaymentInstallment struct {
+	Number             int32
+	DueDate            time.Time
+	CurrencyCode       string
+	Payment            money.Amount
+	Principal          money.Amount
+	Margin             money.Amount
+	OutstandingBalance money.Amount
+	OwnershipTransfer  bool // IJARA: ownership passes to the customer with this rental
+}
+
+type Installment struct {
+	Id            int64
+	LoanId        int64
+	Number        int32
+	DueDate       time.Time
+	CurrencyCode  string
+	PrincipalDue  money.Amount
+	MarginDue     money.Amount
+	PrincipalPaid money.Amount
+	MarginPaid    money.Amount
+	Status        string
+	PaidAt        time.Time
+}
+
+type PayoffQuote struct {
+	Id            int64
+	LoanId        int64
 	CurrencyCode  string
+	Principal     money.Amount
+	Margin        money.Amount
+	MarginWaived  money.Amount
+	Charges       money.Amount
+	SettlementFee money.Amount
+	Total         money.Amount
+	ValidUntil    time.Time
+	Status        string
+	CreatedAt     time.Time
+}
+
+type PrepaymentQuote struct {
+	LoanId           int64
+	CurrencyCode     string
+	Amount           money.Amount
+	Mode             string
+	TermMonths       int32
+	MonthlyPayment   money.Amount
+	RemainingBalance money.Amount
+	MarginSaved      money.Amount
+	Schedule         []RepaymentInstallment
+}
+
+type OutboxEvent struct {
+	Id            int64
+	EventId       string
+	Type          string
+	Version       int32
+	AggregateType string
+	AggregateId   int64
+	CorrelationId string
+	Payload       []byte
+	Attempts      int32
+	CreatedAt     time.Time
+}
+
+type Charge struct {
+	TransactionId   string
+	UserId          int64
+	Amount          money.Amount
+	CurrencyCode    string
+	Method          string
+	PaymentSourceId string
+	Description     string
+	Reference       string
+	Status          string
+	FailureReason   string
+}
+
+type LeasingContract struct {
+	ContractNumber   string
+	Status           string
+	CurrencyCode     string
+	RemainingBalance money.Amount
+	UpdatedAt        time.Time
+}
+
+type ReconciliationRun struct {
+	Id           int64
+	StartedAt    time.Time
+	FinishedAt   time.Time
+	LoansChecked int32
+	IssuesFound  int32
+	Corrected    int32
+	Failed       int32
+	Error        string
+}
+
+type ReconciliationIssue struct {
+	Id             int64
+	RunId          int64
+	LoanId         int64
+	ContractNumber string
+	CurrencyCode   string
+	Kind           string
+	Status         string
+	LocalBalance   money.Amount
+	RemoteBalance  money.Amount
+	LocalStatus    string
+	RemoteStatus   string
+	DetectedAt     time.Time
+	ResolvedAt     time.Time
+}
+
+type ReconciliationIssueCount struct {
+	Kind   string
+	Status string
+	Count  int64
+}
+
+type ReconciliationSummary struct {
+	LastRun *ReconciliationRun
+	Counts  []ReconciliationIssueCount
+}
+
+// LoanProduct is a financing product: what it finances, in which currency,
+// for which terms and amounts, and at which rates. MinAmount and MaxAmount
+// bound the amount financed, i.e. the price less the down payment.
+type LoanProduct struct {
+	Id                    int64
+	Code                  string
+	Name                  string
+	ApplicationType       string
+	CurrencyCode          string
+	RepaymentMethod       string
+	TermMonths            []int32
+	MinAmount             money.Amount
+	MaxAmount             money.Amount
+	MinDownPaymentPercent float64
+	Rates                 []ProductRate
+	Active                bool
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+// ProductRate is a cell of the rate grid of a product: the annual margin
+// rate, in percent, for terms up to MaxTermMonths with a down payment of at
+// least MinDownPaymentPercent of the price.
+type ProductRate struct {
+	MaxTermMonths         int32
+	MinDownPaymentPercent float64
+	MarginRate            float64
+}
+
+// ProductQuery selects products from the catalog. Inactive products are left
+// out unless IncludeInactive is set.
+type ProductQuery struct {
+	ApplicationType string
+	CurrencyCode    string
+	IncludeInactive bool
+}
+
+// FieldError describes why a field of a request was rejected. Field is the
+// name of the field in the API.
+type FieldError struct {
+	Field       string
+	Description string
 }
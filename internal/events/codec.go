@@ -0,0 +1,129 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	eventspb "loan_service/internal/proto/events"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ContentType is the AMQP content type of an encoded envelope.
+const ContentType = "application/x-protobuf"
+
+var ErrUnknownSchema = errors.New("unknown event schema")
+
+// Metadata is what the envelope carries besides the payload.
+type Metadata struct {
+	EventId       string
+	Type          string
+	Version       int32
+	OccurredAt    time.Time
+	AggregateType string
+	AggregateId   int64
+	CorrelationId string
+}
+
+// Encode wraps payload in an envelope and returns the message body to
+// publish. payload must be the message registered for meta.Type and
+// meta.Version.
+func Encode(meta Metadata, payload proto.Message) ([]byte, error) {
+	expected, err := newPayload(meta.Type, meta.Version)
+	if err != nil {
+		return nil, err
+	}
+	if expected.ProtoReflect().Descriptor() != payload.ProtoReflect().Descriptor() {
+		return nil, fmt.Errorf("%s v%d event payload must be %s, got %s",
+			meta.Type, meta.Version, expected.ProtoReflect().Descriptor().FullName(), payload.ProtoReflect().Descriptor().FullName())
+	}
+
+	data, err := proto.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s event payload: %w", meta.Type, err)
+	}
+
+	envelope := &eventspb.EventEnvelope{
+		EventId:       meta.EventId,
+		EventType:     meta.Type,
+		Version:       meta.Version,
+		OccurredAt:    Timestamp(meta.OccurredAt),
+		AggregateType: meta.AggregateType,
+		AggregateId:   meta.AggregateId,
+		CorrelationId: meta.CorrelationId,
+		Payload:       data,
+	}
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(envelope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s event envelope: %w", meta.Type, err)
+	}
+
+	return body, nil
+}
+
+// Decode parses a message body produced by Encode and returns its metadata
+// and payload. Consumers type-switch on the payload, e.g. for
+// *eventspb.LoanOriginatedV1.
+func Decode(body []byte) (Metadata, proto.Message, error) {
+	var envelope eventspb.EventEnvelope
+	if err := proto.Unmarshal(body, &envelope); err != nil {
+		return Metadata{}, nil, fmt.Errorf("failed to decode event envelope: %w", err)
+	}
+
+	occurredAt, err := time.Parse(time.RFC3339, envelope.OccurredAt)
+	if err != nil {
+		return Metadata{}, nil, fmt.Errorf("invalid occurred_at of event %s: %w", envelope.EventId, err)
+	}
+
+	payload, err := newPayload(envelope.EventType, envelope.Version)
+	if err != nil {
+		return Metadata{}, nil, err
+	}
+
+	if err := proto.Unmarshal(envelope.Payload, payload); err != nil {
+		return Metadata{}, nil, fmt.Errorf("failed to decode %s event payload: %w", envelope.EventType, err)
+	}
+
+	meta := Metadata{
+		EventId:       envelope.EventId,
+		Type:          envelope.EventType,
+		Version:       envelope.Version,
+		OccurredAt:    occurredAt,
+		AggregateType: envelope.AggregateType,
+		AggregateId:   envelope.AggregateId,
+		CorrelationId: envelope.CorrelationId,
+	}
+
+	return meta, payload, nil
+}
+
+// MarshalPayload encodes a payload for the outbox. The outbox keeps payloads
+// as JSON so they stay readable in the database.
+func MarshalPayload(payload proto.Message) ([]byte, error) {
+	return protojson.Marshal(payload)
+}
+
+// UnmarshalPayload decodes a payload stored by MarshalPayload.
+func UnmarshalPayload(eventType string, version int32, data []byte) (proto.Message, error) {
+	payload, err := newPayload(eventType, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := protojson.Unmarshal(data, payload); err != nil {
+		return nil, fmt.Errorf("failed to decode %s event payload: %w", eventType, err)
+	}
+
+	return payload, nil
+}
+
+func newPayload(eventType string, version int32) (proto.Message, error) {
+	newMessage, ok := schemas[schema{eventType, version}]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s v%d", ErrUnknownSchema, eventType, version)
+	}
+
+	return newMessage(), nil
+}
@@ -0,0 +1,211 @@
+package events
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	eventspb "loan_service/internal/proto/events"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden envelopes in testdata")
+
+var occurredAt = time.Date(2024, time.March, 15, 9, 30, 0, 0, time.UTC)
+
+// samples holds one filled-in payload per entry of schemas. A schema added
+// without a sample fails TestSamplesCoverSchemas.
+var samples = map[schema]proto.Message{
+	{TypeApplicationCreated, 1}: &eventspb.ApplicationCreatedV1{
+		ApplicationId:   42,
+		UserId:          7,
+		Type:            "AUTO",
+		VehicleVin:      "JTDBR32E720123456",
+		Price:           &eventspb.Money{Amount: 25000000, CurrencyCode: "TJS"},
+		NetPrice:        &eventspb.Money{Amount: 20000000, CurrencyCode: "TJS"},
+		TermMonths:      36,
+		RepaymentMethod: "ANNUITY",
+	},
+	{TypeApplicationStatusChanged, 1}: &eventspb.ApplicationStatusChangedV1{
+		ApplicationId: 42,
+		UserId:        7,
+		FromStatus:    "REVIEW",
+		ToStatus:      "APPROVED",
+		Actor:         "underwriter@koinot",
+		Reason:        "income confirmed",
+	},
+	{TypeLoanOriginated, 1}: &eventspb.LoanOriginatedV1{
+		LoanId:           100,
+		ApplicationId:    42,
+		UserId:           7,
+		Amount:           &eventspb.Money{Amount: 20000000, CurrencyCode: "TJS"},
+		TermMonths:       36,
+		MonthlyPayment:   &eventspb.Money{Amount: 664311, CurrencyCode: "TJS"},
+		RemainingBalance: &eventspb.Money{Amount: 23915196, CurrencyCode: "TJS"},
+	},
+	{TypePaymentReceived, 1}: &eventspb.PaymentReceivedV1{
+		PaymentId:              500,
+		LoanId:                 100,
+		UserId:                 7,
+		Amount:                 &eventspb.Money{Amount: 674311, CurrencyCode: "TJS"},
+		PaymentDate:            Timestamp(occurredAt),
+		TransactionId:          "txn-0001",
+		RemainingBalance:       &eventspb.Money{Amount: 0, CurrencyCode: "TJS"},
+		LoanStatus:             "PAID",
+		CharityAmount:          &eventspb.Money{Amount: 10000, CurrencyCode: "TJS"},
+		CharityAccount:         "TJ00CHARITY",
+		OwnershipTransferredAt: Timestamp(occurredAt),
+	},
+	{TypeLoanOverdue, 1}: &eventspb.LoanOverdueV1{
+		LoanId:           100,
+		UserId:           7,
+		DaysPastDue:      31,
+		RemainingBalance: &eventspb.Money{Amount: 17000000, CurrencyCode: "TJS"},
+	},
+}
+
+func sampleMetadata(s schema) Metadata {
+	aggregateType := AggregateLoan
+	if s.eventType == TypeApplicationCreated || s.eventType == TypeApplicationStatusChanged {
+		aggregateType = AggregateApplication
+	}
+
+	return Metadata{
+		EventId:       fmt.Sprintf("00000000-0000-0000-0000-%012d", s.version),
+		Type:          s.eventType,
+		Version:       s.version,
+		OccurredAt:    occurredAt,
+		AggregateType: aggregateType,
+		AggregateId:   100,
+		CorrelationId: "corr-" + s.eventType,
+	}
+}
+
+func goldenPath(s schema) string {
+	return filepath.Join("testdata", fmt.Sprintf("%s.v%d.golden", s.eventType, s.version))
+}
+
+func TestSamplesCoverSchemas(t *testing.T) {
+	for s := range schemas {
+		if _, ok := samples[s]; !ok {
+			t.Errorf("no sample payload for %s v%d", s.eventType, s.version)
+		}
+	}
+}
+
+// TestEncodeGolden pins the wire format of every published schema. Run with
+// -update after an intended change to the envelope or a payload.
+func TestEncodeGolden(t *testing.T) {
+	for s, payload := range samples {
+		t.Run(fmt.Sprintf("%s.v%d", s.eventType, s.version), func(t *testing.T) {
+			body, err := Encode(sampleMetadata(s), payload)
+			if err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+
+			path := goldenPath(s)
+			if *update {
+				if err := os.WriteFile(path, body, 0o644); err != nil {
+					t.Fatalf("failed to write %s: %v", path, err)
+				}
+			}
+
+			golden, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", path, err)
+			}
+			if !bytes.Equal(body, golden) {
+				t.Errorf("encoded envelope differs from %s; run go test -update if the change is intended", path)
+			}
+		})
+	}
+}
+
+// TestDecodeGolden makes sure envelopes already published keep decoding.
+func TestDecodeGolden(t *testing.T) {
+	for s, payload := range samples {
+		t.Run(fmt.Sprintf("%s.v%d", s.eventType, s.version), func(t *testing.T) {
+			golden, err := os.ReadFile(goldenPath(s))
+			if err != nil {
+				t.Fatalf("failed to read golden envelope: %v", err)
+			}
+
+			meta, decoded, err := Decode(golden)
+			if err != nil {
+				t.Fatalf("Decode: %v", err)
+			}
+			if meta != sampleMetadata(s) {
+				t.Errorf("metadata = %+v, want %+v", meta, sampleMetadata(s))
+			}
+			if !proto.Equal(decoded, payload) {
+				t.Errorf("payload = %v, want %v", decoded, payload)
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for s, payload := range samples {
+		t.Run(fmt.Sprintf("%s.v%d", s.eventType, s.version), func(t *testing.T) {
+			meta := sampleMetadata(s)
+			body, err := Encode(meta, payload)
+			if err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+
+			decodedMeta, decoded, err := Decode(body)
+			if err != nil {
+				t.Fatalf("Decode: %v", err)
+			}
+			if decodedMeta != meta {
+				t.Errorf("metadata = %+v, want %+v", decodedMeta, meta)
+			}
+			if !proto.Equal(decoded, payload) {
+				t.Errorf("payload = %v, want %v", decoded, payload)
+			}
+		})
+	}
+}
+
+func TestEncodeRejectsWrongPayload(t *testing.T) {
+	meta := sampleMetadata(schema{TypeLoanOriginated, 1})
+	if _, err := Encode(meta, &eventspb.LoanOverdueV1{}); err == nil {
+		t.Fatal("Encode accepted a LoanOverdueV1 payload for LoanOriginated v1")
+	}
+}
+
+func TestDecodeUnknownSchema(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType string
+		version   int32
+	}{
+		{"unknown type", "LoanRestructured", 1},
+		{"unknown version", TypeLoanOriginated, 2},
+		{"missing version", TypeLoanOriginated, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := proto.Marshal(&eventspb.EventEnvelope{
+				EventId:    "00000000-0000-0000-0000-000000000000",
+				EventType:  tt.eventType,
+				Version:    tt.version,
+				OccurredAt: Timestamp(occurredAt),
+			})
+			if err != nil {
+				t.Fatalf("failed to marshal envelope: %v", err)
+			}
+
+			if _, _, err := Decode(body); !errors.Is(err, ErrUnknownSchema) {
+				t.Errorf("Decode error = %v, want %v", err, ErrUnknownSchema)
+			}
+		})
+	}
+}
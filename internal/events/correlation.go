@@ -0,0 +1,16 @@
+package events
+
+import "context"
+
+type correlationIdKey struct{}
+
+// WithCorrelationId returns a context whose events carry correlationId.
+func WithCorrelationId(ctx context.Context, correlationId string) context.Context {
+	return context.WithValue(ctx, correlationIdKey{}, correlationId)
+}
+
+// CorrelationId returns the correlation id set by WithCorrelationId, if any.
+func CorrelationId(ctx context.Context) string {
+	correlationId, _ := ctx.Value(correlationIdKey{}).(string)
+	return correlationId
+}
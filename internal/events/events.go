@@ -0,0 +1,68 @@
+package events
+
+import (
+	eventspb "loan_service/internal/proto/events"
+	"loan_service/pkg/money"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Event types published on the events exchange. The type is used as the
+// routing key.
+const (
+	TypeApplicationCreated       = "ApplicationCreated"
+	TypeApplicationStatusChanged = "ApplicationStatusChanged"
+	TypeLoanOriginated           = "LoanOriginated"
+	TypePaymentReceived          = "PaymentReceived"
+	TypeLoanOverdue              = "LoanOverdue"
+)
+
+// Aggregates events belong to.
+const (
+	AggregateApplication = "loan_application"
+	AggregateLoan        = "loan"
+)
+
+// schema is a published version of an event's payload.
+type schema struct {
+	eventType string
+	version   int32
+}
+
+// schemas lists every published payload version. A breaking change to an
+// event adds a new message in loan_events.proto and a new version here; old
+// versions stay so events already in the outbox can still be encoded.
+var schemas = map[schema]func() proto.Message{
+	{TypeApplicationCreated, 1}:       func() proto.Message { return &eventspb.ApplicationCreatedV1{} },
+	{TypeApplicationStatusChanged, 1}: func() proto.Message { return &eventspb.ApplicationStatusChangedV1{} },
+	{TypeLoanOriginated, 1}:           func() proto.Message { return &eventspb.LoanOriginatedV1{} },
+	{TypePaymentReceived, 1}:          func() proto.Message { return &eventspb.PaymentReceivedV1{} },
+	{TypeLoanOverdue, 1}:              func() proto.Message { return &eventspb.LoanOverdueV1{} },
+}
+
+// Version returns the payload version of an event message, or 0 if the
+// message is not a published event.
+func Version(payload proto.Message) int32 {
+	name := payload.ProtoReflect().Descriptor().FullName()
+	for s, newMessage := range schemas {
+		if newMessage().ProtoReflect().Descriptor().FullName() == name {
+			return s.version
+		}
+	}
+
+	return 0
+}
+
+// Money converts an amount to its event representation.
+func Money(amount money.Amount, currencyCode string) *eventspb.Money {
+	return &eventspb.Money{
+		Amount:       int64(amount),
+		CurrencyCode: currencyCode,
+	}
+}
+
+// Timestamp formats a time the way events carry it.
+func Timestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
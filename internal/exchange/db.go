@@ -0,0 +1,55 @@
+package exchange
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"loan_service/internal/repository"
+	"time"
+)
+
+// DBProvider reads rates from the exchange_rates table, taking the latest
+// rate effective at the requested moment. A pair stored only in the opposite
+// direction is inverted.
+type DBProvider struct {
+	queries *repository.Queries
+}
+
+func NewDBProvider(queries *repository.Queries) *DBProvider {
+	return &DBProvider{
+		queries: queries,
+	}
+}
+
+func (p *DBProvider) Rate(ctx context.Context, base, quote string, asOf time.Time) (float64, error) {
+	if base == quote {
+		return 1, nil
+	}
+
+	rate, err := p.queries.GetExchangeRate(ctx, repository.GetExchangeRateParams{
+		BaseCurrency:  base,
+		QuoteCurrency: quote,
+		AsOf:          asOf,
+	})
+	if err == nil {
+		return rate.Rate, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("failed to get exchange rate from db: %w", err)
+	}
+
+	inverse, err := p.queries.GetExchangeRate(ctx, repository.GetExchangeRateParams{
+		BaseCurrency:  quote,
+		QuoteCurrency: base,
+		AsOf:          asOf,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("%w: %s/%s", ErrRateNotFound, base, quote)
+		}
+		return 0, fmt.Errorf("failed to get exchange rate from db: %w", err)
+	}
+
+	return 1 / inverse.Rate, nil
+}
@@ -0,0 +1,15 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrRateNotFound = errors.New("exchange rate not found")
+
+// ExchangeRateProvider tells how many units of quote one unit of base is worth
+// at a given moment.
+type ExchangeRateProvider interface {
+	Rate(ctx context.Context, base, quote string, asOf time.Time) (float64, error)
+}
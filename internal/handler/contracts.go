@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"loan_service/internal/clients"
+	"loan_service/internal/dto"
+	loanpb "loan_service/internal/proto/loan"
+	"loan_service/internal/usecase"
+	"strconv"
+	"time"
+)
+
+func contractToPB(contract *dto.LeasingContract) *loanpb.LeasingContract {
+	return &loanpb.LeasingContract{
+		ContractNumber:   contract.ContractNumber,
+		Status:           contract.Status,
+		RemainingBalance: moneyToPB(contract.RemainingBalance, contract.CurrencyCode),
+		UpdatedAt:        contract.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// asrServiceError maps an ASR Leasing failure to the error returned to the
+// client, or returns nil if err does not come from ASR Leasing.
+func asrServiceError(err error) *loanpb.LoanServiceError {
+	switch {
+	case errors.Is(err, clients.ErrAsrNotFound):
+		return &loanpb.LoanServiceError{
+			Code:        2,
+			Description: "contract not found in ASR Leasing",
+		}
+	case errors.Is(err, clients.ErrAsrRejected):
+		return &loanpb.LoanServiceError{
+			Code:        3,
+			Description: err.Error(),
+		}
+	case errors.Is(err, clients.ErrAsrUnavailable):
+		return &loanpb.LoanServiceError{
+			Code:        4,
+			Description: "ASR Leasing is unavailable, try again later",
+		}
+	default:
+		return nil
+	}
+}
+
+func (h *LoanHandler) GetLoanContract(ctx context.Context, req *loanpb.GetLoanContractRequest) (*loanpb.GetLoanContractResponse, error) {
+	if req.GetLoanId() == "" {
+		return &loanpb.GetLoanContractResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "loan id is required",
+			},
+		}, nil
+	}
+
+	loanId, err := strconv.ParseInt(req.GetLoanId(), 10, 64)
+	if err != nil {
+		return &loanpb.GetLoanContractResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid loan id %q", req.GetLoanId()),
+			},
+		}, nil
+	}
+
+	contract, err := h.loanUC.GetLoanContract(ctx, loanId)
+	if err != nil {
+		// Not found
+		if errors.Is(err, sql.ErrNoRows) {
+			return &loanpb.GetLoanContractResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        2,
+					Description: "loan not found",
+				},
+			}, nil
+		}
+
+		// Loan was originated before the ASR Leasing integration
+		if errors.Is(err, usecase.ErrNoContract) {
+			return &loanpb.GetLoanContractResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        3,
+					Description: err.Error(),
+				},
+			}, nil
+		}
+
+		// Rejected by or failed in ASR Leasing
+		if asrErr := asrServiceError(err); asrErr != nil {
+			return &loanpb.GetLoanContractResponse{
+				LoanServiceError: asrErr,
+			}, nil
+		}
+
+		// Internal error
+		return &loanpb.GetLoanContractResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to fetch contract",
+			},
+		}, nil
+	}
+
+	return &loanpb.GetLoanContractResponse{
+		Contract:         contractToPB(contract),
+		LoanServiceError: ok(),
+	}, nil
+}
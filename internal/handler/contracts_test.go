@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"loan_service/configs"
+	"loan_service/internal/clients"
+	"loan_service/internal/clients/asrleasingstub"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestAsrServiceError runs client calls against the stub and checks the code
+// each failure reaches the caller with.
+func TestAsrServiceError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		contract   string
+		wantCode   int32
+	}{
+		{"unknown contract", 0, "ASR-999999", 2},
+		{"not found", http.StatusNotFound, "ASR-000001", 2},
+		{"bad request", http.StatusBadRequest, "ASR-000001", 3},
+		{"conflict", http.StatusConflict, "ASR-000001", 3},
+		{"unprocessable", http.StatusUnprocessableEntity, "ASR-000001", 3},
+		{"internal error", http.StatusInternalServerError, "ASR-000001", 4},
+		{"unavailable", http.StatusServiceUnavailable, "ASR-000001", 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := asrleasingstub.New("")
+			stub.SetFailure(tt.statusCode)
+			server := httptest.NewServer(stub)
+			defer server.Close()
+
+			client, err := clients.NewAsrLeasingClient(configs.HTTPClientConfig{BaseURL: server.URL, Timeout: "5s"})
+			if err != nil {
+				t.Fatalf("NewAsrLeasingClient: %v", err)
+			}
+
+			_, err = client.GetContract(context.Background(), tt.contract)
+			if err == nil {
+				t.Fatal("GetContract succeeded")
+			}
+
+			serviceError := asrServiceError(err)
+			if serviceError == nil {
+				t.Fatalf("asrServiceError(%v) = nil", err)
+			}
+			if serviceError.Code != tt.wantCode {
+				t.Errorf("code = %d, want %d (%s)", serviceError.Code, tt.wantCode, serviceError.Description)
+			}
+		})
+	}
+}
+
+func TestAsrServiceErrorOther(t *testing.T) {
+	if serviceError := asrServiceError(errors.New("failed to get loan from db")); serviceError != nil {
+		t.Errorf("asrServiceError of a non-ASR error = %v, want nil", serviceError)
+	}
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+	"loan_service/internal/events"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// CorrelationIdHeader is the metadata key callers set to find the events
+// their call published.
+const CorrelationIdHeader = "x-correlation-id"
+
+// maxCorrelationIdLength matches outbox.correlation_id; longer ids are
+// ignored rather than failing the call.
+const maxCorrelationIdLength = 64
+
+// CorrelationInterceptor passes the caller's correlation id on to the events
+// recorded while handling the call.
+func CorrelationInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(CorrelationIdHeader); len(ids) > 0 && ids[0] != "" && len(ids[0]) <= maxCorrelationIdLength {
+			ctx = events.WithCorrelationId(ctx, ids[0])
+		}
+	}
+
+	return handler(ctx, req)
+}
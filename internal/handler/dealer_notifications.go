@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	loanpb "loan_service/internal/proto/loan"
+)
+
+// ListDealerNotifications lists applications by the status of their delivery
+// to Koinot Auto, so deliveries that are stuck can be found.
+func (h *LoanHandler) ListDealerNotifications(ctx context.Context, req *loanpb.ListDealerNotificationsRequest) (*loanpb.ListDealerNotificationsResponse, error) {
+	status := req.GetStatus()
+	switch status {
+	case "":
+		status = "FAILED"
+	case "PENDING", "SENT", "FAILED":
+	default:
+		return &loanpb.ListDealerNotificationsResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid dealer notification status %q, expected PENDING, SENT or FAILED", status),
+			},
+		}, nil
+	}
+
+	limit, offset := pageToLimitOffset(req.GetPage())
+	currentPage := offset/limit + 1
+
+	loanAppsCount, err := h.loanUC.CountDealerNotifications(ctx, status)
+	if err != nil {
+		return &loanpb.ListDealerNotificationsResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to fetch dealer notifications",
+			},
+		}, nil
+	}
+
+	if *loanAppsCount == 0 {
+		return &loanpb.ListDealerNotificationsResponse{
+			Applications: nil,
+			Page: &loanpb.PageResponse{
+				CurrentPage: currentPage,
+				Limit:       limit,
+				TotalItems:  0,
+				TotalPages:  0,
+			},
+			LoanServiceError: ok(),
+		}, nil
+	}
+
+	loanApps, err := h.loanUC.ListDealerNotifications(ctx, status, limit, offset)
+	if err != nil {
+		return &loanpb.ListDealerNotificationsResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to fetch dealer notifications",
+			},
+		}, nil
+	}
+
+	listLoanAppsPB := make([]*loanpb.LoanApplication, len(loanApps))
+	for index, loanApp := range loanApps {
+		listLoanAppsPB[index] = applicationToPB(loanApp)
+	}
+
+	totalPages := *loanAppsCount / int64(limit)
+	if *loanAppsCount%int64(limit) != 0 {
+		totalPages++
+	}
+	return &loanpb.ListDealerNotificationsResponse{
+		Applications: listLoanAppsPB,
+		Page: &loanpb.PageResponse{
+			CurrentPage: currentPage,
+			Limit:       limit,
+			TotalItems:  int32(*loanAppsCount),
+			TotalPages:  int32(totalPages),
+		},
+		LoanServiceError: ok(),
+	}, nil
+}
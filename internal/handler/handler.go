@@ -5,9 +5,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"loan_service/internal/calculator"
 	"loan_service/internal/dto"
+	"loan_service/internal/exchange"
 	loanpb "loan_service/internal/proto/loan"
 	"loan_service/internal/usecase"
+	"loan_service/pkg/money"
 	"strconv"
 	"time"
 )
@@ -46,18 +49,353 @@ func pageToLimitOffset(p *loanpb.PageRequest) (limit, offset int32) {
 	return limitIn, offset
 }
 
+// parseOptionalId reads an id a request may leave out, as 0.
+func parseOptionalId(id string) (int64, error) {
+	if id == "" {
+		return 0, nil
+	}
+
+	parsed, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid id %q", id)
+	}
+
+	return parsed, nil
+}
+
+// optionalIdToPB writes an id that may be unset, leaving it out if it is.
+func optionalIdToPB(id int64) string {
+	if id == 0 {
+		return ""
+	}
+
+	return fmt.Sprint(id)
+}
+
+func moneyToPB(amount money.Amount, currencyCode string) *loanpb.Money {
+	return &loanpb.Money{
+		Amount:       int64(amount),
+		CurrencyCode: currencyCode,
+	}
+}
+
+// orLegacyAmount returns m, or, for a client built before amounts became
+// Money that only sends the deprecated whole-unit field, that amount with no
+// currency, so it is taken to be in the request's currency.
+func orLegacyAmount(m *loanpb.Money, legacy int64) *loanpb.Money {
+	if m != nil || legacy == 0 {
+		return m
+	}
+
+	return &loanpb.Money{Amount: int64(money.FromMajor(legacy))}
+}
+
+// amountFromPB reads an amount given in a request for currencyCode. Amounts
+// without a currency are taken to be in currencyCode; amounts finer than the
+// currency's minor units are rejected.
+func amountFromPB(m *loanpb.Money, currencyCode string) (money.Amount, error) {
+	if m == nil {
+		return 0, nil
+	}
+
+	if m.GetCurrencyCode() != "" && m.GetCurrencyCode() != currencyCode {
+		return 0, fmt.Errorf("amount is in %s, expected %s", m.GetCurrencyCode(), currencyCode)
+	}
+
+	amount := money.Amount(m.GetAmount())
+	if currency := money.Lookup(currencyCode); currency.Round(float64(amount)) != amount {
+		return 0, fmt.Errorf("amount %s has more than %d decimal places for %s", amount, currency.MinorUnits, currencyCode)
+	}
+
+	return amount, nil
+}
+
+// loanMoneyFromPB reads an amount of a request on a loan, whose currency only
+// the usecase knows. currencyCode is the request's own currency, if it has
+// one. An amount in neither is left without a currency, to be taken as the
+// loan's; the usecase also checks the amount against the loan's currency.
+func loanMoneyFromPB(m *loanpb.Money, currencyCode string) (money.Money, error) {
+	if currencyCode == "" {
+		currencyCode = m.GetCurrencyCode()
+	}
+
+	if m.GetCurrencyCode() != "" && m.GetCurrencyCode() != currencyCode {
+		return money.Money{}, fmt.Errorf("amount is in %s, expected %s", m.GetCurrencyCode(), currencyCode)
+	}
+
+	return money.New(money.Amount(m.GetAmount()), currencyCode), nil
+}
+
+// moneyFromPB reads an amount of a request that may be in any currency.
+// Amounts without a currency are taken to be in defaultCurrency.
+func moneyFromPB(m *loanpb.Money, defaultCurrency string) (money.Money, error) {
+	currencyCode := m.GetCurrencyCode()
+	if currencyCode == "" {
+		currencyCode = defaultCurrency
+	}
+
+	if err := money.Validate(currencyCode); err != nil {
+		return money.Money{}, err
+	}
+
+	amount, err := amountFromPB(m, currencyCode)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	return money.New(amount, currencyCode), nil
+}
+
+// isConversionError reports whether err comes from an unsupported currency
+// or a missing exchange rate, which the client can fix.
+func isConversionError(err error) bool {
+	return errors.Is(err, money.ErrUnknownCurrency) ||
+		errors.Is(err, money.ErrUnsupportedCurrency) ||
+		errors.Is(err, exchange.ErrRateNotFound)
+}
+
+// validationServiceError maps a request that failed validation to the error
+// returned to the client, with an entry per rejected field, or returns nil if
+// err is not a validation failure.
+func validationServiceError(err error) *loanpb.LoanServiceError {
+	var validationErr *usecase.ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+
+	fieldErrors := make([]*loanpb.FieldError, len(validationErr.Fields))
+	for index, field := range validationErr.Fields {
+		fieldErrors[index] = &loanpb.FieldError{
+			Field:       field.Field,
+			Description: field.Description,
+		}
+	}
+
+	return &loanpb.LoanServiceError{
+		Code:        1,
+		Description: validationErr.Error(),
+		FieldErrors: fieldErrors,
+	}
+}
+
+// amountsFromPB reads several amounts of a request in currencyCode.
+func amountsFromPB(currencyCode string, ms ...*loanpb.Money) ([]money.Amount, error) {
+	amounts := make([]money.Amount, len(ms))
+	for index, m := range ms {
+		amount, err := amountFromPB(m, currencyCode)
+		if err != nil {
+			return nil, err
+		}
+		amounts[index] = amount
+	}
+
+	return amounts, nil
+}
+
+func loanToPB(loan *dto.Loan) *loanpb.Loan {
+	var ownershipTransferredAt string
+	if !loan.OwnershipTransferredAt.IsZero() {
+		ownershipTransferredAt = loan.OwnershipTransferredAt.Format(time.RFC3339)
+	}
+
+	return &loanpb.Loan{
+		Id:                 fmt.Sprint(loan.Id),
+		ApplicationId:      fmt.Sprint(loan.ApplicationId),
+		UserId:             fmt.Sprint(loan.UserId),
+		CurrencyCode:       loan.CurrencyCode,
+		VehicleVin:         loan.VehicleVin,
+		Amount:             moneyToPB(loan.Amount, loan.CurrencyCode),
+		TermMonths:         loan.TermMonths,
+		MonthlyPayment:     moneyToPB(loan.MonthlyPayment, loan.CurrencyCode),
+		RemainingBalance:   moneyToPB(loan.RemainingBalance, loan.CurrencyCode),
+		ChargesOutstanding: moneyToPB(loan.ChargesOutstanding, loan.CurrencyCode),
+		TotalOutstanding:   moneyToPB(loan.RemainingBalance+loan.ChargesOutstanding, loan.CurrencyCode),
+		MarginRate:         loan.MarginRate,
+		RepaymentMethod:    loan.RepaymentMethod,
+		Status:             loan.Status,
+		DaysPastDue:        loan.DaysPastDue,
+		ContractNumber:     loan.ContractNumber,
+		CreatedAt:          loan.CreatedAt.Format(time.RFC3339),
+
+		OwnershipTransferredAt: ownershipTransferredAt,
+
+		LegacyAmount:             loan.Amount.Major(),
+		LegacyMonthlyPayment:     loan.MonthlyPayment.Major(),
+		LegacyRemainingBalance:   loan.RemainingBalance.Major(),
+		LegacyChargesOutstanding: loan.ChargesOutstanding.Major(),
+		LegacyTotalOutstanding:   (loan.RemainingBalance + loan.ChargesOutstanding).Major(),
+	}
+}
+
+func scheduleToPB(schedule []dto.RepaymentInstallment) []*loanpb.RepaymentInstallment {
+	schedulePB := make([]*loanpb.RepaymentInstallment, len(schedule))
+	for index, installment := range schedule {
+		schedulePB[index] = &loanpb.RepaymentInstallment{
+			Number:             installment.Number,
+			DueDate:            installment.DueDate.Format(time.DateOnly),
+			Payment:            moneyToPB(installment.Payment, installment.CurrencyCode),
+			Principal:          moneyToPB(installment.Principal, installment.CurrencyCode),
+			Margin:             moneyToPB(installment.Margin, installment.CurrencyCode),
+			OutstandingBalance: moneyToPB(installment.OutstandingBalance, installment.CurrencyCode),
+			OwnershipTransfer:  installment.OwnershipTransfer,
+
+			LegacyPayment:            installment.Payment.Major(),
+			LegacyPrincipal:          installment.Principal.Major(),
+			LegacyMargin:             installment.Margin.Major(),
+			LegacyOutstandingBalance: installment.OutstandingBalance.Major(),
+		}
+	}
+
+	return schedulePB
+}
+
+func applicationToPB(loanApp *dto.LoanApplication) *loanpb.LoanApplication {
+	var dealerNotifiedAt string
+	if !loanApp.DealerNotifiedAt.IsZero() {
+		dealerNotifiedAt = loanApp.DealerNotifiedAt.Format(time.RFC3339)
+	}
+
+	return &loanpb.LoanApplication{
+		Id:              fmt.Sprint(loanApp.Id),
+		UserId:          fmt.Sprint(loanApp.UserId),
+		Type:            loanApp.Type,
+		VehicleVin:      loanApp.VehicleVin,
+		VehicleName:     loanApp.VehicleName,
+		CurrencyCode:    loanApp.CurrencyCode,
+		Price:           moneyToPB(loanApp.Price, loanApp.CurrencyCode),
+		DownPayment:     moneyToPB(loanApp.DownPayment, loanApp.CurrencyCode),
+		NetPrice:        moneyToPB(loanApp.NetPrice, loanApp.CurrencyCode),
+		MarginRate:      loanApp.MarginRate,
+		TermMonths:      loanApp.TermMonths,
+		MonthlyPayment:  moneyToPB(loanApp.MonthlyPayment, loanApp.CurrencyCode),
+		RepaymentMethod: loanApp.RepaymentMethod,
+		Status:          loanApp.Status,
+		VehiclePrice:    moneyToPB(loanApp.VehiclePrice, loanApp.VehicleCurrencyCode),
+		ExchangeRate:    loanApp.ExchangeRate,
+		ContractNumber:  loanApp.ContractNumber,
+		ProductId:       optionalIdToPB(loanApp.ProductId),
+		CreatedAt:       loanApp.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       loanApp.UpdatedAt.Format(time.RFC3339),
+
+		DealerNotificationStatus:   loanApp.DealerNotificationStatus,
+		DealerNotificationAttempts: loanApp.DealerNotificationAttempts,
+		DealerNotificationError:    loanApp.DealerNotificationError,
+		DealerNotifiedAt:           dealerNotifiedAt,
+
+		LegacyPrice:          loanApp.Price.Major(),
+		LegacyDownPayment:    loanApp.DownPayment.Major(),
+		LegacyNetPrice:       loanApp.NetPrice.Major(),
+		LegacyMonthlyPayment: loanApp.MonthlyPayment.Major(),
+	}
+}
+
 func (h *LoanHandler) Calculate(ctx context.Context, calculateRequest *loanpb.CalculateRequest) (*loanpb.CalculateResponse, error) {
-	net, monthly, total := h.loanUC.Calculate(
-		calculateRequest.Price,
-		calculateRequest.DownPayment,
+	currencyCode := calculateRequest.GetCurrencyCode()
+	price, err := moneyFromPB(orLegacyAmount(calculateRequest.GetPrice(), calculateRequest.GetLegacyPrice()), currencyCode)
+	if err != nil {
+		return &loanpb.CalculateResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: err.Error(),
+			},
+		}, nil
+	}
+
+	downPayment, err := amountFromPB(orLegacyAmount(calculateRequest.GetDownPayment(), calculateRequest.GetLegacyDownPayment()), currencyCode)
+	if err != nil {
+		return &loanpb.CalculateResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: err.Error(),
+			},
+		}, nil
+	}
+
+	productId, err := parseOptionalId(calculateRequest.GetProductId())
+	if err != nil {
+		return &loanpb.CalculateResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: err.Error(),
+			},
+		}, nil
+	}
+
+	calculation, err := h.loanUC.Calculate(
+		ctx,
+		productId,
+		calculateRequest.GetType(),
+		calculateRequest.RepaymentMethod,
+		currencyCode,
+		price,
+		downPayment,
 		calculateRequest.TermMonths,
 		calculateRequest.MarginRate,
 	)
+	if err != nil {
+		// Terms the product does not offer
+		if validationErr := validationServiceError(err); validationErr != nil {
+			return &loanpb.CalculateResponse{
+				LoanServiceError: validationErr,
+			}, nil
+		}
+
+		if isConversionError(err) || errors.Is(err, calculator.ErrUnknownMethod) {
+			return &loanpb.CalculateResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        1,
+					Description: err.Error(),
+				},
+			}, nil
+		}
+
+		// Internal error
+		return &loanpb.CalculateResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to calculate",
+			},
+		}, nil
+	}
+
+	var schedulePB []*loanpb.RepaymentInstallment
+	if calculateRequest.GetIncludeSchedule() {
+		schedule, err := h.loanUC.CalculateSchedule(
+			calculation.RepaymentMethod,
+			currencyCode,
+			calculation.Price,
+			downPayment,
+			calculateRequest.TermMonths,
+			calculation.MarginRate,
+			time.Now(),
+		)
+		if err != nil {
+			return &loanpb.CalculateResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        1,
+					Description: err.Error(),
+				},
+			}, nil
+		}
+
+		schedulePB = scheduleToPB(schedule)
+	}
 
 	return &loanpb.CalculateResponse{
-		NetPrice:         net,
-		MonthlyPayment:   monthly,
-		TotalAmount:      total,
+		NetPrice:        moneyToPB(calculation.NetPrice, currencyCode),
+		MonthlyPayment:  moneyToPB(calculation.MonthlyPayment, currencyCode),
+		TotalAmount:     moneyToPB(calculation.TotalAmount, currencyCode),
+		Schedule:        schedulePB,
+		Price:           moneyToPB(calculation.Price, currencyCode),
+		ExchangeRate:    calculation.ExchangeRate,
+		MarginRate:      calculation.MarginRate,
+		RepaymentMethod: calculation.RepaymentMethod,
+		ProductId:       optionalIdToPB(calculation.ProductId),
+
+		LegacyNetPrice:       calculation.NetPrice.Major(),
+		LegacyMonthlyPayment: calculation.MonthlyPayment.Major(),
+		LegacyTotalAmount:    calculation.TotalAmount.Major(),
+
 		LoanServiceError: ok(),
 	}, nil
 }
@@ -73,22 +411,83 @@ func (h *LoanHandler) CreateApplication(ctx context.Context, req *loanpb.CreateA
 		}, nil
 	}
 
+	price, err := moneyFromPB(orLegacyAmount(req.GetPrice(), req.GetLegacyPrice()), req.GetCurrencyCode())
+	if err != nil {
+		return &loanpb.CreateApplicationResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: err.Error(),
+			},
+		}, nil
+	}
+
+	amounts, err := amountsFromPB(
+		req.GetCurrencyCode(),
+		orLegacyAmount(req.GetDownPayment(), req.GetLegacyDownPayment()),
+		orLegacyAmount(req.GetNetPrice(), req.GetLegacyNetPrice()),
+		orLegacyAmount(req.GetMonthlyPayment(), req.GetLegacyMonthlyPayment()),
+	)
+	if err != nil {
+		return &loanpb.CreateApplicationResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: err.Error(),
+			},
+		}, nil
+	}
+
+	productId, err := parseOptionalId(req.GetProductId())
+	if err != nil {
+		return &loanpb.CreateApplicationResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: err.Error(),
+			},
+		}, nil
+	}
+
 	createdLoanApp, err := h.loanUC.CreateApplication(ctx, &dto.LoanApplication{
-		Id:             0,
-		UserId:         userId,
-		Type:           req.GetType(),
-		VehicleVin:     req.GetVehicleVin(),
-		VehicleName:    req.GetVehicleName(),
-		CurrencyCode:   req.GetCurrencyCode(),
-		Price:          req.GetPrice(),
-		DownPayment:    req.GetDownPayment(),
-		MarginRate:     req.GetMarginRate(),
-		NetPrice:       req.GetNetPrice(),
-		TermMonths:     req.GetTermMonths(),
-		MonthlyPayment: req.GetMonthlyPayment(),
-		Status:         "NEW",
+		Id:                  0,
+		UserId:              userId,
+		Type:                req.GetType(),
+		VehicleVin:          req.GetVehicleVin(),
+		VehicleName:         req.GetVehicleName(),
+		CurrencyCode:        req.GetCurrencyCode(),
+		DownPayment:         amounts[0],
+		MarginRate:          req.GetMarginRate(),
+		NetPrice:            amounts[1],
+		TermMonths:          req.GetTermMonths(),
+		MonthlyPayment:      amounts[2],
+		RepaymentMethod:     req.GetRepaymentMethod(),
+		Status:              "NEW",
+		VehiclePrice:        price.Amount,
+		VehicleCurrencyCode: price.Currency,
+		ProductId:           productId,
 	})
 	if err != nil {
+		// Figures that do not add up or break the terms of the product
+		if validationErr := validationServiceError(err); validationErr != nil {
+			return &loanpb.CreateApplicationResponse{
+				LoanServiceError: validationErr,
+			}, nil
+		}
+
+		// Not in or rejected by the Koinot Auto catalog
+		if vehicleErr := vehicleServiceError(err); vehicleErr != nil {
+			return &loanpb.CreateApplicationResponse{
+				LoanServiceError: vehicleErr,
+			}, nil
+		}
+
+		if isConversionError(err) || errors.Is(err, calculator.ErrUnknownMethod) {
+			return &loanpb.CreateApplicationResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        1,
+					Description: err.Error(),
+				},
+			}, nil
+		}
+
 		return &loanpb.CreateApplicationResponse{
 			LoanServiceError: &loanpb.LoanServiceError{
 				Code:        5,
@@ -98,23 +497,7 @@ func (h *LoanHandler) CreateApplication(ctx context.Context, req *loanpb.CreateA
 	}
 
 	return &loanpb.CreateApplicationResponse{
-		Application: &loanpb.LoanApplication{
-			Id:             fmt.Sprint(createdLoanApp.Id),
-			UserId:         fmt.Sprint(createdLoanApp.UserId),
-			Type:           createdLoanApp.Type,
-			VehicleVin:     createdLoanApp.VehicleVin,
-			VehicleName:    createdLoanApp.VehicleName,
-			CurrencyCode:   createdLoanApp.CurrencyCode,
-			Price:          createdLoanApp.Price,
-			DownPayment:    createdLoanApp.DownPayment,
-			NetPrice:       createdLoanApp.NetPrice,
-			MarginRate:     createdLoanApp.MarginRate,
-			TermMonths:     createdLoanApp.TermMonths,
-			MonthlyPayment: createdLoanApp.MonthlyPayment,
-			Status:         createdLoanApp.Status,
-			CreatedAt:      createdLoanApp.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:      createdLoanApp.UpdatedAt.Format(time.RFC3339),
-		},
+		Application:      applicationToPB(createdLoanApp),
 		LoanServiceError: ok(),
 	}, nil
 }
@@ -161,23 +544,179 @@ func (h *LoanHandler) GetApplication(ctx context.Context, req *loanpb.GetApplica
 	}
 
 	return &loanpb.GetApplicationResponse{
-		Application: &loanpb.LoanApplication{
-			Id:             fmt.Sprint(loanApplication.Id),
-			UserId:         fmt.Sprint(loanApplication.UserId),
-			Type:           loanApplication.Type,
-			VehicleVin:     loanApplication.VehicleVin,
-			VehicleName:    loanApplication.VehicleName,
-			CurrencyCode:   loanApplication.CurrencyCode,
-			Price:          loanApplication.Price,
-			DownPayment:    loanApplication.DownPayment,
-			NetPrice:       loanApplication.NetPrice,
-			MarginRate:     loanApplication.MarginRate,
-			TermMonths:     loanApplication.TermMonths,
-			MonthlyPayment: loanApplication.MonthlyPayment,
-			Status:         loanApplication.Status,
-			CreatedAt:      loanApplication.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:      loanApplication.UpdatedAt.Format(time.RFC3339),
-		},
+		Application:      applicationToPB(loanApplication),
+		LoanServiceError: ok(),
+	}, nil
+}
+
+func (h *LoanHandler) ReviewApplication(ctx context.Context, req *loanpb.ReviewApplicationRequest) (*loanpb.ReviewApplicationResponse, error) {
+	loanApp, loanServiceError := h.changeApplicationStatus(ctx, req.GetId(), req.GetActor(), req.GetReason(), false, h.loanUC.ReviewApplication)
+
+	return &loanpb.ReviewApplicationResponse{
+		Application:      loanApp,
+		LoanServiceError: loanServiceError,
+	}, nil
+}
+
+func (h *LoanHandler) ApproveApplication(ctx context.Context, req *loanpb.ApproveApplicationRequest) (*loanpb.ApproveApplicationResponse, error) {
+	loanApp, loanServiceError := h.changeApplicationStatus(ctx, req.GetId(), req.GetActor(), req.GetReason(), false, h.loanUC.ApproveApplication)
+
+	return &loanpb.ApproveApplicationResponse{
+		Application:      loanApp,
+		LoanServiceError: loanServiceError,
+	}, nil
+}
+
+func (h *LoanHandler) RejectApplication(ctx context.Context, req *loanpb.RejectApplicationRequest) (*loanpb.RejectApplicationResponse, error) {
+	loanApp, loanServiceError := h.changeApplicationStatus(ctx, req.GetId(), req.GetActor(), req.GetReason(), true, h.loanUC.RejectApplication)
+
+	return &loanpb.RejectApplicationResponse{
+		Application:      loanApp,
+		LoanServiceError: loanServiceError,
+	}, nil
+}
+
+// changeApplicationStatus validates a status change request and maps the
+// usecase result onto the response fields shared by the status RPCs.
+func (h *LoanHandler) changeApplicationStatus(
+	ctx context.Context,
+	id, actor, reason string,
+	reasonRequired bool,
+	change func(ctx context.Context, id int64, actor, reason string) (*dto.LoanApplication, error),
+) (*loanpb.LoanApplication, *loanpb.LoanServiceError) {
+	if id == "" {
+		return nil, &loanpb.LoanServiceError{
+			Code:        1,
+			Description: "id is required",
+		}
+	}
+
+	loanAppId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, &loanpb.LoanServiceError{
+			Code:        1,
+			Description: fmt.Sprintf("invalid id %q", id),
+		}
+	}
+
+	if actor == "" {
+		return nil, &loanpb.LoanServiceError{
+			Code:        1,
+			Description: "actor is required",
+		}
+	}
+
+	if reasonRequired && reason == "" {
+		return nil, &loanpb.LoanServiceError{
+			Code:        1,
+			Description: "reason is required",
+		}
+	}
+
+	loanApp, err := change(ctx, loanAppId, actor, reason)
+	if err != nil {
+		// Not found
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &loanpb.LoanServiceError{
+				Code:        2,
+				Description: "application not found",
+			}
+		}
+
+		// Illegal transition
+		if errors.Is(err, usecase.ErrInvalidStatusTransition) {
+			return nil, &loanpb.LoanServiceError{
+				Code:        3,
+				Description: err.Error(),
+			}
+		}
+
+		// Internal error
+		return nil, &loanpb.LoanServiceError{
+			Code:        5,
+			Description: "failed to change application status",
+		}
+	}
+
+	return applicationToPB(loanApp), ok()
+}
+
+func (h *LoanHandler) CreateLoan(ctx context.Context, req *loanpb.CreateLoanRequest) (*loanpb.CreateLoanResponse, error) {
+	if req.GetApplicationId() == "" {
+		return &loanpb.CreateLoanResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "application id is required",
+			},
+		}, nil
+	}
+
+	applicationId, err := strconv.ParseInt(req.GetApplicationId(), 10, 64)
+	if err != nil {
+		return &loanpb.CreateLoanResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid application id %q", req.GetApplicationId()),
+			},
+		}, nil
+	}
+
+	if req.GetActor() == "" {
+		return &loanpb.CreateLoanResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "actor is required",
+			},
+		}, nil
+	}
+
+	loan, err := h.loanUC.CreateLoan(ctx, applicationId, req.GetActor())
+	if err != nil {
+		// Not found
+		if errors.Is(err, sql.ErrNoRows) {
+			return &loanpb.CreateLoanResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        2,
+					Description: "application not found",
+				},
+			}, nil
+		}
+
+		// Application is not approved, already originated or being originated
+		if errors.Is(err, usecase.ErrApplicationNotApproved) || errors.Is(err, usecase.ErrOriginationInProgress) {
+			return &loanpb.CreateLoanResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        3,
+					Description: err.Error(),
+				},
+			}, nil
+		}
+
+		// Rejected by or failed in ASR Leasing
+		if asrErr := asrServiceError(err); asrErr != nil {
+			return &loanpb.CreateLoanResponse{
+				LoanServiceError: asrErr,
+			}, nil
+		}
+
+		// Vehicle could not be sold to the customer by Koinot Auto
+		if vehicleErr := vehicleServiceError(err); vehicleErr != nil {
+			return &loanpb.CreateLoanResponse{
+				LoanServiceError: vehicleErr,
+			}, nil
+		}
+
+		// Internal error
+		return &loanpb.CreateLoanResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to create loan",
+			},
+		}, nil
+	}
+
+	return &loanpb.CreateLoanResponse{
+		Loan:             loanToPB(loan),
 		LoanServiceError: ok(),
 	}, nil
 }
@@ -224,19 +763,54 @@ func (h *LoanHandler) GetLoan(ctx context.Context, req *loanpb.GetLoanRequest) (
 	}
 
 	return &loanpb.GetLoanResponse{
-		Loan: &loanpb.Loan{
-			Id:               fmt.Sprint(loan.Id),
-			ApplicationId:    fmt.Sprint(loan.ApplicationId),
-			UserId:           fmt.Sprint(loan.UserId),
-			CurrencyCode:     loan.CurrencyCode,
-			VehicleVin:       loan.VehicleVin,
-			Amount:           loan.Amount,
-			TermMonths:       loan.TermMonths,
-			MonthlyPayment:   loan.MonthlyPayment,
-			RemainingBalance: loan.RemainingBalance,
-			Status:           loan.Status,
-			CreatedAt:        loan.CreatedAt.Format(time.RFC3339),
-		},
+		Loan:             loanToPB(loan),
+		LoanServiceError: ok(),
+	}, nil
+}
+
+func (h *LoanHandler) GetRepaymentSchedule(ctx context.Context, req *loanpb.GetRepaymentScheduleRequest) (*loanpb.GetRepaymentScheduleResponse, error) {
+	if req.GetLoanId() == "" {
+		return &loanpb.GetRepaymentScheduleResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "loan id is required",
+			},
+		}, nil
+	}
+
+	loanId, err := strconv.ParseInt(req.GetLoanId(), 10, 64)
+	if err != nil {
+		return &loanpb.GetRepaymentScheduleResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid loan id %q", req.GetLoanId()),
+			},
+		}, nil
+	}
+
+	schedule, err := h.loanUC.GetRepaymentSchedule(ctx, loanId)
+	if err != nil {
+		// Not found
+		if errors.Is(err, sql.ErrNoRows) {
+			return &loanpb.GetRepaymentScheduleResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        2,
+					Description: "loan not found",
+				},
+			}, nil
+		}
+
+		// Internal error
+		return &loanpb.GetRepaymentScheduleResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to build repayment schedule",
+			},
+		}, nil
+	}
+
+	return &loanpb.GetRepaymentScheduleResponse{
+		Schedule:         scheduleToPB(schedule),
 		LoanServiceError: ok(),
 	}, nil
 }
@@ -310,23 +884,7 @@ func (h *LoanHandler) ListApplications(ctx context.Context, req *loanpb.ListAppl
 
 	listLoanAppsPB := make([]*loanpb.LoanApplication, len(loanApps))
 	for index, loanApp := range loanApps {
-		listLoanAppsPB[index] = &loanpb.LoanApplication{
-			Id:             fmt.Sprint(loanApp.Id),
-			UserId:         fmt.Sprint(loanApp.UserId),
-			Type:           loanApp.Type,
-			VehicleVin:     loanApp.VehicleVin,
-			VehicleName:    loanApp.VehicleName,
-			CurrencyCode:   loanApp.CurrencyCode,
-			Price:          loanApp.Price,
-			DownPayment:    loanApp.DownPayment,
-			NetPrice:       loanApp.NetPrice,
-			MarginRate:     loanApp.MarginRate,
-			TermMonths:     loanApp.TermMonths,
-			MonthlyPayment: loanApp.MonthlyPayment,
-			Status:         loanApp.Status,
-			CreatedAt:      loanApp.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:      loanApp.UpdatedAt.Format(time.RFC3339),
-		}
+		listLoanAppsPB[index] = applicationToPB(loanApp)
 	}
 
 	totalPages := *loanAppsCount / int64(pageInfo.Limit)
@@ -414,19 +972,7 @@ func (h *LoanHandler) ListLoans(ctx context.Context, req *loanpb.ListLoansReques
 
 	listLoansPB := make([]*loanpb.Loan, len(loans))
 	for index, loan := range loans {
-		listLoansPB[index] = &loanpb.Loan{
-			Id:               fmt.Sprint(loan.Id),
-			ApplicationId:    fmt.Sprint(loan.ApplicationId),
-			UserId:           fmt.Sprint(loan.UserId),
-			CurrencyCode:     loan.CurrencyCode,
-			VehicleVin:       loan.VehicleVin,
-			Amount:           loan.Amount,
-			TermMonths:       loan.TermMonths,
-			MonthlyPayment:   loan.MonthlyPayment,
-			RemainingBalance: loan.RemainingBalance,
-			Status:           loan.Status,
-			CreatedAt:        loan.CreatedAt.Format(time.RFC3339),
-		}
+		listLoansPB[index] = loanToPB(loan)
 	}
 
 	totalPages := *loansCount / int64(pageInfo.Limit)
@@ -444,33 +990,3 @@ func (h *LoanHandler) ListLoans(ctx context.Context, req *loanpb.ListLoansReques
 		LoanServiceError: ok(),
 	}, nil
 }
-
-func (h *LoanHandler) ListVehicles(ctx context.Context, req *loanpb.ListVehiclesRequest) (*loanpb.ListVehiclesResponse, error) {
-	vehicles, err := h.loanUC.ListVehicles(ctx)
-	if err != nil {
-		return &loanpb.ListVehiclesResponse{
-			LoanServiceError: &loanpb.LoanServiceError{
-				Code:        5,
-				Description: "failed to get vehicles",
-			},
-		}, nil
-	}
-
-	vehiclesPB := make([]*loanpb.Vehicle, len(vehicles))
-	for index, vehicle := range vehicles {
-		vehiclesPB[index] = &loanpb.Vehicle{
-			ImageUrl:      vehicle.ImageURL,
-			Vin:           vehicle.Vin,
-			Name:          vehicle.Name,
-			EngineType:    vehicle.EngineType,
-			Configuration: vehicle.Configuration,
-			Price:         vehicle.Price,
-			CurrencyCode:  vehicle.CurrencyCode,
-		}
-	}
-
-	return &loanpb.ListVehiclesResponse{
-		Vehicles:         vehiclesPB,
-		LoanServiceError: ok(),
-	}, nil
-}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"loan_service/internal/dto"
+	loanpb "loan_service/internal/proto/loan"
+	"strconv"
+	"time"
+)
+
+func installmentToPB(installment *dto.Installment) *loanpb.Installment {
+	var paidAt string
+	if !installment.PaidAt.IsZero() {
+		paidAt = installment.PaidAt.Format(time.RFC3339)
+	}
+
+	return &loanpb.Installment{
+		Id:            fmt.Sprint(installment.Id),
+		LoanId:        fmt.Sprint(installment.LoanId),
+		Number:        installment.Number,
+		DueDate:       installment.DueDate.Format(time.DateOnly),
+		PrincipalDue:  moneyToPB(installment.PrincipalDue, installment.CurrencyCode),
+		MarginDue:     moneyToPB(installment.MarginDue, installment.CurrencyCode),
+		PrincipalPaid: moneyToPB(installment.PrincipalPaid, installment.CurrencyCode),
+		MarginPaid:    moneyToPB(installment.MarginPaid, installment.CurrencyCode),
+		Status:        installment.Status,
+		PaidAt:        paidAt,
+
+		LegacyPrincipalDue:  installment.PrincipalDue.Major(),
+		LegacyMarginDue:     installment.MarginDue.Major(),
+		LegacyPrincipalPaid: installment.PrincipalPaid.Major(),
+		LegacyMarginPaid:    installment.MarginPaid.Major(),
+	}
+}
+
+func (h *LoanHandler) ListInstallments(ctx context.Context, req *loanpb.ListInstallmentsRequest) (*loanpb.ListInstallmentsResponse, error) {
+	if req.GetLoanId() == "" {
+		return &loanpb.ListInstallmentsResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "loan id is required",
+			},
+		}, nil
+	}
+
+	loanId, err := strconv.ParseInt(req.GetLoanId(), 10, 64)
+	if err != nil {
+		return &loanpb.ListInstallmentsResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid loan id %q", req.GetLoanId()),
+			},
+		}, nil
+	}
+
+	installments, err := h.loanUC.ListInstallments(ctx, loanId)
+	if err != nil {
+		// Not found
+		if errors.Is(err, sql.ErrNoRows) {
+			return &loanpb.ListInstallmentsResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        2,
+					Description: "loan not found",
+				},
+			}, nil
+		}
+
+		// Internal error
+		return &loanpb.ListInstallmentsResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to fetch installments",
+			},
+		}, nil
+	}
+
+	installmentsPB := make([]*loanpb.Installment, len(installments))
+	for index, installment := range installments {
+		installmentsPB[index] = installmentToPB(installment)
+	}
+
+	return &loanpb.ListInstallmentsResponse{
+		Installments:     installmentsPB,
+		LoanServiceError: ok(),
+	}, nil
+}
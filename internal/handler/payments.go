@@ -0,0 +1,366 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"loan_service/internal/clients"
+	"loan_service/internal/dto"
+	loanpb "loan_service/internal/proto/loan"
+	"loan_service/internal/usecase"
+	"loan_service/pkg/money"
+	"strconv"
+	"time"
+)
+
+func paymentToPB(payment *dto.Payment) *loanpb.Payment {
+	return &loanpb.Payment{
+		Id:            fmt.Sprint(payment.Id),
+		LoanId:        fmt.Sprint(payment.LoanId),
+		CurrencyCode:  payment.CurrencyCode,
+		PaymentDate:   payment.PaymentDate.Format(time.RFC3339),
+		Amount:        moneyToPB(payment.Amount, payment.CurrencyCode),
+		Method:        payment.Method,
+		Status:        payment.Status,
+		TransactionId: payment.TransactionId,
+		CreatedAt:     payment.CreatedAt.Format(time.RFC3339),
+
+		LegacyAmount: payment.Amount.Major(),
+	}
+}
+
+func (h *LoanHandler) RecordPayment(ctx context.Context, req *loanpb.RecordPaymentRequest) (*loanpb.RecordPaymentResponse, error) {
+	if req.GetLoanId() == "" {
+		return &loanpb.RecordPaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "loan id is required",
+			},
+		}, nil
+	}
+
+	loanId, err := strconv.ParseInt(req.GetLoanId(), 10, 64)
+	if err != nil {
+		return &loanpb.RecordPaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid loan id %q", req.GetLoanId()),
+			},
+		}, nil
+	}
+
+	amount, err := amountFromPB(orLegacyAmount(req.GetAmount(), req.GetLegacyAmount()), req.GetCurrencyCode())
+	if err != nil {
+		return &loanpb.RecordPaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: err.Error(),
+			},
+		}, nil
+	}
+
+	if amount <= 0 {
+		return &loanpb.RecordPaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "amount must be positive",
+			},
+		}, nil
+	}
+
+	var paymentDate time.Time
+	if req.GetPaymentDate() != "" {
+		paymentDate, err = time.Parse(time.RFC3339, req.GetPaymentDate())
+		if err != nil {
+			return &loanpb.RecordPaymentResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        1,
+					Description: fmt.Sprintf("invalid payment date %q", req.GetPaymentDate()),
+				},
+			}, nil
+		}
+	}
+
+	payment, loan, err := h.loanUC.RecordPayment(ctx, &dto.Payment{
+		LoanId:        loanId,
+		CurrencyCode:  req.GetCurrencyCode(),
+		PaymentDate:   paymentDate,
+		Amount:        amount,
+		Method:        req.GetMethod(),
+		TransactionId: req.GetTransactionId(),
+	})
+	if err != nil {
+		// Not found
+		if errors.Is(err, sql.ErrNoRows) {
+			return &loanpb.RecordPaymentResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        2,
+					Description: "loan not found",
+				},
+			}, nil
+		}
+
+		// Payment rejected by loan state
+		if errors.Is(err, usecase.ErrLoanClosed) ||
+			errors.Is(err, usecase.ErrCurrencyMismatch) ||
+			errors.Is(err, usecase.ErrPaymentExceedsBalance) ||
+			errors.Is(err, usecase.ErrDuplicateTransaction) ||
+			errors.Is(err, usecase.ErrPaymentMismatch) {
+			return &loanpb.RecordPaymentResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        3,
+					Description: err.Error(),
+				},
+			}, nil
+		}
+
+		// Internal error
+		return &loanpb.RecordPaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to record payment",
+			},
+		}, nil
+	}
+
+	return &loanpb.RecordPaymentResponse{
+		Payment:          paymentToPB(payment),
+		Loan:             loanToPB(loan),
+		LoanServiceError: ok(),
+	}, nil
+}
+
+func (h *LoanHandler) GetPayment(ctx context.Context, req *loanpb.GetPaymentRequest) (*loanpb.GetPaymentResponse, error) {
+	if req.GetId() == "" {
+		return &loanpb.GetPaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "id is required",
+			},
+		}, nil
+	}
+
+	paymentId, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &loanpb.GetPaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid id %q", req.GetId()),
+			},
+		}, nil
+	}
+
+	payment, err := h.loanUC.GetPayment(ctx, paymentId)
+	if err != nil {
+		// Not found
+		if errors.Is(err, sql.ErrNoRows) {
+			return &loanpb.GetPaymentResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        2,
+					Description: "payment not found",
+				},
+			}, nil
+		}
+
+		// Internal error
+		return &loanpb.GetPaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to fetch payment",
+			},
+		}, nil
+	}
+
+	return &loanpb.GetPaymentResponse{
+		Payment:          paymentToPB(payment),
+		LoanServiceError: ok(),
+	}, nil
+}
+
+func (h *LoanHandler) ListPayments(ctx context.Context, req *loanpb.ListPaymentsRequest) (*loanpb.ListPaymentsResponse, error) {
+	if req.GetLoanId() == "" {
+		return &loanpb.ListPaymentsResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "loan id is required",
+			},
+		}, nil
+	}
+
+	loanId, err := strconv.ParseInt(req.GetLoanId(), 10, 64)
+	if err != nil {
+		return &loanpb.ListPaymentsResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid loan id %q", req.GetLoanId()),
+			},
+		}, nil
+	}
+
+	limit, offset := pageToLimitOffset(req.GetPage())
+	currentPage := offset/limit + 1
+
+	paymentsCount, err := h.loanUC.CountPayments(ctx, loanId)
+	if err != nil {
+		return &loanpb.ListPaymentsResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to fetch payments",
+			},
+		}, nil
+	}
+
+	if *paymentsCount == 0 {
+		return &loanpb.ListPaymentsResponse{
+			Payments: nil,
+			Page: &loanpb.PageResponse{
+				CurrentPage: currentPage,
+				Limit:       limit,
+				TotalItems:  0,
+				TotalPages:  0,
+			},
+			LoanServiceError: ok(),
+		}, nil
+	}
+
+	payments, err := h.loanUC.ListPayments(ctx, loanId, limit, offset)
+	if err != nil {
+		return &loanpb.ListPaymentsResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to fetch payments",
+			},
+		}, nil
+	}
+
+	listPaymentsPB := make([]*loanpb.Payment, len(payments))
+	for index, payment := range payments {
+		listPaymentsPB[index] = paymentToPB(payment)
+	}
+
+	totalPages := *paymentsCount / int64(limit)
+	if *paymentsCount%int64(limit) != 0 {
+		totalPages++
+	}
+	return &loanpb.ListPaymentsResponse{
+		Payments: listPaymentsPB,
+		Page: &loanpb.PageResponse{
+			CurrentPage: currentPage,
+			Limit:       limit,
+			TotalItems:  int32(*paymentsCount),
+			TotalPages:  int32(totalPages),
+		},
+		LoanServiceError: ok(),
+	}, nil
+}
+
+func (h *LoanHandler) InitiateInstallmentPayment(ctx context.Context, req *loanpb.InitiateInstallmentPaymentRequest) (*loanpb.InitiateInstallmentPaymentResponse, error) {
+	if req.GetLoanId() == "" {
+		return &loanpb.InitiateInstallmentPaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "loan id is required",
+			},
+		}, nil
+	}
+
+	loanId, err := strconv.ParseInt(req.GetLoanId(), 10, 64)
+	if err != nil {
+		return &loanpb.InitiateInstallmentPaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid loan id %q", req.GetLoanId()),
+			},
+		}, nil
+	}
+
+	if req.GetMethod() != "CARD" && req.GetMethod() != "WALLET" {
+		return &loanpb.InitiateInstallmentPaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid method %q, expected CARD or WALLET", req.GetMethod()),
+			},
+		}, nil
+	}
+
+	if req.GetPaymentSourceId() == "" {
+		return &loanpb.InitiateInstallmentPaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "payment source id is required",
+			},
+		}, nil
+	}
+
+	var amount money.Money
+	if req.GetAmount() != nil {
+		if amount, err = loanMoneyFromPB(req.GetAmount(), ""); err != nil {
+			return &loanpb.InitiateInstallmentPaymentResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        1,
+					Description: err.Error(),
+				},
+			}, nil
+		}
+
+		if amount.Amount < 0 {
+			return &loanpb.InitiateInstallmentPaymentResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        1,
+					Description: "amount must not be negative",
+				},
+			}, nil
+		}
+	}
+
+	payment, err := h.loanUC.InitiateInstallmentPayment(ctx, loanId, amount, req.GetMethod(), req.GetPaymentSourceId())
+	if err != nil {
+		// Not found
+		if errors.Is(err, sql.ErrNoRows) {
+			return &loanpb.InitiateInstallmentPaymentResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        2,
+					Description: "loan not found",
+				},
+			}, nil
+		}
+
+		// Invalid amount
+		if errors.Is(err, usecase.ErrInvalidAmount) {
+			return &loanpb.InitiateInstallmentPaymentResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        1,
+					Description: err.Error(),
+				},
+			}, nil
+		}
+
+		// Payment rejected by loan state or by the payment service
+		if errors.Is(err, usecase.ErrLoanClosed) ||
+			errors.Is(err, usecase.ErrCurrencyMismatch) ||
+			errors.Is(err, usecase.ErrPaymentExceedsBalance) ||
+			errors.Is(err, usecase.ErrNothingDue) ||
+			errors.Is(err, clients.ErrChargeRejected) {
+			return &loanpb.InitiateInstallmentPaymentResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        3,
+					Description: err.Error(),
+				},
+			}, nil
+		}
+
+		// Internal error
+		return &loanpb.InitiateInstallmentPaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to initiate payment",
+			},
+		}, nil
+	}
+
+	return &loanpb.InitiateInstallmentPaymentResponse{
+		Payment:          paymentToPB(payment),
+		LoanServiceError: ok(),
+	}, nil
+}
@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"loan_service/internal/dto"
+	loanpb "loan_service/internal/proto/loan"
+	"loan_service/internal/usecase"
+	"strconv"
+	"time"
+)
+
+func payoffQuoteToPB(quote *dto.PayoffQuote) *loanpb.PayoffQuote {
+	return &loanpb.PayoffQuote{
+		Id:            fmt.Sprint(quote.Id),
+		LoanId:        fmt.Sprint(quote.LoanId),
+		Principal:     moneyToPB(quote.Principal, quote.CurrencyCode),
+		Margin:        moneyToPB(quote.Margin, quote.CurrencyCode),
+		MarginWaived:  moneyToPB(quote.MarginWaived, quote.CurrencyCode),
+		Charges:       moneyToPB(quote.Charges, quote.CurrencyCode),
+		SettlementFee: moneyToPB(quote.SettlementFee, quote.CurrencyCode),
+		Total:         moneyToPB(quote.Total, quote.CurrencyCode),
+		ValidUntil:    quote.ValidUntil.Format(time.RFC3339),
+		Status:        quote.Status,
+		CreatedAt:     quote.CreatedAt.Format(time.RFC3339),
+
+		LegacyPrincipal:     quote.Principal.Major(),
+		LegacyMargin:        quote.Margin.Major(),
+		LegacyMarginWaived:  quote.MarginWaived.Major(),
+		LegacyCharges:       quote.Charges.Major(),
+		LegacySettlementFee: quote.SettlementFee.Major(),
+		LegacyTotal:         quote.Total.Major(),
+	}
+}
+
+func (h *LoanHandler) GetPayoffQuote(ctx context.Context, req *loanpb.GetPayoffQuoteRequest) (*loanpb.GetPayoffQuoteResponse, error) {
+	if req.GetLoanId() == "" {
+		return &loanpb.GetPayoffQuoteResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "loan id is required",
+			},
+		}, nil
+	}
+
+	loanId, err := strconv.ParseInt(req.GetLoanId(), 10, 64)
+	if err != nil {
+		return &loanpb.GetPayoffQuoteResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid loan id %q", req.GetLoanId()),
+			},
+		}, nil
+	}
+
+	quote, err := h.loanUC.GetPayoffQuote(ctx, loanId)
+	if err != nil {
+		// Not found
+		if errors.Is(err, sql.ErrNoRows) {
+			return &loanpb.GetPayoffQuoteResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        2,
+					Description: "loan not found",
+				},
+			}, nil
+		}
+
+		// Loan already closed
+		if errors.Is(err, usecase.ErrLoanClosed) {
+			return &loanpb.GetPayoffQuoteResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        3,
+					Description: err.Error(),
+				},
+			}, nil
+		}
+
+		// Internal error
+		return &loanpb.GetPayoffQuoteResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to quote payoff",
+			},
+		}, nil
+	}
+
+	return &loanpb.GetPayoffQuoteResponse{
+		Quote:            payoffQuoteToPB(quote),
+		LoanServiceError: ok(),
+	}, nil
+}
+
+func (h *LoanHandler) SettleLoan(ctx context.Context, req *loanpb.SettleLoanRequest) (*loanpb.SettleLoanResponse, error) {
+	if req.GetQuoteId() == "" {
+		return &loanpb.SettleLoanResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "quote id is required",
+			},
+		}, nil
+	}
+
+	quoteId, err := strconv.ParseInt(req.GetQuoteId(), 10, 64)
+	if err != nil {
+		return &loanpb.SettleLoanResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid quote id %q", req.GetQuoteId()),
+			},
+		}, nil
+	}
+
+	var paymentDate time.Time
+	if req.GetPaymentDate() != "" {
+		paymentDate, err = time.Parse(time.RFC3339, req.GetPaymentDate())
+		if err != nil {
+			return &loanpb.SettleLoanResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        1,
+					Description: fmt.Sprintf("invalid payment date %q", req.GetPaymentDate()),
+				},
+			}, nil
+		}
+	}
+
+	quote, loan, payment, err := h.loanUC.SettleLoan(ctx, quoteId, &dto.Payment{
+		CurrencyCode:  req.GetCurrencyCode(),
+		PaymentDate:   paymentDate,
+		Method:        req.GetMethod(),
+		TransactionId: req.GetTransactionId(),
+	})
+	if err != nil {
+		// Not found
+		if errors.Is(err, sql.ErrNoRows) {
+			return &loanpb.SettleLoanResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        2,
+					Description: "payoff quote not found",
+				},
+			}, nil
+		}
+
+		// Quote no longer applicable
+		if errors.Is(err, usecase.ErrPayoffQuoteExpired) ||
+			errors.Is(err, usecase.ErrPayoffQuoteUsed) ||
+			errors.Is(err, usecase.ErrPayoffQuoteStale) ||
+			errors.Is(err, usecase.ErrLoanClosed) ||
+			errors.Is(err, usecase.ErrCurrencyMismatch) ||
+			errors.Is(err, usecase.ErrDuplicateTransaction) {
+			return &loanpb.SettleLoanResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        3,
+					Description: err.Error(),
+				},
+			}, nil
+		}
+
+		// Internal error
+		return &loanpb.SettleLoanResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to settle loan",
+			},
+		}, nil
+	}
+
+	return &loanpb.SettleLoanResponse{
+		Quote:            payoffQuoteToPB(quote),
+		Loan:             loanToPB(loan),
+		Payment:          paymentToPB(payment),
+		LoanServiceError: ok(),
+	}, nil
+}
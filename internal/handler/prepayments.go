@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"loan_service/internal/dto"
+	loanpb "loan_service/internal/proto/loan"
+	"loan_service/internal/usecase"
+	"strconv"
+	"time"
+)
+
+func prepaymentQuoteToPB(quote *dto.PrepaymentQuote) *loanpb.PrepaymentQuote {
+	return &loanpb.PrepaymentQuote{
+		LoanId:           fmt.Sprint(quote.LoanId),
+		Amount:           moneyToPB(quote.Amount, quote.CurrencyCode),
+		Mode:             quote.Mode,
+		TermMonths:       quote.TermMonths,
+		MonthlyPayment:   moneyToPB(quote.MonthlyPayment, quote.CurrencyCode),
+		RemainingBalance: moneyToPB(quote.RemainingBalance, quote.CurrencyCode),
+		MarginSaved:      moneyToPB(quote.MarginSaved, quote.CurrencyCode),
+		Schedule:         scheduleToPB(quote.Schedule),
+
+		LegacyAmount:           quote.Amount.Major(),
+		LegacyMonthlyPayment:   quote.MonthlyPayment.Major(),
+		LegacyRemainingBalance: quote.RemainingBalance.Major(),
+		LegacyMarginSaved:      quote.MarginSaved.Major(),
+	}
+}
+
+// prepaymentErrorToPB maps the errors shared by QuotePrepayment and
+// ApplyPrepayment to a LoanServiceError.
+func prepaymentErrorToPB(err error, internalDescription string) *loanpb.LoanServiceError {
+	// Not found
+	if errors.Is(err, sql.ErrNoRows) {
+		return &loanpb.LoanServiceError{
+			Code:        2,
+			Description: "loan not found",
+		}
+	}
+
+	// Invalid mode or amount
+	if errors.Is(err, usecase.ErrUnknownPrepaymentMode) ||
+		errors.Is(err, usecase.ErrInvalidAmount) {
+		return &loanpb.LoanServiceError{
+			Code:        1,
+			Description: err.Error(),
+		}
+	}
+
+	// Prepayment rejected by loan state
+	if errors.Is(err, usecase.ErrLoanClosed) ||
+		errors.Is(err, usecase.ErrPrepaymentNotAllowed) ||
+		errors.Is(err, usecase.ErrPrepaymentExceedsPrincipal) ||
+		errors.Is(err, usecase.ErrCurrencyMismatch) ||
+		errors.Is(err, usecase.ErrDuplicateTransaction) {
+		return &loanpb.LoanServiceError{
+			Code:        3,
+			Description: err.Error(),
+		}
+	}
+
+	// Internal error
+	return &loanpb.LoanServiceError{
+		Code:        5,
+		Description: internalDescription,
+	}
+}
+
+func (h *LoanHandler) QuotePrepayment(ctx context.Context, req *loanpb.QuotePrepaymentRequest) (*loanpb.QuotePrepaymentResponse, error) {
+	if req.GetLoanId() == "" {
+		return &loanpb.QuotePrepaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "loan id is required",
+			},
+		}, nil
+	}
+
+	loanId, err := strconv.ParseInt(req.GetLoanId(), 10, 64)
+	if err != nil {
+		return &loanpb.QuotePrepaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid loan id %q", req.GetLoanId()),
+			},
+		}, nil
+	}
+
+	amount, err := loanMoneyFromPB(orLegacyAmount(req.GetAmount(), req.GetLegacyAmount()), "")
+	if err != nil {
+		return &loanpb.QuotePrepaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: err.Error(),
+			},
+		}, nil
+	}
+
+	if amount.Amount <= 0 {
+		return &loanpb.QuotePrepaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "amount must be positive",
+			},
+		}, nil
+	}
+
+	quote, err := h.loanUC.QuotePrepayment(ctx, loanId, amount, req.GetMode())
+	if err != nil {
+		return &loanpb.QuotePrepaymentResponse{
+			LoanServiceError: prepaymentErrorToPB(err, "failed to quote prepayment"),
+		}, nil
+	}
+
+	return &loanpb.QuotePrepaymentResponse{
+		Quote:            prepaymentQuoteToPB(quote),
+		LoanServiceError: ok(),
+	}, nil
+}
+
+func (h *LoanHandler) ApplyPrepayment(ctx context.Context, req *loanpb.ApplyPrepaymentRequest) (*loanpb.ApplyPrepaymentResponse, error) {
+	if req.GetLoanId() == "" {
+		return &loanpb.ApplyPrepaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "loan id is required",
+			},
+		}, nil
+	}
+
+	loanId, err := strconv.ParseInt(req.GetLoanId(), 10, 64)
+	if err != nil {
+		return &loanpb.ApplyPrepaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid loan id %q", req.GetLoanId()),
+			},
+		}, nil
+	}
+
+	amount, err := loanMoneyFromPB(orLegacyAmount(req.GetAmount(), req.GetLegacyAmount()), req.GetCurrencyCode())
+	if err != nil {
+		return &loanpb.ApplyPrepaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: err.Error(),
+			},
+		}, nil
+	}
+
+	if amount.Amount <= 0 {
+		return &loanpb.ApplyPrepaymentResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "amount must be positive",
+			},
+		}, nil
+	}
+
+	var paymentDate time.Time
+	if req.GetPaymentDate() != "" {
+		paymentDate, err = time.Parse(time.RFC3339, req.GetPaymentDate())
+		if err != nil {
+			return &loanpb.ApplyPrepaymentResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        1,
+					Description: fmt.Sprintf("invalid payment date %q", req.GetPaymentDate()),
+				},
+			}, nil
+		}
+	}
+
+	quote, loan, payment, err := h.loanUC.ApplyPrepayment(ctx, &dto.Payment{
+		LoanId:        loanId,
+		CurrencyCode:  amount.Currency,
+		PaymentDate:   paymentDate,
+		Amount:        amount.Amount,
+		Method:        req.GetMethod(),
+		TransactionId: req.GetTransactionId(),
+	}, req.GetMode())
+	if err != nil {
+		return &loanpb.ApplyPrepaymentResponse{
+			LoanServiceError: prepaymentErrorToPB(err, "failed to apply prepayment"),
+		}, nil
+	}
+
+	return &loanpb.ApplyPrepaymentResponse{
+		Quote:            prepaymentQuoteToPB(quote),
+		Loan:             loanToPB(loan),
+		Payment:          paymentToPB(payment),
+		LoanServiceError: ok(),
+	}, nil
+}
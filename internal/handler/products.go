@@ -0,0 +1,311 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"loan_service/internal/dto"
+	loanpb "loan_service/internal/proto/loan"
+	"loan_service/internal/usecase"
+	"loan_service/pkg/money"
+	"strconv"
+	"time"
+)
+
+func productToPB(product *dto.LoanProduct) *loanpb.LoanProduct {
+	rates := make([]*loanpb.ProductRate, len(product.Rates))
+	for index, rate := range product.Rates {
+		rates[index] = &loanpb.ProductRate{
+			MaxTermMonths:         rate.MaxTermMonths,
+			MinDownPaymentPercent: rate.MinDownPaymentPercent,
+			MarginRate:            rate.MarginRate,
+		}
+	}
+
+	return &loanpb.LoanProduct{
+		Id:                    fmt.Sprint(product.Id),
+		Code:                  product.Code,
+		Name:                  product.Name,
+		ApplicationType:       product.ApplicationType,
+		CurrencyCode:          product.CurrencyCode,
+		RepaymentMethod:       product.RepaymentMethod,
+		TermMonths:            product.TermMonths,
+		MinAmount:             moneyToPB(product.MinAmount, product.CurrencyCode),
+		MaxAmount:             moneyToPB(product.MaxAmount, product.CurrencyCode),
+		MinDownPaymentPercent: product.MinDownPaymentPercent,
+		Rates:                 rates,
+		Active:                product.Active,
+		CreatedAt:             product.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:             product.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// productFromPB reads a product of an admin request. Its amounts must be in
+// the currency of the product.
+func productFromPB(product *loanpb.LoanProduct) (*dto.LoanProduct, error) {
+	if err := money.Validate(product.GetCurrencyCode()); err != nil {
+		return nil, err
+	}
+
+	amounts, err := amountsFromPB(product.GetCurrencyCode(), product.GetMinAmount(), product.GetMaxAmount())
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make([]dto.ProductRate, len(product.GetRates()))
+	for index, rate := range product.GetRates() {
+		rates[index] = dto.ProductRate{
+			MaxTermMonths:         rate.GetMaxTermMonths(),
+			MinDownPaymentPercent: rate.GetMinDownPaymentPercent(),
+			MarginRate:            rate.GetMarginRate(),
+		}
+	}
+
+	return &dto.LoanProduct{
+		Code:                  product.GetCode(),
+		Name:                  product.GetName(),
+		ApplicationType:       product.GetApplicationType(),
+		CurrencyCode:          product.GetCurrencyCode(),
+		RepaymentMethod:       product.GetRepaymentMethod(),
+		TermMonths:            product.GetTermMonths(),
+		MinAmount:             amounts[0],
+		MaxAmount:             amounts[1],
+		MinDownPaymentPercent: product.GetMinDownPaymentPercent(),
+		Rates:                 rates,
+		Active:                product.GetActive(),
+	}, nil
+}
+
+// productServiceError maps a failure to save or delete a product to the error
+// returned to the client, or returns nil if err is not one.
+func productServiceError(err error) *loanpb.LoanServiceError {
+	if validationErr := validationServiceError(err); validationErr != nil {
+		return validationErr
+	}
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return &loanpb.LoanServiceError{
+			Code:        2,
+			Description: "product not found",
+		}
+	case errors.Is(err, usecase.ErrProductInUse):
+		return &loanpb.LoanServiceError{
+			Code:        3,
+			Description: err.Error(),
+		}
+	default:
+		return nil
+	}
+}
+
+func (h *LoanHandler) ListProducts(ctx context.Context, req *loanpb.ListProductsRequest) (*loanpb.ListProductsResponse, error) {
+	query := dto.ProductQuery{
+		ApplicationType: req.GetApplicationType(),
+		CurrencyCode:    req.GetCurrencyCode(),
+		IncludeInactive: req.GetIncludeInactive(),
+	}
+
+	limit, offset := pageToLimitOffset(req.GetPage())
+	currentPage := offset/limit + 1
+
+	productsCount, err := h.loanUC.CountProducts(ctx, query)
+	if err != nil {
+		return &loanpb.ListProductsResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to fetch products",
+			},
+		}, nil
+	}
+
+	if *productsCount == 0 {
+		return &loanpb.ListProductsResponse{
+			Products: nil,
+			Page: &loanpb.PageResponse{
+				CurrentPage: currentPage,
+				Limit:       limit,
+				TotalItems:  0,
+				TotalPages:  0,
+			},
+			LoanServiceError: ok(),
+		}, nil
+	}
+
+	products, err := h.loanUC.ListProducts(ctx, query, limit, offset)
+	if err != nil {
+		return &loanpb.ListProductsResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to fetch products",
+			},
+		}, nil
+	}
+
+	productsPB := make([]*loanpb.LoanProduct, len(products))
+	for index, product := range products {
+		productsPB[index] = productToPB(product)
+	}
+
+	totalPages := *productsCount / int64(limit)
+	if *productsCount%int64(limit) != 0 {
+		totalPages++
+	}
+	return &loanpb.ListProductsResponse{
+		Products: productsPB,
+		Page: &loanpb.PageResponse{
+			CurrentPage: currentPage,
+			Limit:       limit,
+			TotalItems:  int32(*productsCount),
+			TotalPages:  int32(totalPages),
+		},
+		LoanServiceError: ok(),
+	}, nil
+}
+
+func (h *LoanHandler) GetProduct(ctx context.Context, req *loanpb.GetProductRequest) (*loanpb.GetProductResponse, error) {
+	productId, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &loanpb.GetProductResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid id %q", req.GetId()),
+			},
+		}, nil
+	}
+
+	product, err := h.loanUC.GetProduct(ctx, productId)
+	if err != nil {
+		// Not found
+		if errors.Is(err, sql.ErrNoRows) {
+			return &loanpb.GetProductResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        2,
+					Description: "product not found",
+				},
+			}, nil
+		}
+
+		// Internal Error
+		return &loanpb.GetProductResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to fetch product",
+			},
+		}, nil
+	}
+
+	return &loanpb.GetProductResponse{
+		Product:          productToPB(product),
+		LoanServiceError: ok(),
+	}, nil
+}
+
+func (h *LoanHandler) CreateProduct(ctx context.Context, req *loanpb.CreateProductRequest) (*loanpb.CreateProductResponse, error) {
+	product, err := productFromPB(req.GetProduct())
+	if err != nil {
+		return &loanpb.CreateProductResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: err.Error(),
+			},
+		}, nil
+	}
+
+	createdProduct, err := h.loanUC.CreateProduct(ctx, product)
+	if err != nil {
+		if productErr := productServiceError(err); productErr != nil {
+			return &loanpb.CreateProductResponse{
+				LoanServiceError: productErr,
+			}, nil
+		}
+
+		return &loanpb.CreateProductResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to create product",
+			},
+		}, nil
+	}
+
+	return &loanpb.CreateProductResponse{
+		Product:          productToPB(createdProduct),
+		LoanServiceError: ok(),
+	}, nil
+}
+
+func (h *LoanHandler) UpdateProduct(ctx context.Context, req *loanpb.UpdateProductRequest) (*loanpb.UpdateProductResponse, error) {
+	productId, err := strconv.ParseInt(req.GetProduct().GetId(), 10, 64)
+	if err != nil {
+		return &loanpb.UpdateProductResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid id %q", req.GetProduct().GetId()),
+			},
+		}, nil
+	}
+
+	product, err := productFromPB(req.GetProduct())
+	if err != nil {
+		return &loanpb.UpdateProductResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: err.Error(),
+			},
+		}, nil
+	}
+	product.Id = productId
+
+	updatedProduct, err := h.loanUC.UpdateProduct(ctx, product)
+	if err != nil {
+		if productErr := productServiceError(err); productErr != nil {
+			return &loanpb.UpdateProductResponse{
+				LoanServiceError: productErr,
+			}, nil
+		}
+
+		return &loanpb.UpdateProductResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to update product",
+			},
+		}, nil
+	}
+
+	return &loanpb.UpdateProductResponse{
+		Product:          productToPB(updatedProduct),
+		LoanServiceError: ok(),
+	}, nil
+}
+
+func (h *LoanHandler) DeleteProduct(ctx context.Context, req *loanpb.DeleteProductRequest) (*loanpb.DeleteProductResponse, error) {
+	productId, err := strconv.ParseInt(req.GetId(), 10, 64)
+	if err != nil {
+		return &loanpb.DeleteProductResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid id %q", req.GetId()),
+			},
+		}, nil
+	}
+
+	if err := h.loanUC.DeleteProduct(ctx, productId); err != nil {
+		if productErr := productServiceError(err); productErr != nil {
+			return &loanpb.DeleteProductResponse{
+				LoanServiceError: productErr,
+			}, nil
+		}
+
+		return &loanpb.DeleteProductResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to delete product",
+			},
+		}, nil
+	}
+
+	return &loanpb.DeleteProductResponse{
+		LoanServiceError: ok(),
+	}, nil
+}
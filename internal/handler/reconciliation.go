@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"loan_service/internal/dto"
+	loanpb "loan_service/internal/proto/loan"
+	"strconv"
+	"time"
+)
+
+func reconciliationRunToPB(run *dto.ReconciliationRun) *loanpb.ReconciliationRun {
+	if run == nil {
+		return nil
+	}
+
+	var finishedAt string
+	if !run.FinishedAt.IsZero() {
+		finishedAt = run.FinishedAt.Format(time.RFC3339)
+	}
+
+	return &loanpb.ReconciliationRun{
+		Id:           strconv.FormatInt(run.Id, 10),
+		StartedAt:    run.StartedAt.Format(time.RFC3339),
+		FinishedAt:   finishedAt,
+		LoansChecked: run.LoansChecked,
+		IssuesFound:  run.IssuesFound,
+		Corrected:    run.Corrected,
+		Failed:       run.Failed,
+		Error:        run.Error,
+	}
+}
+
+func reconciliationIssueToPB(issue *dto.ReconciliationIssue) *loanpb.ReconciliationIssue {
+	var resolvedAt string
+	if !issue.ResolvedAt.IsZero() {
+		resolvedAt = issue.ResolvedAt.Format(time.RFC3339)
+	}
+
+	var remoteBalance *loanpb.Money
+	if issue.RemoteStatus != "" {
+		remoteBalance = moneyToPB(issue.RemoteBalance, issue.CurrencyCode)
+	}
+
+	return &loanpb.ReconciliationIssue{
+		Id:             strconv.FormatInt(issue.Id, 10),
+		LoanId:         strconv.FormatInt(issue.LoanId, 10),
+		ContractNumber: issue.ContractNumber,
+		Kind:           issue.Kind,
+		Status:         issue.Status,
+		LocalBalance:   moneyToPB(issue.LocalBalance, issue.CurrencyCode),
+		RemoteBalance:  remoteBalance,
+		LocalStatus:    issue.LocalStatus,
+		RemoteStatus:   issue.RemoteStatus,
+		DetectedAt:     issue.DetectedAt.Format(time.RFC3339),
+		ResolvedAt:     resolvedAt,
+	}
+}
+
+// GetReconciliationSummary reports the latest reconciliation run with ASR
+// Leasing, issue counts by kind and status, and a page of issues in the
+// requested status.
+func (h *LoanHandler) GetReconciliationSummary(ctx context.Context, req *loanpb.GetReconciliationSummaryRequest) (*loanpb.GetReconciliationSummaryResponse, error) {
+	status := req.GetStatus()
+	switch status {
+	case "":
+		status = "OPEN"
+	case "OPEN", "CORRECTED", "RESOLVED":
+	default:
+		return &loanpb.GetReconciliationSummaryResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: fmt.Sprintf("invalid issue status %q, expected OPEN, CORRECTED or RESOLVED", status),
+			},
+		}, nil
+	}
+
+	limit, offset := pageToLimitOffset(req.GetPage())
+	currentPage := offset/limit + 1
+
+	summary, err := h.loanUC.GetReconciliationSummary(ctx)
+	if err != nil {
+		return &loanpb.GetReconciliationSummaryResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to fetch reconciliation summary",
+			},
+		}, nil
+	}
+
+	issuesCount, err := h.loanUC.CountReconciliationIssues(ctx, status)
+	if err != nil {
+		return &loanpb.GetReconciliationSummaryResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to fetch reconciliation issues",
+			},
+		}, nil
+	}
+
+	var issuesPB []*loanpb.ReconciliationIssue
+	if *issuesCount > 0 {
+		issues, err := h.loanUC.ListReconciliationIssues(ctx, status, limit, offset)
+		if err != nil {
+			return &loanpb.GetReconciliationSummaryResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        5,
+					Description: "failed to fetch reconciliation issues",
+				},
+			}, nil
+		}
+
+		issuesPB = make([]*loanpb.ReconciliationIssue, len(issues))
+		for index, issue := range issues {
+			issuesPB[index] = reconciliationIssueToPB(issue)
+		}
+	}
+
+	countsPB := make([]*loanpb.ReconciliationIssueCount, len(summary.Counts))
+	for index, count := range summary.Counts {
+		countsPB[index] = &loanpb.ReconciliationIssueCount{
+			Kind:   count.Kind,
+			Status: count.Status,
+			Count:  int32(count.Count),
+		}
+	}
+
+	totalPages := *issuesCount / int64(limit)
+	if *issuesCount%int64(limit) != 0 {
+		totalPages++
+	}
+	return &loanpb.GetReconciliationSummaryResponse{
+		LastRun: reconciliationRunToPB(summary.LastRun),
+		Counts:  countsPB,
+		Issues:  issuesPB,
+		Page: &loanpb.PageResponse{
+			CurrentPage: currentPage,
+			Limit:       limit,
+			TotalItems:  int32(*issuesCount),
+			TotalPages:  int32(totalPages),
+		},
+		LoanServiceError: ok(),
+	}, nil
+}
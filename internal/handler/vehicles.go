@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"loan_service/internal/clients"
+	"loan_service/internal/dto"
+	loanpb "loan_service/internal/proto/loan"
+	"loan_service/internal/usecase"
+	"loan_service/pkg/money"
+	"loan_service/pkg/vin"
+)
+
+func vehicleToPB(vehicle dto.Vehicle) *loanpb.Vehicle {
+	return &loanpb.Vehicle{
+		ImageUrl:      vehicle.ImageURL,
+		Vin:           vehicle.Vin,
+		Name:          vehicle.Name,
+		EngineType:    vehicle.EngineType,
+		Configuration: vehicle.Configuration,
+		Price:         moneyToPB(vehicle.Price, vehicle.CurrencyCode),
+		Status:        vehicle.Status,
+
+		LegacyPrice: vehicle.Price.Major(),
+	}
+}
+
+// vehicleServiceError maps a failure to look up or verify a vehicle in the
+// Koinot Auto catalog to the error returned to the client, or returns nil if
+// err is not one.
+func vehicleServiceError(err error) *loanpb.LoanServiceError {
+	switch {
+	case errors.Is(err, vin.ErrInvalidVin):
+		return &loanpb.LoanServiceError{
+			Code:        1,
+			Description: err.Error(),
+		}
+	case errors.Is(err, clients.ErrVehicleNotFound):
+		return &loanpb.LoanServiceError{
+			Code:        2,
+			Description: "vehicle not found in Koinot Auto catalog",
+		}
+	case errors.Is(err, usecase.ErrVehicleUnavailable),
+		errors.Is(err, usecase.ErrVehicleMismatch),
+		errors.Is(err, clients.ErrVehicleHeld):
+		return &loanpb.LoanServiceError{
+			Code:        3,
+			Description: err.Error(),
+		}
+	case errors.Is(err, clients.ErrKoinotUnavailable):
+		return &loanpb.LoanServiceError{
+			Code:        4,
+			Description: "Koinot Auto is unavailable, try again later",
+		}
+	default:
+		return nil
+	}
+}
+
+// priceRangeFromPB reads the price range of a vehicle query. Bounds without a
+// currency are taken to be in the currency of the other bound or, failing
+// that, currencyCode.
+func priceRangeFromPB(minPrice, maxPrice *loanpb.Money, currencyCode string) (money.Money, money.Money, error) {
+	defaultCurrency := currencyCode
+	for _, m := range []*loanpb.Money{minPrice, maxPrice} {
+		if m.GetCurrencyCode() != "" {
+			defaultCurrency = m.GetCurrencyCode()
+			break
+		}
+	}
+
+	var bounds [2]money.Money
+	for index, m := range []*loanpb.Money{minPrice, maxPrice} {
+		if m == nil {
+			continue
+		}
+
+		bound, err := moneyFromPB(m, defaultCurrency)
+		if err != nil {
+			return money.Money{}, money.Money{}, err
+		}
+		bounds[index] = bound
+	}
+
+	return bounds[0], bounds[1], nil
+}
+
+func (h *LoanHandler) ListVehicles(ctx context.Context, req *loanpb.ListVehiclesRequest) (*loanpb.ListVehiclesResponse, error) {
+	if req.GetCurrencyCode() != "" {
+		if err := money.Validate(req.GetCurrencyCode()); err != nil {
+			return &loanpb.ListVehiclesResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        1,
+					Description: err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	minPrice, maxPrice, err := priceRangeFromPB(req.GetMinPrice(), req.GetMaxPrice(), req.GetCurrencyCode())
+	if err != nil {
+		return &loanpb.ListVehiclesResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: err.Error(),
+			},
+		}, nil
+	}
+
+	limit, offset := pageToLimitOffset(req.GetPage())
+	currentPage := offset/limit + 1
+
+	vehicles, total, err := h.loanUC.ListVehicles(ctx, dto.VehicleQuery{
+		EngineType:   req.GetEngineType(),
+		CurrencyCode: req.GetCurrencyCode(),
+		Search:       req.GetSearch(),
+		MinPrice:     minPrice,
+		MaxPrice:     maxPrice,
+		Sort:         req.GetSort(),
+	}, limit, offset)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidVehicleQuery) || isConversionError(err) {
+			return &loanpb.ListVehiclesResponse{
+				LoanServiceError: &loanpb.LoanServiceError{
+					Code:        1,
+					Description: err.Error(),
+				},
+			}, nil
+		}
+
+		return &loanpb.ListVehiclesResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to get vehicles",
+			},
+		}, nil
+	}
+
+	vehiclesPB := make([]*loanpb.Vehicle, len(vehicles))
+	for index, vehicle := range vehicles {
+		vehiclesPB[index] = vehicleToPB(vehicle)
+	}
+
+	totalPages := total / int64(limit)
+	if total%int64(limit) != 0 {
+		totalPages++
+	}
+	return &loanpb.ListVehiclesResponse{
+		Vehicles: vehiclesPB,
+		Page: &loanpb.PageResponse{
+			CurrentPage: currentPage,
+			Limit:       limit,
+			TotalItems:  int32(total),
+			TotalPages:  int32(totalPages),
+		},
+		LoanServiceError: ok(),
+	}, nil
+}
+
+func (h *LoanHandler) GetVehicle(ctx context.Context, req *loanpb.GetVehicleRequest) (*loanpb.GetVehicleResponse, error) {
+	if req.GetVin() == "" {
+		return &loanpb.GetVehicleResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        1,
+				Description: "vin is required",
+			},
+		}, nil
+	}
+
+	vehicle, err := h.loanUC.GetVehicle(ctx, req.GetVin())
+	if err != nil {
+		// Not in or rejected by the Koinot Auto catalog
+		if vehicleErr := vehicleServiceError(err); vehicleErr != nil {
+			return &loanpb.GetVehicleResponse{
+				LoanServiceError: vehicleErr,
+			}, nil
+		}
+
+		return &loanpb.GetVehicleResponse{
+			LoanServiceError: &loanpb.LoanServiceError{
+				Code:        5,
+				Description: "failed to get vehicle",
+			},
+		}, nil
+	}
+
+	return &loanpb.GetVehicleResponse{
+		Vehicle:          vehicleToPB(*vehicle),
+		LoanServiceError: ok(),
+	}, nil
+}
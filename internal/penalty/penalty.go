@@ -0,0 +1,52 @@
+package penalty
+
+import (
+	"fmt"
+	"loan_service/configs"
+	"loan_service/pkg/money"
+)
+
+// Rules describe how late-payment charges accrue on an overdue loan.
+type Rules struct {
+	// FixedFee is charged once for every installment that becomes overdue.
+	FixedFee money.Amount
+	// DailyRate is the percent of the overdue amount charged per day.
+	DailyRate float64
+	// CapRate limits all charges of a loan to this percent of the loan
+	// amount. Zero disables the cap.
+	CapRate float64
+	// CharityAccount receives the charges of Sharia-compliant loans, which
+	// the financier may not keep as income.
+	CharityAccount string
+}
+
+func NewRules(cfg configs.PenaltiesConfig) (Rules, error) {
+	if cfg.FixedFee < 0 || cfg.DailyRate < 0 || cfg.CapRate < 0 {
+		return Rules{}, fmt.Errorf("penalty rules must not be negative: %+v", cfg)
+	}
+
+	return Rules{
+		FixedFee:  money.FromMajor(cfg.FixedFee),
+		DailyRate: cfg.DailyRate,
+		CapRate:   cfg.CapRate,
+
+		CharityAccount: cfg.CharityAccount,
+	}, nil
+}
+
+// DailyPenalty is the charge for one day of overdueAmount being late, rounded
+// with the rules of currency.
+func (r Rules) DailyPenalty(currency money.Currency, overdueAmount money.Amount) money.Amount {
+	return currency.Round(float64(overdueAmount) * r.DailyRate / 100)
+}
+
+// Limit trims charge so that the loan's charges, charged so far, stay within
+// the cap for a loan of loanAmount.
+func (r Rules) Limit(currency money.Currency, charge, charged, loanAmount money.Amount) money.Amount {
+	if r.CapRate == 0 {
+		return charge
+	}
+
+	capAmount := currency.Round(float64(loanAmount) * r.CapRate / 100)
+	return max(0, min(charge, capAmount-charged))
+}
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS application_status_history (
+    id               BIGSERIAL PRIMARY KEY,
+    application_id   BIGINT REFERENCES loan_applications(id) NOT NULL,
+    from_status      application_status NOT NULL,
+    to_status        application_status NOT NULL,
+    reason           TEXT,
+    actor            VARCHAR(255) NOT NULL,
+    created_at       TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_application_status_history_application ON application_status_history(application_id);
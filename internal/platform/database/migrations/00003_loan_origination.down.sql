@@ -0,0 +1,2 @@
+-- Postgres cannot drop a value from an enum, 'ISSUED' stays in application_status.
+DROP INDEX IF EXISTS idx_loans_application;
@@ -0,0 +1,3 @@
+ALTER TYPE application_status ADD VALUE IF NOT EXISTS 'ISSUED';  -- application was originated into a loan
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_loans_application ON loans(application_id);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS payments (
+    id               BIGSERIAL PRIMARY KEY,
+    loan_id          BIGINT REFERENCES loans(id) NOT NULL,
+    payment_date     TIMESTAMP,
+    amount           NUMERIC(18,2),
+    currency_code    VARCHAR(10) NOT NULL,
+    method           VARCHAR(32),
+    status           VARCHAR(32),    -- status: COMPLETED
+    transaction_id   VARCHAR(128),
+    created_at       TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_payments_loan ON payments(loan_id);
+CREATE UNIQUE INDEX idx_payments_transaction ON payments(transaction_id);
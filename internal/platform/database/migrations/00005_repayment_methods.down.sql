@@ -0,0 +1,8 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS repayment_method,
+    DROP COLUMN IF EXISTS margin_rate;
+
+ALTER TABLE loan_applications
+    DROP COLUMN IF EXISTS repayment_method;
+
+DROP TYPE IF EXISTS repayment_method;
@@ -0,0 +1,14 @@
+CREATE TYPE repayment_method AS ENUM ('FLAT', 'ANNUITY', 'DIFFERENTIATED');  -- FLAT: margin on full amount / ANNUITY: equal installments / DIFFERENTIATED: equal principal
+
+ALTER TABLE loan_applications
+    ADD COLUMN repayment_method repayment_method NOT NULL DEFAULT 'FLAT';
+
+ALTER TABLE loans
+    ADD COLUMN margin_rate      NUMERIC(5,2),
+    ADD COLUMN repayment_method repayment_method NOT NULL DEFAULT 'FLAT';
+
+UPDATE loans
+SET margin_rate = loan_applications.margin_rate,
+    repayment_method = loan_applications.repayment_method
+FROM loan_applications
+WHERE loan_applications.id = loans.application_id;
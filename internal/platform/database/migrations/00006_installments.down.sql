@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS installments;
+
+DROP TYPE IF EXISTS installment_status;
@@ -0,0 +1,18 @@
+CREATE TYPE installment_status AS ENUM ('PENDING', 'PARTIAL', 'PAID', 'OVERDUE');
+
+CREATE TABLE IF NOT EXISTS installments (
+    id               BIGSERIAL PRIMARY KEY,
+    loan_id          BIGINT REFERENCES loans(id) NOT NULL,
+    number           INT NOT NULL,
+    due_date         TIMESTAMP NOT NULL,
+    principal_due    NUMERIC(18,2) NOT NULL,
+    margin_due       NUMERIC(18,2) NOT NULL,
+    principal_paid   NUMERIC(18,2) NOT NULL DEFAULT 0,
+    margin_paid      NUMERIC(18,2) NOT NULL DEFAULT 0,
+    status           installment_status NOT NULL DEFAULT 'PENDING',
+    paid_at          TIMESTAMP,
+    created_at       TIMESTAMP DEFAULT NOW(),
+    updated_at       TIMESTAMP DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_installments_loan_number ON installments(loan_id, number);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_installments_open_due;
+
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS days_past_due;
@@ -0,0 +1,4 @@
+ALTER TABLE loans
+    ADD COLUMN days_past_due INT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_installments_open_due ON installments(due_date) WHERE status <> 'PAID';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS loan_charges;
+
+DROP TYPE IF EXISTS charge_type;
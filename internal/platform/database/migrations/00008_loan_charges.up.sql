@@ -0,0 +1,17 @@
+CREATE TYPE charge_type AS ENUM ('LATE_FEE', 'PENALTY');  -- LATE_FEE: fixed fee per missed installment / PENALTY: daily accrual on the overdue amount
+
+CREATE TABLE IF NOT EXISTS loan_charges (
+    id               BIGSERIAL PRIMARY KEY,
+    loan_id          BIGINT REFERENCES loans(id) NOT NULL,
+    installment_id   BIGINT REFERENCES installments(id),
+    type             charge_type NOT NULL,
+    amount           NUMERIC(18,2) NOT NULL,
+    paid_amount      NUMERIC(18,2) NOT NULL DEFAULT 0,
+    accrued_on       DATE NOT NULL,
+    created_at       TIMESTAMP DEFAULT NOW(),
+    updated_at       TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_loan_charges_loan ON loan_charges(loan_id);
+CREATE UNIQUE INDEX idx_loan_charges_late_fee ON loan_charges(installment_id) WHERE type = 'LATE_FEE';
+CREATE UNIQUE INDEX idx_loan_charges_penalty ON loan_charges(loan_id, accrued_on) WHERE type = 'PENALTY';
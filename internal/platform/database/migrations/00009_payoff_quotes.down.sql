@@ -0,0 +1 @@
+DROP TABLE IF EXISTS payoff_quotes;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS payoff_quotes (
+    id                 BIGSERIAL PRIMARY KEY,
+    loan_id            BIGINT REFERENCES loans(id) NOT NULL,
+    principal          NUMERIC(18,2) NOT NULL,
+    margin             NUMERIC(18,2) NOT NULL,
+    margin_waived      NUMERIC(18,2) NOT NULL,
+    charges            NUMERIC(18,2) NOT NULL,
+    settlement_fee     NUMERIC(18,2) NOT NULL,
+    total              NUMERIC(18,2) NOT NULL,
+    remaining_balance  NUMERIC(18,2) NOT NULL,    -- loan balance the quote was computed from
+    valid_until        TIMESTAMP NOT NULL,
+    status             VARCHAR(32) NOT NULL DEFAULT 'ISSUED',    -- status: ISSUED, SETTLED
+    payment_id         BIGINT REFERENCES payments(id),
+    created_at         TIMESTAMP DEFAULT NOW(),
+    settled_at         TIMESTAMP
+);
+
+CREATE INDEX idx_payoff_quotes_loan ON payoff_quotes(loan_id);
@@ -0,0 +1,6 @@
+ALTER TABLE loan_applications
+    DROP COLUMN IF EXISTS exchange_rate,
+    DROP COLUMN IF EXISTS vehicle_currency_code,
+    DROP COLUMN IF EXISTS vehicle_price;
+
+DROP TABLE IF EXISTS exchange_rates;
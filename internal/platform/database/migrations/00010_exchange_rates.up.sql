@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    id                BIGSERIAL PRIMARY KEY,
+    base_currency     VARCHAR(10) NOT NULL,
+    quote_currency    VARCHAR(10) NOT NULL,
+    rate              NUMERIC(18,8) NOT NULL CHECK (rate > 0),    -- units of quote_currency per unit of base_currency
+    effective_at      TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_at        TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX idx_exchange_rates_pair ON exchange_rates(base_currency, quote_currency, effective_at DESC);
+
+ALTER TABLE loan_applications
+    ADD COLUMN vehicle_price          NUMERIC(18,2),    -- price in the vehicle's own currency
+    ADD COLUMN vehicle_currency_code  VARCHAR(10),
+    ADD COLUMN exchange_rate          NUMERIC(18,8);    -- rate price was converted at into currency_code
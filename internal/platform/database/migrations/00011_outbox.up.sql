@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS outbox (
+    id                BIGSERIAL PRIMARY KEY,
+    event_id          VARCHAR(36) NOT NULL DEFAULT gen_random_uuid()::text,
+    event_type        VARCHAR(64) NOT NULL,    -- ApplicationCreated, ApplicationStatusChanged, LoanOriginated, PaymentReceived, LoanOverdue
+    aggregate_type    VARCHAR(32) NOT NULL,    -- loan_application, loan
+    aggregate_id      BIGINT NOT NULL,
+    payload           JSONB NOT NULL,
+    attempts          INT NOT NULL DEFAULT 0,
+    last_error        TEXT,
+    next_attempt_at   TIMESTAMP NOT NULL DEFAULT NOW(),
+    published_at      TIMESTAMP,
+    created_at        TIMESTAMP DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_outbox_event_id ON outbox(event_id);
+CREATE INDEX idx_outbox_pending ON outbox(next_attempt_at, id) WHERE published_at IS NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE outbox
+    DROP COLUMN IF EXISTS correlation_id,
+    DROP COLUMN IF EXISTS event_version;
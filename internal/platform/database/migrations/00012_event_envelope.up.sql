@@ -0,0 +1,6 @@
+-- Payloads are now stored as the protojson form of the versioned event
+-- messages in internal/proto/events. Drain the outbox before applying:
+-- pending rows written earlier cannot be encoded.
+ALTER TABLE outbox
+    ADD COLUMN event_version   INT NOT NULL DEFAULT 1,
+    ADD COLUMN correlation_id  VARCHAR(64);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_loans_contract_number;
+
+ALTER TABLE loans DROP COLUMN IF EXISTS contract_number;
+ALTER TABLE loan_applications DROP COLUMN IF EXISTS contract_number;
@@ -0,0 +1,7 @@
+-- Contract number ASR Leasing assigns to an application on submission. It is
+-- stored on the application first, so a retried CreateLoan does not submit
+-- again, and copied to the loan on origination.
+ALTER TABLE loan_applications ADD COLUMN contract_number VARCHAR(64);
+ALTER TABLE loans ADD COLUMN contract_number VARCHAR(64);
+
+CREATE UNIQUE INDEX idx_loans_contract_number ON loans(contract_number);
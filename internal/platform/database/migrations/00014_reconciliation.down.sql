@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS reconciliation_issues;
+DROP TABLE IF EXISTS reconciliation_runs;
+
+DROP TYPE IF EXISTS reconciliation_issue_status;
+DROP TYPE IF EXISTS reconciliation_issue_kind;
@@ -0,0 +1,34 @@
+CREATE TYPE reconciliation_issue_kind AS ENUM ('BALANCE_MISMATCH', 'STATUS_MISMATCH', 'CONTRACT_NOT_FOUND');
+CREATE TYPE reconciliation_issue_status AS ENUM ('OPEN', 'CORRECTED', 'RESOLVED');  -- CORRECTED: local balance set to ASR's / RESOLVED: sides agree again
+
+CREATE TABLE IF NOT EXISTS reconciliation_runs (
+    id               BIGSERIAL PRIMARY KEY,
+    started_at       TIMESTAMP NOT NULL DEFAULT NOW(),
+    finished_at      TIMESTAMP,
+    loans_checked    INT NOT NULL DEFAULT 0,
+    issues_found     INT NOT NULL DEFAULT 0,
+    corrected        INT NOT NULL DEFAULT 0,
+    failed           INT NOT NULL DEFAULT 0,  -- loans ASR Leasing could not be asked about
+    error            TEXT
+);
+
+-- One OPEN issue per loan and kind; a run that sees the discrepancy again
+-- refreshes it with the latest figures.
+CREATE TABLE IF NOT EXISTS reconciliation_issues (
+    id               BIGSERIAL PRIMARY KEY,
+    run_id           BIGINT REFERENCES reconciliation_runs(id) NOT NULL,
+    loan_id          BIGINT REFERENCES loans(id) NOT NULL,
+    contract_number  VARCHAR(64) NOT NULL,
+    currency_code    VARCHAR(10) NOT NULL,
+    kind             reconciliation_issue_kind NOT NULL,
+    status           reconciliation_issue_status NOT NULL DEFAULT 'OPEN',
+    local_balance    NUMERIC(18,2) NOT NULL,
+    remote_balance   NUMERIC(18,2),
+    local_status     VARCHAR(16) NOT NULL,
+    remote_status    VARCHAR(16),
+    detected_at      TIMESTAMP NOT NULL DEFAULT NOW(),
+    resolved_at      TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_reconciliation_issues_open ON reconciliation_issues(loan_id, kind) WHERE status = 'OPEN';
+CREATE INDEX idx_reconciliation_issues_status ON reconciliation_issues(status, id);
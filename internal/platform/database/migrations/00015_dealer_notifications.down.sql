@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_loan_applications_dealer_notification;
+
+ALTER TABLE loan_applications DROP COLUMN IF EXISTS dealer_notified_at;
+ALTER TABLE loan_applications DROP COLUMN IF EXISTS dealer_notification_next_attempt_at;
+ALTER TABLE loan_applications DROP COLUMN IF EXISTS dealer_notification_error;
+ALTER TABLE loan_applications DROP COLUMN IF EXISTS dealer_notification_attempts;
+ALTER TABLE loan_applications DROP COLUMN IF EXISTS dealer_notification_status;
@@ -0,0 +1,14 @@
+-- Delivery of new applications to the Koinot Auto dealer. The dealer notifier
+-- sends PENDING applications, retries failures with backoff and gives up
+-- (FAILED) after the configured number of attempts.
+ALTER TABLE loan_applications ADD COLUMN dealer_notification_status VARCHAR(16) NOT NULL DEFAULT 'PENDING';    -- status: PENDING, SENT, FAILED
+ALTER TABLE loan_applications ADD COLUMN dealer_notification_attempts INT NOT NULL DEFAULT 0;
+ALTER TABLE loan_applications ADD COLUMN dealer_notification_error TEXT;
+ALTER TABLE loan_applications ADD COLUMN dealer_notification_next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE loan_applications ADD COLUMN dealer_notified_at TIMESTAMP;
+
+-- Existing applications were sent when they were created; whether that
+-- succeeded is not recorded, so they are not sent again.
+UPDATE loan_applications SET dealer_notification_status = 'SENT';
+
+CREATE INDEX idx_loan_applications_dealer_notification ON loan_applications(dealer_notification_next_attempt_at, id) WHERE dealer_notification_status = 'PENDING';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS vehicle_holds;
+
+DROP TYPE IF EXISTS vehicle_hold_status;
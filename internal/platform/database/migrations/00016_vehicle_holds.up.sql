@@ -0,0 +1,20 @@
+CREATE TYPE vehicle_hold_status AS ENUM ('ACTIVE', 'RELEASED', 'EXPIRED', 'SOLD');
+
+-- Holds Koinot Auto keeps on vehicles while their AUTO applications are
+-- reviewed. A hold is released when its application is rejected, expires
+-- with Koinot Auto's hold and is converted into a sale when a loan is
+-- originated from its application.
+CREATE TABLE IF NOT EXISTS vehicle_holds (
+    id               BIGSERIAL PRIMARY KEY,
+    application_id   BIGINT REFERENCES loan_applications(id) NOT NULL UNIQUE,
+    vehicle_vin      VARCHAR(17) NOT NULL,
+    koinot_hold_id   VARCHAR(64) NOT NULL,
+    status           vehicle_hold_status NOT NULL DEFAULT 'ACTIVE',
+    expires_at       TIMESTAMP NOT NULL,
+    created_at       TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- A vehicle is held for one application at a time.
+CREATE UNIQUE INDEX idx_vehicle_holds_active_vin ON vehicle_holds(vehicle_vin) WHERE status = 'ACTIVE';
+CREATE INDEX idx_vehicle_holds_active_expires_at ON vehicle_holds(expires_at) WHERE status = 'ACTIVE';
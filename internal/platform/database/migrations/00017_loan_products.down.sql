@@ -0,0 +1,4 @@
+ALTER TABLE loan_applications DROP COLUMN IF EXISTS product_id;
+
+DROP TABLE IF EXISTS loan_product_rates;
+DROP TABLE IF EXISTS loan_products;
@@ -0,0 +1,43 @@
+-- Financing products. An application is financed on the terms of its
+-- product: the currency, the terms it may run for, the amount financed, the
+-- smallest down payment and a grid of margin rates.
+CREATE TABLE IF NOT EXISTS loan_products (
+    id                        BIGSERIAL PRIMARY KEY,
+    code                      VARCHAR(32) NOT NULL UNIQUE,
+    name                      VARCHAR(128) NOT NULL,
+    application_type          application_type NOT NULL,
+    currency_code             VARCHAR(10) NOT NULL,
+    repayment_method          repayment_method NOT NULL DEFAULT 'FLAT',
+    term_months               INT[] NOT NULL,                  -- terms offered, in months
+    min_amount                NUMERIC(18,2) NOT NULL,          -- amount financed, i.e. net price
+    max_amount                NUMERIC(18,2) NOT NULL,
+    min_down_payment_percent  NUMERIC(5,2) NOT NULL DEFAULT 0,
+    active                    BOOLEAN NOT NULL DEFAULT TRUE,   -- inactive products take no new applications
+    created_at                TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at                TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- A cell of the rate grid applies to terms up to max_term_months with a down
+-- payment of at least min_down_payment_percent of the price. The cell with the
+-- shortest term and then the largest down payment that applies wins.
+CREATE TABLE IF NOT EXISTS loan_product_rates (
+    id                        BIGSERIAL PRIMARY KEY,
+    product_id                BIGINT REFERENCES loan_products(id) ON DELETE CASCADE NOT NULL,
+    max_term_months           INT NOT NULL,
+    min_down_payment_percent  NUMERIC(5,2) NOT NULL,
+    margin_rate               NUMERIC(7,4) NOT NULL,           -- annual, percent
+    UNIQUE (product_id, max_term_months, min_down_payment_percent)
+);
+
+ALTER TABLE loan_applications ADD COLUMN product_id BIGINT REFERENCES loan_products(id);
+
+-- The terms applications were checked against before products existed.
+INSERT INTO loan_products(code, name, application_type, currency_code, term_months, min_amount, max_amount, min_down_payment_percent)
+VALUES
+    ('AUTO_TJS', 'Автокредит', 'AUTO', 'TJS', '{6,12,18,24,36,48,60}', 1000.00, 1000000.00, 20),
+    ('PERSONAL_TJS', 'Потребительский кредит', 'PERSONAL', 'TJS', '{3,6,12,18,24,36}', 500.00, 100000.00, 0);
+
+INSERT INTO loan_product_rates(product_id, max_term_months, min_down_payment_percent, margin_rate)
+SELECT id, 60, 20, 18 FROM loan_products WHERE code = 'AUTO_TJS'
+UNION ALL
+SELECT id, 36, 0, 24 FROM loan_products WHERE code = 'PERSONAL_TJS';
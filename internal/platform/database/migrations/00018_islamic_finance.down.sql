@@ -0,0 +1,31 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS ownership_transferred_at;
+
+ALTER TABLE loan_charges
+    DROP COLUMN IF EXISTS charity;
+
+-- Postgres cannot drop enum values: loans and applications on MURABAHA or
+-- IJARA are moved to FLAT and the type is rebuilt without them.
+UPDATE loan_products SET repayment_method = 'FLAT' WHERE repayment_method IN ('MURABAHA', 'IJARA');
+UPDATE loan_applications SET repayment_method = 'FLAT' WHERE repayment_method IN ('MURABAHA', 'IJARA');
+UPDATE loans SET repayment_method = 'FLAT' WHERE repayment_method IN ('MURABAHA', 'IJARA');
+
+ALTER TYPE repayment_method RENAME TO repayment_method_old;
+CREATE TYPE repayment_method AS ENUM ('FLAT', 'ANNUITY', 'DIFFERENTIATED');
+
+ALTER TABLE loan_products ALTER COLUMN repayment_method DROP DEFAULT;
+ALTER TABLE loan_applications ALTER COLUMN repayment_method DROP DEFAULT;
+ALTER TABLE loans ALTER COLUMN repayment_method DROP DEFAULT;
+
+ALTER TABLE loan_products
+    ALTER COLUMN repayment_method TYPE repayment_method USING repayment_method::text::repayment_method;
+ALTER TABLE loan_applications
+    ALTER COLUMN repayment_method TYPE repayment_method USING repayment_method::text::repayment_method;
+ALTER TABLE loans
+    ALTER COLUMN repayment_method TYPE repayment_method USING repayment_method::text::repayment_method;
+
+ALTER TABLE loan_products ALTER COLUMN repayment_method SET DEFAULT 'FLAT';
+ALTER TABLE loan_applications ALTER COLUMN repayment_method SET DEFAULT 'FLAT';
+ALTER TABLE loans ALTER COLUMN repayment_method SET DEFAULT 'FLAT';
+
+DROP TYPE repayment_method_old;
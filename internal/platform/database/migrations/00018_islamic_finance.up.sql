@@ -0,0 +1,10 @@
+-- MURABAHA: sale at cost plus a fixed markup / IJARA: lease with ownership transferred after the last rental
+ALTER TYPE repayment_method ADD VALUE IF NOT EXISTS 'MURABAHA';
+ALTER TYPE repayment_method ADD VALUE IF NOT EXISTS 'IJARA';
+
+-- Late charges of MURABAHA and IJARA loans are not income: they are paid to the charity account.
+ALTER TABLE loan_charges
+    ADD COLUMN charity BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE loans
+    ADD COLUMN ownership_transferred_at TIMESTAMP;
@@ -0,0 +1 @@
+ALTER TABLE loan_applications DROP COLUMN IF EXISTS origination_claimed_until;
@@ -0,0 +1,4 @@
+-- CreateLoan claims an application until origination_claimed_until before it
+-- calls ASR Leasing and Koinot Auto, outside of any transaction. A claim that
+-- has run out, e.g. because the instance holding it died, can be taken over.
+ALTER TABLE loan_applications ADD COLUMN origination_claimed_until TIMESTAMP;
@@ -0,0 +1,2 @@
+ALTER TABLE loans ALTER COLUMN margin_rate TYPE NUMERIC(5,2);
+ALTER TABLE loan_applications ALTER COLUMN margin_rate TYPE NUMERIC(5,2);
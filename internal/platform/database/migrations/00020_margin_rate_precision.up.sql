@@ -0,0 +1,4 @@
+-- Product rate grids keep margin rates to four decimals, NUMERIC(7,4); the
+-- applications and loans priced on them have to hold the same rate.
+ALTER TABLE loan_applications ALTER COLUMN margin_rate TYPE NUMERIC(7,4);
+ALTER TABLE loans ALTER COLUMN margin_rate TYPE NUMERIC(7,4);
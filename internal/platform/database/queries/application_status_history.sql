@@ -0,0 +1,10 @@
+-- name: CreateApplicationStatusChange :one
+INSERT INTO application_status_history(
+  application_id,
+  from_status,
+  to_status,
+  reason,
+  actor
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
@@ -0,0 +1,9 @@
+-- name: GetExchangeRate :one
+select *
+from exchange_rates
+where base_currency = @base_currency
+  and quote_currency = @quote_currency
+  and effective_at <= @as_of
+order by effective_at desc
+limit 1
+;
@@ -0,0 +1,56 @@
+-- name: CreateInstallment :one
+INSERT INTO installments(
+  loan_id,
+  number,
+  due_date,
+  principal_due,
+  margin_due
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ListInstallmentsByLoan :many
+select *
+from installments
+where loan_id = $1
+order by number
+;
+
+-- name: ListOpenInstallmentsForUpdate :many
+select *
+from installments
+where loan_id = $1 and status <> 'PAID'
+order by number
+for update
+;
+
+-- name: UpdateInstallmentPayment :one
+update installments
+set principal_paid = $2,
+    margin_paid = $3,
+    status = $4,
+    paid_at = $5,
+    updated_at = NOW()
+where id = $1
+returning *
+;
+
+-- name: AdjustInstallmentMargin :one
+update installments
+set margin_due = $2,
+    updated_at = NOW()
+where id = $1
+returning *
+;
+
+-- name: MarkInstallmentsOverdue :execrows
+update installments
+set status = 'OVERDUE',
+    updated_at = NOW()
+where status in ('PENDING', 'PARTIAL') and due_date < date_trunc('day', @as_of::timestamp)
+;
+
+-- name: DeletePendingInstallments :exec
+delete from installments
+where loan_id = $1 and status = 'PENDING'
+;
@@ -11,9 +11,14 @@ INSERT INTO loan_applications(
   margin_rate,
   term_months,
   monthly_payment,
-  status
+  status,
+  repayment_method,
+  vehicle_price,
+  vehicle_currency_code,
+  exchange_rate,
+  product_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12  
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
 ) RETURNING *;
 
 -- name: GetApplication :one
@@ -36,3 +41,86 @@ order by id desc
 limit $2
 offset $3
 ;
+
+-- name: GetApplicationForUpdate :one
+select *
+from loan_applications
+where id = $1
+for update
+;
+
+-- name: UpdateApplicationStatus :one
+update loan_applications
+set status = $2,
+    updated_at = NOW()
+where id = $1
+returning *
+;
+
+-- name: UpdateApplicationContractNumber :one
+update loan_applications
+set contract_number = $2,
+    updated_at = NOW()
+where id = $1
+returning *
+;
+
+-- name: ClaimApplicationOrigination :exec
+update loan_applications
+set origination_claimed_until = $2
+where id = $1
+;
+
+-- name: ReleaseApplicationOrigination :exec
+update loan_applications
+set origination_claimed_until = null
+where id = $1
+;
+
+-- name: ClaimPendingDealerNotifications :many
+update loan_applications
+set dealer_notification_next_attempt_at = @claimed_until
+where id in (
+  select id
+  from loan_applications
+  where dealer_notification_status = 'PENDING'
+    and dealer_notification_next_attempt_at <= NOW()
+  order by id
+  limit @batch_size
+  for update skip locked
+)
+returning *
+;
+
+-- name: MarkDealerNotificationSent :exec
+update loan_applications
+set dealer_notification_status = 'SENT',
+    dealer_notification_attempts = dealer_notification_attempts + 1,
+    dealer_notification_error = null,
+    dealer_notified_at = NOW()
+where id = $1
+;
+
+-- name: MarkDealerNotificationFailed :exec
+update loan_applications
+set dealer_notification_status = $2,
+    dealer_notification_attempts = dealer_notification_attempts + 1,
+    dealer_notification_error = $3,
+    dealer_notification_next_attempt_at = $4
+where id = $1
+;
+
+-- name: CountApplicationsByDealerNotificationStatus :one
+select count(*)
+from loan_applications
+where dealer_notification_status = $1
+;
+
+-- name: ListApplicationsByDealerNotificationStatus :many
+select *
+from loan_applications
+where dealer_notification_status = $1
+order by id
+limit $2
+offset $3
+;
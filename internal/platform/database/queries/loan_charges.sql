@@ -0,0 +1,51 @@
+-- name: CreateLoanCharge :execrows
+INSERT INTO loan_charges(
+  loan_id,
+  installment_id,
+  type,
+  amount,
+  accrued_on,
+  charity
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) ON CONFLICT DO NOTHING;
+
+-- name: GetLastPenaltyAccruedOn :one
+select accrued_on
+from loan_charges
+where loan_id = $1 and type = 'PENALTY'
+order by accrued_on desc
+limit 1
+;
+
+-- name: GetLoanChargeTotals :one
+select coalesce(sum(amount), 0)::numeric as charged,
+       coalesce(sum(paid_amount), 0)::numeric as paid
+from loan_charges
+where loan_id = $1
+;
+
+-- name: ListLoanChargeTotals :many
+select loan_id,
+       coalesce(sum(amount), 0)::numeric as charged,
+       coalesce(sum(paid_amount), 0)::numeric as paid
+from loan_charges
+where loan_id = any(@loan_ids::bigint[])
+group by loan_id
+order by loan_id
+;
+
+-- name: ListOpenLoanChargesForUpdate :many
+select *
+from loan_charges
+where loan_id = $1 and paid_amount < amount
+order by accrued_on, id
+for update
+;
+
+-- name: UpdateLoanChargePayment :exec
+update loan_charges
+set paid_amount = $2,
+    updated_at = NOW()
+where id = $1
+;
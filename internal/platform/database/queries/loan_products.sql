@@ -0,0 +1,90 @@
+-- name: CreateLoanProduct :one
+INSERT INTO loan_products(
+  code,
+  name,
+  application_type,
+  currency_code,
+  repayment_method,
+  term_months,
+  min_amount,
+  max_amount,
+  min_down_payment_percent,
+  active
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING *;
+
+-- name: UpdateLoanProduct :one
+update loan_products
+set code = $2,
+    name = $3,
+    application_type = $4,
+    currency_code = $5,
+    repayment_method = $6,
+    term_months = $7,
+    min_amount = $8,
+    max_amount = $9,
+    min_down_payment_percent = $10,
+    active = $11,
+    updated_at = NOW()
+where id = $1
+returning *
+;
+
+-- name: DeleteLoanProduct :execrows
+delete from loan_products
+where id = $1
+;
+
+-- name: GetLoanProduct :one
+select *
+from loan_products
+where id = $1
+;
+
+-- name: GetLoanProductByCode :one
+select *
+from loan_products
+where code = $1
+;
+
+-- name: CountLoanProducts :one
+select count(*)
+from loan_products
+where (@application_type::text = '' or application_type::text = @application_type)
+  and (@currency_code::text = '' or currency_code = @currency_code)
+  and (active or @include_inactive::bool)
+;
+
+-- name: ListLoanProducts :many
+select *
+from loan_products
+where (@application_type::text = '' or application_type::text = @application_type)
+  and (@currency_code::text = '' or currency_code = @currency_code)
+  and (active or @include_inactive::bool)
+order by id
+limit @limit_count
+offset @offset_count
+;
+
+-- name: CreateLoanProductRate :exec
+INSERT INTO loan_product_rates(
+  product_id,
+  max_term_months,
+  min_down_payment_percent,
+  margin_rate
+) VALUES (
+  $1, $2, $3, $4
+);
+
+-- name: DeleteLoanProductRates :exec
+delete from loan_product_rates
+where product_id = $1
+;
+
+-- name: ListLoanProductRates :many
+select *
+from loan_product_rates
+where product_id = any(@product_ids::bigint[])
+order by product_id, max_term_months, min_down_payment_percent
+;
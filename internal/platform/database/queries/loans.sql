@@ -7,14 +7,120 @@ where id = $1
 -- name: CountLoansByUser :one
 select count(*)
 from loans
-where user_id = $1 and status = 'ACTIVE'
+where user_id = $1 and status in ('ACTIVE', 'OVERDUE')
 ;
 
 -- name: ListLoansByUser :many
 select *
 from loans
-where user_id = $1 and status = 'ACTIVE'
+where user_id = $1 and status in ('ACTIVE', 'OVERDUE')
 order by id desc
 limit $2
 offset $3
 ;
+
+-- name: CreateLoan :one
+INSERT INTO loans(
+  application_id,
+  user_id,
+  vehicle_vin,
+  currency_code,
+  amount,
+  term_months,
+  monthly_payment,
+  remaining_balance,
+  status,
+  margin_rate,
+  repayment_method,
+  contract_number
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+) RETURNING *;
+
+-- name: GetLoanForUpdate :one
+select *
+from loans
+where id = $1
+for update
+;
+
+-- name: UpdateLoanBalance :one
+update loans
+set remaining_balance = $2,
+    status = $3
+where id = $1
+returning *
+;
+
+-- name: RestoreActiveLoans :many
+update loans
+set status = 'ACTIVE',
+    days_past_due = 0
+where status = 'OVERDUE'
+  and not exists (
+    select 1
+    from installments
+    where installments.loan_id = loans.id
+      and installments.status <> 'PAID'
+      and installments.due_date < date_trunc('day', @as_of::timestamp)
+  )
+returning *
+;
+
+-- name: UpdateLoansDaysPastDue :execrows
+update loans
+set days_past_due = arrears.days_past_due
+from (
+  select loan_id, (@as_of::timestamp::date - min(due_date)::date) as days_past_due
+  from installments
+  where status <> 'PAID' and due_date < date_trunc('day', @as_of::timestamp)
+  group by loan_id
+) arrears
+where loans.id = arrears.loan_id and loans.status = 'OVERDUE'
+;
+
+-- name: MarkLoansOverdue :many
+update loans
+set status = 'OVERDUE',
+    days_past_due = arrears.days_past_due
+from (
+  select loan_id, (@as_of::timestamp::date - min(due_date)::date) as days_past_due
+  from installments
+  where status <> 'PAID' and due_date < date_trunc('day', @as_of::timestamp)
+  group by loan_id
+) arrears
+where loans.id = arrears.loan_id and loans.status = 'ACTIVE'
+returning loans.*
+;
+
+-- name: ListOverdueLoans :many
+select *
+from loans
+where status = 'OVERDUE'
+order by id
+;
+
+-- name: UpdateLoanSchedule :one
+update loans
+set term_months = $2,
+    monthly_payment = $3,
+    remaining_balance = $4,
+    status = $5
+where id = $1
+returning *
+;
+
+-- name: TransferLoanOwnership :one
+update loans
+set ownership_transferred_at = $2
+where id = $1
+returning *
+;
+
+-- name: ListLoansForReconciliation :many
+select *
+from loans
+where contract_number is not null and id > @after_id
+order by id
+limit @batch_size
+;
@@ -0,0 +1,37 @@
+-- name: CreateOutboxEvent :exec
+INSERT INTO outbox(
+  event_type,
+  aggregate_type,
+  aggregate_id,
+  payload,
+  event_version,
+  correlation_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+);
+
+-- name: ListPendingOutboxEventsForUpdate :many
+select *
+from outbox
+where published_at is null
+  and next_attempt_at <= NOW()
+order by id
+limit $1
+for update skip locked
+;
+
+-- name: MarkOutboxEventPublished :exec
+update outbox
+set published_at = NOW(),
+    attempts = attempts + 1,
+    last_error = null
+where id = $1
+;
+
+-- name: MarkOutboxEventFailed :exec
+update outbox
+set attempts = attempts + 1,
+    last_error = $2,
+    next_attempt_at = $3
+where id = $1
+;
@@ -0,0 +1,47 @@
+-- name: CreatePayment :one
+INSERT INTO payments(
+  loan_id,
+  currency_code,
+  payment_date,
+  amount,
+  method,
+  status,
+  transaction_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetPayment :one
+select *
+from payments
+where id = $1
+;
+
+-- name: GetPaymentByTransactionID :one
+select *
+from payments
+where transaction_id = $1
+;
+
+-- name: CountPayments :one
+select count(*)
+from payments
+where loan_id = $1
+;
+
+-- name: ListPaymentsByLoan :many
+select *
+from payments
+where loan_id = $1
+order by id desc
+limit $2
+offset $3
+;
+
+-- name: UpdatePayment :one
+update payments
+set status = $2,
+    payment_date = $3
+where id = $1
+returning *
+;
@@ -0,0 +1,30 @@
+-- name: CreatePayoffQuote :one
+INSERT INTO payoff_quotes(
+  loan_id,
+  principal,
+  margin,
+  margin_waived,
+  charges,
+  settlement_fee,
+  total,
+  remaining_balance,
+  valid_until
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: GetPayoffQuoteForUpdate :one
+select *
+from payoff_quotes
+where id = $1
+for update
+;
+
+-- name: MarkPayoffQuoteSettled :one
+update payoff_quotes
+set status = 'SETTLED',
+    payment_id = $2,
+    settled_at = NOW()
+where id = $1
+returning *
+;
@@ -0,0 +1,74 @@
+-- name: CreateReconciliationRun :one
+INSERT INTO reconciliation_runs DEFAULT VALUES
+RETURNING *;
+
+-- name: FinishReconciliationRun :one
+update reconciliation_runs
+set finished_at = NOW(),
+    loans_checked = $2,
+    issues_found = $3,
+    corrected = $4,
+    failed = $5,
+    error = $6
+where id = $1
+returning *
+;
+
+-- name: GetLastReconciliationRun :one
+select *
+from reconciliation_runs
+order by id desc
+limit 1
+;
+
+-- name: UpsertReconciliationIssue :one
+INSERT INTO reconciliation_issues(
+  run_id,
+  loan_id,
+  contract_number,
+  currency_code,
+  kind,
+  local_balance,
+  remote_balance,
+  local_status,
+  remote_status
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+) ON CONFLICT (loan_id, kind) WHERE status = 'OPEN' DO UPDATE
+set run_id = excluded.run_id,
+    contract_number = excluded.contract_number,
+    currency_code = excluded.currency_code,
+    local_balance = excluded.local_balance,
+    remote_balance = excluded.remote_balance,
+    local_status = excluded.local_status,
+    remote_status = excluded.remote_status
+RETURNING *;
+
+-- name: CloseReconciliationIssue :execrows
+update reconciliation_issues
+set status = $3,
+    resolved_at = NOW()
+where loan_id = $1 and kind = $2 and status = 'OPEN'
+;
+
+-- name: CountReconciliationIssues :one
+select count(*)
+from reconciliation_issues
+where status = $1
+;
+
+-- name: CountReconciliationIssuesByKind :many
+select kind, status, count(*)
+from reconciliation_issues
+group by kind, status
+order by kind, status
+;
+
+-- name: ListReconciliationIssues :many
+select *
+from reconciliation_issues
+where status = $1
+order by id desc
+limit $2
+offset $3
+;
@@ -0,0 +1,67 @@
+-- name: CreateVehicleHold :one
+INSERT INTO vehicle_holds(
+  application_id,
+  vehicle_vin,
+  koinot_hold_id,
+  expires_at
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetVehicleHoldByApplication :one
+select *
+from vehicle_holds
+where application_id = $1
+;
+
+-- name: GetActiveVehicleHoldByVin :one
+select *
+from vehicle_holds
+where vehicle_vin = $1
+  and status = 'ACTIVE'
+  and expires_at > NOW()
+;
+
+-- name: ExpireVehicleHolds :execrows
+update vehicle_holds
+set status = 'EXPIRED',
+    updated_at = NOW()
+where status = 'ACTIVE'
+  and expires_at <= NOW()
+;
+
+-- name: ExpireVehicleHoldsByVin :exec
+update vehicle_holds
+set status = 'EXPIRED',
+    updated_at = NOW()
+where vehicle_vin = $1
+  and status = 'ACTIVE'
+  and expires_at <= NOW()
+;
+
+-- name: ReleaseVehicleHold :one
+update vehicle_holds
+set status = 'RELEASED',
+    updated_at = NOW()
+where application_id = $1
+  and status = 'ACTIVE'
+returning *
+;
+
+-- name: RenewVehicleHold :one
+update vehicle_holds
+set koinot_hold_id = $2,
+    expires_at = $3,
+    status = 'ACTIVE',
+    updated_at = NOW()
+where application_id = $1
+returning *
+;
+
+-- name: MarkVehicleHoldSold :one
+update vehicle_holds
+set status = 'SOLD',
+    updated_at = NOW()
+where application_id = $1
+returning *
+;
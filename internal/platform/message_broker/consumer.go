@@ -0,0 +1,173 @@
+package messagebroker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"loan_service/configs"
+	"log"
+	"time"
+
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// ErrPoisonMessage marks a message that can never be processed. Handlers
+// wrap it so the consumer dead-letters the message instead of requeueing it.
+var ErrPoisonMessage = errors.New("message cannot be processed")
+
+// DeliveryHandler processes a single message. A nil error acks the message,
+// an error wrapping ErrPoisonMessage dead-letters it, and any other error
+// requeues it for another attempt.
+type DeliveryHandler func(ctx context.Context, delivery amqp091.Delivery) error
+
+// Consumer consumes a durable queue with manual acks. The queue is declared
+// with a dead-letter queue, so rejected messages are kept for inspection
+// rather than dropped.
+type Consumer struct {
+	conn            *amqp091.Connection
+	queue           string
+	deadLetterQueue string
+	exchange        string
+	routingKey      string
+	prefetch        int
+	retryDelay      time.Duration
+	handler         DeliveryHandler
+}
+
+func NewConsumer(conn *amqp091.Connection, cfg configs.ConsumerConfig, handler DeliveryHandler) (*Consumer, error) {
+	if cfg.Queue == "" {
+		return nil, errors.New("consumer queue is required")
+	}
+
+	if cfg.DeadLetterQueue == "" {
+		return nil, fmt.Errorf("dead letter queue is required for queue %s", cfg.Queue)
+	}
+
+	if cfg.Prefetch <= 0 {
+		return nil, fmt.Errorf("prefetch for queue %s must be positive, got %d", cfg.Queue, cfg.Prefetch)
+	}
+
+	retryDelay, err := time.ParseDuration(cfg.RetryDelay)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid retry delay format for queue %s: %w", cfg.Queue, err)
+	}
+
+	return &Consumer{
+		conn:            conn,
+		queue:           cfg.Queue,
+		deadLetterQueue: cfg.DeadLetterQueue,
+		exchange:        cfg.Exchange,
+		routingKey:      cfg.RoutingKey,
+		prefetch:        cfg.Prefetch,
+		retryDelay:      retryDelay,
+		handler:         handler,
+	}, nil
+}
+
+// Run consumes the queue until ctx is done. If the channel fails, it is
+// reopened after the retry delay.
+func (c *Consumer) Run(ctx context.Context) {
+	for {
+		err := c.consume(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Consumer of %s stopped: %s", c.queue, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.retryDelay):
+		}
+	}
+}
+
+func (c *Consumer) consume(ctx context.Context) error {
+	channel, err := c.conn.Channel()
+	if err != nil {
+		return fmt.Errorf("failed to open RabbitMQ channel: %w", err)
+	}
+	defer channel.Close()
+
+	if err := c.declare(channel); err != nil {
+		return err
+	}
+
+	if err := channel.Qos(c.prefetch, 0, false); err != nil {
+		return fmt.Errorf("failed to set prefetch for queue %s: %w", c.queue, err)
+	}
+
+	deliveries, err := channel.ConsumeWithContext(ctx, c.queue, "", false, false, false, false, nil)
+	if err != nil {
+		return fmt.Errorf("failed to consume queue %s: %w", c.queue, err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case delivery, ok := <-deliveries:
+			if !ok {
+				return errors.New("delivery channel closed")
+			}
+			c.handle(ctx, delivery)
+		}
+	}
+}
+
+// declare declares the dead-letter queue and the queue dead-lettering into
+// it, and binds the queue when an exchange is configured. The exchange
+// belongs to the publisher, so it is only checked, not declared.
+func (c *Consumer) declare(channel *amqp091.Channel) error {
+	if _, err := channel.QueueDeclare(c.deadLetterQueue, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("failed to declare queue %s: %w", c.deadLetterQueue, err)
+	}
+
+	args := amqp091.Table{
+		"x-dead-letter-exchange":    "",
+		"x-dead-letter-routing-key": c.deadLetterQueue,
+	}
+	if _, err := channel.QueueDeclare(c.queue, true, false, false, false, args); err != nil {
+		return fmt.Errorf("failed to declare queue %s: %w", c.queue, err)
+	}
+
+	if c.exchange == "" {
+		return nil
+	}
+
+	if err := channel.ExchangeDeclarePassive(c.exchange, amqp091.ExchangeTopic, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("exchange %s is not available: %w", c.exchange, err)
+	}
+
+	if err := channel.QueueBind(c.queue, c.routingKey, c.exchange, false, nil); err != nil {
+		return fmt.Errorf("failed to bind queue %s: %w", c.queue, err)
+	}
+
+	return nil
+}
+
+func (c *Consumer) handle(ctx context.Context, delivery amqp091.Delivery) {
+	err := c.handler(ctx, delivery)
+	switch {
+	case err == nil:
+		if err := delivery.Ack(false); err != nil {
+			log.Printf("Failed to ack message %s from %s: %s", delivery.MessageId, c.queue, err)
+		}
+
+	case errors.Is(err, ErrPoisonMessage):
+		log.Printf("Dead-lettering message %s from %s: %s", delivery.MessageId, c.queue, err)
+		if err := delivery.Nack(false, false); err != nil {
+			log.Printf("Failed to reject message %s from %s: %s", delivery.MessageId, c.queue, err)
+		}
+
+	default:
+		log.Printf("Requeueing message %s from %s: %s", delivery.MessageId, c.queue, err)
+		select {
+		case <-ctx.Done():
+		case <-time.After(c.retryDelay):
+		}
+		if err := delivery.Nack(false, true); err != nil {
+			log.Printf("Failed to requeue message %s from %s: %s", delivery.MessageId, c.queue, err)
+		}
+	}
+}
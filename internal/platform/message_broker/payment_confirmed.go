@@ -0,0 +1,101 @@
+package messagebroker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"loan_service/internal/dto"
+	"loan_service/internal/usecase"
+	"loan_service/pkg/money"
+	"log"
+	"time"
+
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// PaymentConfirmed is the message the payment service publishes once a
+// payment towards a loan has been charged. Amount is in major units.
+type PaymentConfirmed struct {
+	TransactionId string       `json:"transactionId"`
+	LoanId        int64        `json:"loanId"`
+	Amount        money.Amount `json:"amount"`
+	CurrencyCode  string       `json:"currencyCode"`
+	Method        string       `json:"method"`
+	PaidAt        time.Time    `json:"paidAt"`
+}
+
+// PaymentRecorder applies a payment to its loan.
+type PaymentRecorder interface {
+	RecordPayment(ctx context.Context, payment *dto.Payment) (*dto.Payment, *dto.Loan, error)
+}
+
+// NewPaymentConfirmedHandler returns the handler recording confirmed
+// payments. Payments are deduplicated by transaction id, so a redelivered
+// confirmation is acked without being applied again.
+func NewPaymentConfirmedHandler(recorder PaymentRecorder) DeliveryHandler {
+	return func(ctx context.Context, delivery amqp091.Delivery) error {
+		var confirmed PaymentConfirmed
+		if err := json.Unmarshal(delivery.Body, &confirmed); err != nil {
+			return fmt.Errorf("%w: invalid payment confirmation: %s", ErrPoisonMessage, err)
+		}
+
+		if err := validatePaymentConfirmed(confirmed); err != nil {
+			return fmt.Errorf("%w: %s", ErrPoisonMessage, err)
+		}
+
+		payment, _, err := recorder.RecordPayment(ctx, &dto.Payment{
+			LoanId:        confirmed.LoanId,
+			CurrencyCode:  confirmed.CurrencyCode,
+			PaymentDate:   confirmed.PaidAt,
+			Amount:        confirmed.Amount,
+			Method:        confirmed.Method,
+			TransactionId: confirmed.TransactionId,
+		})
+		if err != nil {
+			if errors.Is(err, usecase.ErrDuplicateTransaction) {
+				log.Printf("Payment confirmation %s is already recorded", confirmed.TransactionId)
+				return nil
+			}
+
+			// Not found or rejected by loan state
+			if errors.Is(err, sql.ErrNoRows) ||
+				errors.Is(err, usecase.ErrLoanClosed) ||
+				errors.Is(err, usecase.ErrCurrencyMismatch) ||
+				errors.Is(err, usecase.ErrPaymentExceedsBalance) ||
+				errors.Is(err, usecase.ErrPaymentMismatch) {
+				return fmt.Errorf("%w: payment %s for loan %d: %s", ErrPoisonMessage, confirmed.TransactionId, confirmed.LoanId, err)
+			}
+
+			return fmt.Errorf("failed to record payment %s: %w", confirmed.TransactionId, err)
+		}
+
+		log.Printf("Recorded payment %d for loan %d from transaction %s", payment.Id, payment.LoanId, confirmed.TransactionId)
+		return nil
+	}
+}
+
+func validatePaymentConfirmed(confirmed PaymentConfirmed) error {
+	if confirmed.TransactionId == "" {
+		return errors.New("transaction id is required")
+	}
+
+	if confirmed.LoanId <= 0 {
+		return fmt.Errorf("invalid loan id %d", confirmed.LoanId)
+	}
+
+	if confirmed.Amount <= 0 {
+		return errors.New("amount must be positive")
+	}
+
+	if err := money.Validate(confirmed.CurrencyCode); err != nil {
+		return err
+	}
+
+	if currency := money.Lookup(confirmed.CurrencyCode); currency.Round(float64(confirmed.Amount)) != confirmed.Amount {
+		return fmt.Errorf("amount %s has more than %d decimal places for %s", confirmed.Amount, currency.MinorUnits, confirmed.CurrencyCode)
+	}
+
+	return nil
+}
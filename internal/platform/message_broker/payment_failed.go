@@ -0,0 +1,64 @@
+package messagebroker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"loan_service/internal/dto"
+	"loan_service/internal/usecase"
+	"log"
+	"time"
+
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// PaymentFailed is the message the payment service publishes when a charge
+// it accepted fails later, e.g. when the card issuer declines it.
+type PaymentFailed struct {
+	TransactionId string    `json:"transactionId"`
+	LoanId        int64     `json:"loanId"`
+	FailureReason string    `json:"failureReason"`
+	FailedAt      time.Time `json:"failedAt"`
+}
+
+// PaymentFailer marks the payment of a failed charge as FAILED.
+type PaymentFailer interface {
+	FailPayment(ctx context.Context, transactionId string) (*dto.Payment, error)
+}
+
+// NewPaymentFailedHandler returns the handler failing the payments of failed
+// charges. A redelivered failure finds the payment FAILED already and is
+// acked; a failure arriving after the charge was confirmed leaves the payment
+// COMPLETED.
+func NewPaymentFailedHandler(failer PaymentFailer) DeliveryHandler {
+	return func(ctx context.Context, delivery amqp091.Delivery) error {
+		var failed PaymentFailed
+		if err := json.Unmarshal(delivery.Body, &failed); err != nil {
+			return fmt.Errorf("%w: invalid payment failure: %s", ErrPoisonMessage, err)
+		}
+
+		if failed.TransactionId == "" {
+			return fmt.Errorf("%w: transaction id is required", ErrPoisonMessage)
+		}
+
+		payment, err := failer.FailPayment(ctx, failed.TransactionId)
+		if err != nil {
+			if errors.Is(err, usecase.ErrPaymentCompleted) {
+				log.Printf("Payment failure %s arrived after its confirmation, the payment stays completed", failed.TransactionId)
+				return nil
+			}
+
+			// Not initiated by this service
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%w: payment %s for loan %d: %s", ErrPoisonMessage, failed.TransactionId, failed.LoanId, err)
+			}
+
+			return fmt.Errorf("failed to fail payment %s: %w", failed.TransactionId, err)
+		}
+
+		log.Printf("Payment %d for loan %d failed in the payment service: %s", payment.Id, payment.LoanId, failed.FailureReason)
+		return nil
+	}
+}
@@ -0,0 +1,157 @@
+package messagebroker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"loan_service/configs"
+	"loan_service/internal/dto"
+	"loan_service/internal/events"
+	"sync"
+
+	"github.com/rabbitmq/amqp091-go"
+)
+
+var ErrPublishNotConfirmed = errors.New("broker did not confirm the message")
+
+// Publisher publishes outbox events to a durable topic exchange with the
+// event type as the routing key. The channel is in confirm mode and Publish
+// waits for the broker's ack, so an event counts as published only once the
+// broker has taken responsibility for it.
+type Publisher struct {
+	conn     *amqp091.Connection
+	exchange string
+	queues   []string
+
+	mu      sync.Mutex
+	channel *amqp091.Channel
+}
+
+func NewPublisher(conn *amqp091.Connection, cfg configs.RabbitMQConfig) (*Publisher, error) {
+	if cfg.Exchange == "" {
+		return nil, errors.New("rabbitmq exchange is required")
+	}
+
+	p := &Publisher{
+		conn:     conn,
+		exchange: cfg.Exchange,
+		queues:   cfg.QueueNames,
+	}
+
+	if _, err := p.openChannel(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// openChannel opens a confirm-mode channel and declares the exchange and the
+// configured queues bound to every event. It must be called with mu held or
+// before the publisher is shared.
+func (p *Publisher) openChannel() (*amqp091.Channel, error) {
+	channel, err := p.conn.Channel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open RabbitMQ channel: %w", err)
+	}
+
+	if err := channel.Confirm(false); err != nil {
+		channel.Close()
+		return nil, fmt.Errorf("failed to enable publisher confirms: %w", err)
+	}
+
+	if err := channel.ExchangeDeclare(p.exchange, amqp091.ExchangeTopic, true, false, false, false, nil); err != nil {
+		channel.Close()
+		return nil, fmt.Errorf("failed to declare exchange %s: %w", p.exchange, err)
+	}
+
+	for _, queue := range p.queues {
+		if _, err := channel.QueueDeclare(queue, true, false, false, false, nil); err != nil {
+			channel.Close()
+			return nil, fmt.Errorf("failed to declare queue %s: %w", queue, err)
+		}
+
+		if err := channel.QueueBind(queue, "#", p.exchange, false, nil); err != nil {
+			channel.Close()
+			return nil, fmt.Errorf("failed to bind queue %s: %w", queue, err)
+		}
+	}
+
+	p.channel = channel
+	return channel, nil
+}
+
+func (p *Publisher) Publish(ctx context.Context, event *dto.OutboxEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	channel := p.channel
+	if channel == nil || channel.IsClosed() {
+		var err error
+		if channel, err = p.openChannel(); err != nil {
+			return err
+		}
+	}
+
+	body, err := encodeEvent(event)
+	if err != nil {
+		return err
+	}
+
+	confirmation, err := channel.PublishWithDeferredConfirmWithContext(ctx, p.exchange, event.Type, false, false, amqp091.Publishing{
+		ContentType:  events.ContentType,
+		DeliveryMode: amqp091.Persistent,
+		MessageId:    event.EventId,
+		Type:         event.Type,
+		Timestamp:    event.CreatedAt,
+		Headers: amqp091.Table{
+			"event_version":  event.Version,
+			"aggregate_type": event.AggregateType,
+			"aggregate_id":   event.AggregateId,
+		},
+		CorrelationId: event.CorrelationId,
+		Body:          body,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish %s event %s: %w", event.Type, event.EventId, err)
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to confirm %s event %s: %w", event.Type, event.EventId, err)
+	}
+	if !acked {
+		return fmt.Errorf("%w: %s event %s", ErrPublishNotConfirmed, event.Type, event.EventId)
+	}
+
+	return nil
+}
+
+// encodeEvent wraps an outbox event in the versioned envelope consumers
+// decode with events.Decode.
+func encodeEvent(event *dto.OutboxEvent) ([]byte, error) {
+	payload, err := events.UnmarshalPayload(event.Type, event.Version, event.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s event %s: %w", event.Type, event.EventId, err)
+	}
+
+	return events.Encode(events.Metadata{
+		EventId:       event.EventId,
+		Type:          event.Type,
+		Version:       event.Version,
+		OccurredAt:    event.CreatedAt,
+		AggregateType: event.AggregateType,
+		AggregateId:   event.AggregateId,
+		CorrelationId: event.CorrelationId,
+	}, payload)
+}
+
+func (p *Publisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.channel == nil {
+		return nil
+	}
+
+	return p.channel.Close()
+}
@@ -0,0 +1,782 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: internal/proto/events/loan_events.proto
+
+package eventspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// -------------------- Envelope --------------------
+// EventEnvelope is the body of every message published on the events
+// exchange. payload holds the serialized event message named by event_type
+// at the given version, e.g. event_type "LoanOriginated", version 1 is
+// LoanOriginatedV1.
+type EventEnvelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`       // unique per event, also the AMQP message_id
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // also the routing key
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`          // RFC 3339
+	AggregateType string                 `protobuf:"bytes,5,opt,name=aggregate_type,json=aggregateType,proto3" json:"aggregate_type,omitempty"` // loan_application, loan
+	AggregateId   int64                  `protobuf:"varint,6,opt,name=aggregate_id,json=aggregateId,proto3" json:"aggregate_id,omitempty"`
+	CorrelationId string                 `protobuf:"bytes,7,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"` // x-correlation-id of the originating call, empty for scheduled jobs
+	Payload       []byte                 `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventEnvelope) Reset() {
+	*x = EventEnvelope{}
+	mi := &file_internal_proto_events_loan_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventEnvelope) ProtoMessage() {}
+
+func (x *EventEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_events_loan_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventEnvelope.ProtoReflect.Descriptor instead.
+func (*EventEnvelope) Descriptor() ([]byte, []int) {
+	return file_internal_proto_events_loan_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventEnvelope) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *EventEnvelope) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetAggregateType() string {
+	if x != nil {
+		return x.AggregateType
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetAggregateId() int64 {
+	if x != nil {
+		return x.AggregateId
+	}
+	return 0
+}
+
+func (x *EventEnvelope) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *EventEnvelope) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// Money is an exact amount in hundredths of the major currency unit:
+// amount 123456 with currency_code "TJS" is 1234.56 TJS.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	CurrencyCode  string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_internal_proto_events_loan_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_events_loan_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_internal_proto_events_loan_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+type ApplicationCreatedV1 struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId   int64                  `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	UserId          int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type            string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	VehicleVin      string                 `protobuf:"bytes,4,opt,name=vehicle_vin,json=vehicleVin,proto3" json:"vehicle_vin,omitempty"`
+	Price           *Money                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	NetPrice        *Money                 `protobuf:"bytes,6,opt,name=net_price,json=netPrice,proto3" json:"net_price,omitempty"`
+	TermMonths      int32                  `protobuf:"varint,7,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	RepaymentMethod string                 `protobuf:"bytes,8,opt,name=repayment_method,json=repaymentMethod,proto3" json:"repayment_method,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApplicationCreatedV1) Reset() {
+	*x = ApplicationCreatedV1{}
+	mi := &file_internal_proto_events_loan_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationCreatedV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationCreatedV1) ProtoMessage() {}
+
+func (x *ApplicationCreatedV1) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_events_loan_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationCreatedV1.ProtoReflect.Descriptor instead.
+func (*ApplicationCreatedV1) Descriptor() ([]byte, []int) {
+	return file_internal_proto_events_loan_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ApplicationCreatedV1) GetApplicationId() int64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *ApplicationCreatedV1) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ApplicationCreatedV1) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ApplicationCreatedV1) GetVehicleVin() string {
+	if x != nil {
+		return x.VehicleVin
+	}
+	return ""
+}
+
+func (x *ApplicationCreatedV1) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ApplicationCreatedV1) GetNetPrice() *Money {
+	if x != nil {
+		return x.NetPrice
+	}
+	return nil
+}
+
+func (x *ApplicationCreatedV1) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *ApplicationCreatedV1) GetRepaymentMethod() string {
+	if x != nil {
+		return x.RepaymentMethod
+	}
+	return ""
+}
+
+type ApplicationStatusChangedV1 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId int64                  `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,3,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,4,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Actor         string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationStatusChangedV1) Reset() {
+	*x = ApplicationStatusChangedV1{}
+	mi := &file_internal_proto_events_loan_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationStatusChangedV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationStatusChangedV1) ProtoMessage() {}
+
+func (x *ApplicationStatusChangedV1) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_events_loan_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationStatusChangedV1.ProtoReflect.Descriptor instead.
+func (*ApplicationStatusChangedV1) Descriptor() ([]byte, []int) {
+	return file_internal_proto_events_loan_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ApplicationStatusChangedV1) GetApplicationId() int64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *ApplicationStatusChangedV1) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ApplicationStatusChangedV1) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *ApplicationStatusChangedV1) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *ApplicationStatusChangedV1) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ApplicationStatusChangedV1) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type LoanOriginatedV1 struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LoanId           int64                  `protobuf:"varint,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	ApplicationId    int64                  `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	UserId           int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount           *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	TermMonths       int32                  `protobuf:"varint,5,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	MonthlyPayment   *Money                 `protobuf:"bytes,6,opt,name=monthly_payment,json=monthlyPayment,proto3" json:"monthly_payment,omitempty"`
+	RemainingBalance *Money                 `protobuf:"bytes,7,opt,name=remaining_balance,json=remainingBalance,proto3" json:"remaining_balance,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LoanOriginatedV1) Reset() {
+	*x = LoanOriginatedV1{}
+	mi := &file_internal_proto_events_loan_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanOriginatedV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanOriginatedV1) ProtoMessage() {}
+
+func (x *LoanOriginatedV1) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_events_loan_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanOriginatedV1.ProtoReflect.Descriptor instead.
+func (*LoanOriginatedV1) Descriptor() ([]byte, []int) {
+	return file_internal_proto_events_loan_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LoanOriginatedV1) GetLoanId() int64 {
+	if x != nil {
+		return x.LoanId
+	}
+	return 0
+}
+
+func (x *LoanOriginatedV1) GetApplicationId() int64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *LoanOriginatedV1) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LoanOriginatedV1) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *LoanOriginatedV1) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *LoanOriginatedV1) GetMonthlyPayment() *Money {
+	if x != nil {
+		return x.MonthlyPayment
+	}
+	return nil
+}
+
+func (x *LoanOriginatedV1) GetRemainingBalance() *Money {
+	if x != nil {
+		return x.RemainingBalance
+	}
+	return nil
+}
+
+type PaymentReceivedV1 struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId        int64                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	LoanId           int64                  `protobuf:"varint,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	UserId           int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount           *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	PaymentDate      string                 `protobuf:"bytes,5,opt,name=payment_date,json=paymentDate,proto3" json:"payment_date,omitempty"` // RFC 3339
+	TransactionId    string                 `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	RemainingBalance *Money                 `protobuf:"bytes,7,opt,name=remaining_balance,json=remainingBalance,proto3" json:"remaining_balance,omitempty"`
+	LoanStatus       string                 `protobuf:"bytes,8,opt,name=loan_status,json=loanStatus,proto3" json:"loan_status,omitempty"`
+	// Part of amount that paid late charges of a MURABAHA or IJARA loan. It is
+	// not income and must be passed on to charity_account.
+	CharityAmount          *Money `protobuf:"bytes,9,opt,name=charity_amount,json=charityAmount,proto3" json:"charity_amount,omitempty"`
+	CharityAccount         string `protobuf:"bytes,10,opt,name=charity_account,json=charityAccount,proto3" json:"charity_account,omitempty"`
+	OwnershipTransferredAt string `protobuf:"bytes,11,opt,name=ownership_transferred_at,json=ownershipTransferredAt,proto3" json:"ownership_transferred_at,omitempty"` // RFC 3339; set once an IJARA loan is paid off
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *PaymentReceivedV1) Reset() {
+	*x = PaymentReceivedV1{}
+	mi := &file_internal_proto_events_loan_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentReceivedV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentReceivedV1) ProtoMessage() {}
+
+func (x *PaymentReceivedV1) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_events_loan_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentReceivedV1.ProtoReflect.Descriptor instead.
+func (*PaymentReceivedV1) Descriptor() ([]byte, []int) {
+	return file_internal_proto_events_loan_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PaymentReceivedV1) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *PaymentReceivedV1) GetLoanId() int64 {
+	if x != nil {
+		return x.LoanId
+	}
+	return 0
+}
+
+func (x *PaymentReceivedV1) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PaymentReceivedV1) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PaymentReceivedV1) GetPaymentDate() string {
+	if x != nil {
+		return x.PaymentDate
+	}
+	return ""
+}
+
+func (x *PaymentReceivedV1) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *PaymentReceivedV1) GetRemainingBalance() *Money {
+	if x != nil {
+		return x.RemainingBalance
+	}
+	return nil
+}
+
+func (x *PaymentReceivedV1) GetLoanStatus() string {
+	if x != nil {
+		return x.LoanStatus
+	}
+	return ""
+}
+
+func (x *PaymentReceivedV1) GetCharityAmount() *Money {
+	if x != nil {
+		return x.CharityAmount
+	}
+	return nil
+}
+
+func (x *PaymentReceivedV1) GetCharityAccount() string {
+	if x != nil {
+		return x.CharityAccount
+	}
+	return ""
+}
+
+func (x *PaymentReceivedV1) GetOwnershipTransferredAt() string {
+	if x != nil {
+		return x.OwnershipTransferredAt
+	}
+	return ""
+}
+
+type LoanOverdueV1 struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LoanId           int64                  `protobuf:"varint,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	UserId           int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DaysPastDue      int32                  `protobuf:"varint,3,opt,name=days_past_due,json=daysPastDue,proto3" json:"days_past_due,omitempty"`
+	RemainingBalance *Money                 `protobuf:"bytes,4,opt,name=remaining_balance,json=remainingBalance,proto3" json:"remaining_balance,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LoanOverdueV1) Reset() {
+	*x = LoanOverdueV1{}
+	mi := &file_internal_proto_events_loan_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanOverdueV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanOverdueV1) ProtoMessage() {}
+
+func (x *LoanOverdueV1) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_events_loan_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanOverdueV1.ProtoReflect.Descriptor instead.
+func (*LoanOverdueV1) Descriptor() ([]byte, []int) {
+	return file_internal_proto_events_loan_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LoanOverdueV1) GetLoanId() int64 {
+	if x != nil {
+		return x.LoanId
+	}
+	return 0
+}
+
+func (x *LoanOverdueV1) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LoanOverdueV1) GetDaysPastDue() int32 {
+	if x != nil {
+		return x.DaysPastDue
+	}
+	return 0
+}
+
+func (x *LoanOverdueV1) GetRemainingBalance() *Money {
+	if x != nil {
+		return x.RemainingBalance
+	}
+	return nil
+}
+
+var File_internal_proto_events_loan_events_proto protoreflect.FileDescriptor
+
+const file_internal_proto_events_loan_events_proto_rawDesc = "" +
+	"\n" +
+	"'internal/proto/events/loan_events.proto\x12\beventspb\"\x8f\x02\n" +
+	"\rEventEnvelope\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\tR\n" +
+	"occurredAt\x12%\n" +
+	"\x0eaggregate_type\x18\x05 \x01(\tR\raggregateType\x12!\n" +
+	"\faggregate_id\x18\x06 \x01(\x03R\vaggregateId\x12%\n" +
+	"\x0ecorrelation_id\x18\a \x01(\tR\rcorrelationId\x12\x18\n" +
+	"\apayload\x18\b \x01(\fR\apayload\"D\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\"\xac\x02\n" +
+	"\x14ApplicationCreatedV1\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\x03R\rapplicationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1f\n" +
+	"\vvehicle_vin\x18\x04 \x01(\tR\n" +
+	"vehicleVin\x12%\n" +
+	"\x05price\x18\x05 \x01(\v2\x0f.eventspb.MoneyR\x05price\x12,\n" +
+	"\tnet_price\x18\x06 \x01(\v2\x0f.eventspb.MoneyR\bnetPrice\x12\x1f\n" +
+	"\vterm_months\x18\a \x01(\x05R\n" +
+	"termMonths\x12)\n" +
+	"\x10repayment_method\x18\b \x01(\tR\x0frepaymentMethod\"\xc8\x01\n" +
+	"\x1aApplicationStatusChangedV1\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\x03R\rapplicationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vfrom_status\x18\x03 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x04 \x01(\tR\btoStatus\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"\xad\x02\n" +
+	"\x10LoanOriginatedV1\x12\x17\n" +
+	"\aloan_id\x18\x01 \x01(\x03R\x06loanId\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\x03R\rapplicationId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12'\n" +
+	"\x06amount\x18\x04 \x01(\v2\x0f.eventspb.MoneyR\x06amount\x12\x1f\n" +
+	"\vterm_months\x18\x05 \x01(\x05R\n" +
+	"termMonths\x128\n" +
+	"\x0fmonthly_payment\x18\x06 \x01(\v2\x0f.eventspb.MoneyR\x0emonthlyPayment\x12<\n" +
+	"\x11remaining_balance\x18\a \x01(\v2\x0f.eventspb.MoneyR\x10remainingBalance\"\xd1\x03\n" +
+	"\x11PaymentReceivedV1\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x03R\tpaymentId\x12\x17\n" +
+	"\aloan_id\x18\x02 \x01(\x03R\x06loanId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12'\n" +
+	"\x06amount\x18\x04 \x01(\v2\x0f.eventspb.MoneyR\x06amount\x12!\n" +
+	"\fpayment_date\x18\x05 \x01(\tR\vpaymentDate\x12%\n" +
+	"\x0etransaction_id\x18\x06 \x01(\tR\rtransactionId\x12<\n" +
+	"\x11remaining_balance\x18\a \x01(\v2\x0f.eventspb.MoneyR\x10remainingBalance\x12\x1f\n" +
+	"\vloan_status\x18\b \x01(\tR\n" +
+	"loanStatus\x126\n" +
+	"\x0echarity_amount\x18\t \x01(\v2\x0f.eventspb.MoneyR\rcharityAmount\x12'\n" +
+	"\x0fcharity_account\x18\n" +
+	" \x01(\tR\x0echarityAccount\x128\n" +
+	"\x18ownership_transferred_at\x18\v \x01(\tR\x16ownershipTransferredAt\"\xa3\x01\n" +
+	"\rLoanOverdueV1\x12\x17\n" +
+	"\aloan_id\x18\x01 \x01(\x03R\x06loanId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\"\n" +
+	"\rdays_past_due\x18\x03 \x01(\x05R\vdaysPastDue\x12<\n" +
+	"\x11remaining_balance\x18\x04 \x01(\v2\x0f.eventspb.MoneyR\x10remainingBalanceB\x19Z\x17internal/proto/eventspbb\x06proto3"
+
+var (
+	file_internal_proto_events_loan_events_proto_rawDescOnce sync.Once
+	file_internal_proto_events_loan_events_proto_rawDescData []byte
+)
+
+func file_internal_proto_events_loan_events_proto_rawDescGZIP() []byte {
+	file_internal_proto_events_loan_events_proto_rawDescOnce.Do(func() {
+		file_internal_proto_events_loan_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_proto_events_loan_events_proto_rawDesc), len(file_internal_proto_events_loan_events_proto_rawDesc)))
+	})
+	return file_internal_proto_events_loan_events_proto_rawDescData
+}
+
+var file_internal_proto_events_loan_events_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_internal_proto_events_loan_events_proto_goTypes = []any{
+	(*EventEnvelope)(nil),              // 0: eventspb.EventEnvelope
+	(*Money)(nil),                      // 1: eventspb.Money
+	(*ApplicationCreatedV1)(nil),       // 2: eventspb.ApplicationCreatedV1
+	(*ApplicationStatusChangedV1)(nil), // 3: eventspb.ApplicationStatusChangedV1
+	(*LoanOriginatedV1)(nil),           // 4: eventspb.LoanOriginatedV1
+	(*PaymentReceivedV1)(nil),          // 5: eventspb.PaymentReceivedV1
+	(*LoanOverdueV1)(nil),              // 6: eventspb.LoanOverdueV1
+}
+var file_internal_proto_events_loan_events_proto_depIdxs = []int32{
+	1, // 0: eventspb.ApplicationCreatedV1.price:type_name -> eventspb.Money
+	1, // 1: eventspb.ApplicationCreatedV1.net_price:type_name -> eventspb.Money
+	1, // 2: eventspb.LoanOriginatedV1.amount:type_name -> eventspb.Money
+	1, // 3: eventspb.LoanOriginatedV1.monthly_payment:type_name -> eventspb.Money
+	1, // 4: eventspb.LoanOriginatedV1.remaining_balance:type_name -> eventspb.Money
+	1, // 5: eventspb.PaymentReceivedV1.amount:type_name -> eventspb.Money
+	1, // 6: eventspb.PaymentReceivedV1.remaining_balance:type_name -> eventspb.Money
+	1, // 7: eventspb.PaymentReceivedV1.charity_amount:type_name -> eventspb.Money
+	1, // 8: eventspb.LoanOverdueV1.remaining_balance:type_name -> eventspb.Money
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_internal_proto_events_loan_events_proto_init() }
+func file_internal_proto_events_loan_events_proto_init() {
+	if File_internal_proto_events_loan_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_proto_events_loan_events_proto_rawDesc), len(file_internal_proto_events_loan_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_proto_events_loan_events_proto_goTypes,
+		DependencyIndexes: file_internal_proto_events_loan_events_proto_depIdxs,
+		MessageInfos:      file_internal_proto_events_loan_events_proto_msgTypes,
+	}.Build()
+	File_internal_proto_events_loan_events_proto = out.File
+	file_internal_proto_events_loan_events_proto_goTypes = nil
+	file_internal_proto_events_loan_events_proto_depIdxs = nil
+}
@@ -2,7 +2,7 @@
 // versions:
 // 	protoc-gen-go v1.36.10
 // 	protoc        v3.21.12
-// source: internal/proto/loan/loan_service.proto
+// source: loan_service.proto
 
 package loanpb
 
@@ -25,13 +25,14 @@ type LoanServiceError struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	FieldErrors   []*FieldError          `protobuf:"bytes,3,rep,name=field_errors,json=fieldErrors,proto3" json:"field_errors,omitempty"` // fields that failed validation, with code 1
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoanServiceError) Reset() {
 	*x = LoanServiceError{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[0]
+	mi := &file_loan_service_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -43,7 +44,7 @@ func (x *LoanServiceError) String() string {
 func (*LoanServiceError) ProtoMessage() {}
 
 func (x *LoanServiceError) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[0]
+	mi := &file_loan_service_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -56,7 +57,7 @@ func (x *LoanServiceError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoanServiceError.ProtoReflect.Descriptor instead.
 func (*LoanServiceError) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{0}
+	return file_loan_service_proto_rawDescGZIP(), []int{0}
 }
 
 func (x *LoanServiceError) GetCode() int32 {
@@ -73,7 +74,120 @@ func (x *LoanServiceError) GetDescription() string {
 	return ""
 }
 
+func (x *LoanServiceError) GetFieldErrors() []*FieldError {
+	if x != nil {
+		return x.FieldErrors
+	}
+	return nil
+}
+
+type FieldError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // request field name, e.g. "down_payment"
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldError) Reset() {
+	*x = FieldError{}
+	mi := &file_loan_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldError) ProtoMessage() {}
+
+func (x *FieldError) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldError.ProtoReflect.Descriptor instead.
+func (*FieldError) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FieldError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldError) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
 // -------------------- Core models --------------------
+// Money is an exact amount in hundredths of the major currency unit:
+// amount 123456 with currency_code "TJS" is 1234.56 TJS.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	CurrencyCode  string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_loan_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
 type Vehicle struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ImageUrl      string                 `protobuf:"bytes,1,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
@@ -81,15 +195,20 @@ type Vehicle struct {
 	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	EngineType    string                 `protobuf:"bytes,4,opt,name=engine_type,json=engineType,proto3" json:"engine_type,omitempty"`
 	Configuration string                 `protobuf:"bytes,5,opt,name=configuration,proto3" json:"configuration,omitempty"`
-	Price         int64                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
-	CurrencyCode  string                 `protobuf:"bytes,7,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"` // "TJS", "USD"
+	Price         *Money                 `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // "AVAILABLE", "RESERVED", "SOLD"; empty if the dealer does not report it
+	// Whole currency units, as sent before amounts became Money. Kept for
+	// clients built against that version; responses fill both.
+	//
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyPrice   int64 `protobuf:"varint,6,opt,name=legacy_price,json=legacyPrice,proto3" json:"legacy_price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Vehicle) Reset() {
 	*x = Vehicle{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[1]
+	mi := &file_loan_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -101,7 +220,7 @@ func (x *Vehicle) String() string {
 func (*Vehicle) ProtoMessage() {}
 
 func (x *Vehicle) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[1]
+	mi := &file_loan_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -114,7 +233,7 @@ func (x *Vehicle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Vehicle.ProtoReflect.Descriptor instead.
 func (*Vehicle) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{1}
+	return file_loan_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Vehicle) GetImageUrl() string {
@@ -152,44 +271,72 @@ func (x *Vehicle) GetConfiguration() string {
 	return ""
 }
 
-func (x *Vehicle) GetPrice() int64 {
+func (x *Vehicle) GetPrice() *Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
-func (x *Vehicle) GetCurrencyCode() string {
+func (x *Vehicle) GetStatus() string {
 	if x != nil {
-		return x.CurrencyCode
+		return x.Status
 	}
 	return ""
 }
 
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *Vehicle) GetLegacyPrice() int64 {
+	if x != nil {
+		return x.LegacyPrice
+	}
+	return 0
+}
+
 type LoanApplication struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	VehicleVin     string                 `protobuf:"bytes,4,opt,name=vehicle_vin,json=vehicleVin,proto3" json:"vehicle_vin,omitempty"`
-	VehicleName    string                 `protobuf:"bytes,5,opt,name=vehicle_name,json=vehicleName,proto3" json:"vehicle_name,omitempty"`
-	CurrencyCode   string                 `protobuf:"bytes,6,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
-	Price          int64                  `protobuf:"varint,7,opt,name=price,proto3" json:"price,omitempty"`
-	DownPayment    int64                  `protobuf:"varint,8,opt,name=down_payment,json=downPayment,proto3" json:"down_payment,omitempty"`
-	NetPrice       int64                  `protobuf:"varint,9,opt,name=net_price,json=netPrice,proto3" json:"net_price,omitempty"`
-	MarginRate     float64                `protobuf:"fixed64,10,opt,name=margin_rate,json=marginRate,proto3" json:"margin_rate,omitempty"`
-	TermMonths     int32                  `protobuf:"varint,11,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
-	MonthlyPayment int64                  `protobuf:"varint,12,opt,name=monthly_payment,json=monthlyPayment,proto3" json:"monthly_payment,omitempty"`
-	Status         string                 `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt      string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      string                 `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	Id                         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId                     string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type                       string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	VehicleVin                 string                 `protobuf:"bytes,4,opt,name=vehicle_vin,json=vehicleVin,proto3" json:"vehicle_vin,omitempty"`
+	VehicleName                string                 `protobuf:"bytes,5,opt,name=vehicle_name,json=vehicleName,proto3" json:"vehicle_name,omitempty"`
+	CurrencyCode               string                 `protobuf:"bytes,6,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Price                      *Money                 `protobuf:"bytes,25,opt,name=price,proto3" json:"price,omitempty"`
+	DownPayment                *Money                 `protobuf:"bytes,26,opt,name=down_payment,json=downPayment,proto3" json:"down_payment,omitempty"`
+	NetPrice                   *Money                 `protobuf:"bytes,27,opt,name=net_price,json=netPrice,proto3" json:"net_price,omitempty"`
+	MarginRate                 float64                `protobuf:"fixed64,10,opt,name=margin_rate,json=marginRate,proto3" json:"margin_rate,omitempty"`
+	TermMonths                 int32                  `protobuf:"varint,11,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	MonthlyPayment             *Money                 `protobuf:"bytes,28,opt,name=monthly_payment,json=monthlyPayment,proto3" json:"monthly_payment,omitempty"`
+	Status                     string                 `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt                  string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt                  string                 `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	RepaymentMethod            string                 `protobuf:"bytes,16,opt,name=repayment_method,json=repaymentMethod,proto3" json:"repayment_method,omitempty"`
+	VehiclePrice               *Money                 `protobuf:"bytes,17,opt,name=vehicle_price,json=vehiclePrice,proto3" json:"vehicle_price,omitempty"`                                       // price in the vehicle's own currency
+	ExchangeRate               float64                `protobuf:"fixed64,18,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`                                     // rate vehicle_price was converted into currency_code at
+	ContractNumber             string                 `protobuf:"bytes,19,opt,name=contract_number,json=contractNumber,proto3" json:"contract_number,omitempty"`                                 // assigned by ASR Leasing on origination
+	DealerNotificationStatus   string                 `protobuf:"bytes,20,opt,name=dealer_notification_status,json=dealerNotificationStatus,proto3" json:"dealer_notification_status,omitempty"` // delivery to Koinot Auto: PENDING, SENT, FAILED
+	DealerNotificationAttempts int32                  `protobuf:"varint,21,opt,name=dealer_notification_attempts,json=dealerNotificationAttempts,proto3" json:"dealer_notification_attempts,omitempty"`
+	DealerNotificationError    string                 `protobuf:"bytes,22,opt,name=dealer_notification_error,json=dealerNotificationError,proto3" json:"dealer_notification_error,omitempty"` // last delivery failure
+	DealerNotifiedAt           string                 `protobuf:"bytes,23,opt,name=dealer_notified_at,json=dealerNotifiedAt,proto3" json:"dealer_notified_at,omitempty"`
+	ProductId                  string                 `protobuf:"bytes,24,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // loan product the application is financed under
+	// Whole currency units, as sent before amounts became Money. Kept for
+	// clients built against that version; responses fill both.
+	//
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyPrice int64 `protobuf:"varint,7,opt,name=legacy_price,json=legacyPrice,proto3" json:"legacy_price,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyDownPayment int64 `protobuf:"varint,8,opt,name=legacy_down_payment,json=legacyDownPayment,proto3" json:"legacy_down_payment,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyNetPrice int64 `protobuf:"varint,9,opt,name=legacy_net_price,json=legacyNetPrice,proto3" json:"legacy_net_price,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyMonthlyPayment int64 `protobuf:"varint,12,opt,name=legacy_monthly_payment,json=legacyMonthlyPayment,proto3" json:"legacy_monthly_payment,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *LoanApplication) Reset() {
 	*x = LoanApplication{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[2]
+	mi := &file_loan_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -201,7 +348,7 @@ func (x *LoanApplication) String() string {
 func (*LoanApplication) ProtoMessage() {}
 
 func (x *LoanApplication) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[2]
+	mi := &file_loan_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -214,7 +361,7 @@ func (x *LoanApplication) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoanApplication.ProtoReflect.Descriptor instead.
 func (*LoanApplication) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{2}
+	return file_loan_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *LoanApplication) GetId() string {
@@ -259,25 +406,25 @@ func (x *LoanApplication) GetCurrencyCode() string {
 	return ""
 }
 
-func (x *LoanApplication) GetPrice() int64 {
+func (x *LoanApplication) GetPrice() *Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
-func (x *LoanApplication) GetDownPayment() int64 {
+func (x *LoanApplication) GetDownPayment() *Money {
 	if x != nil {
 		return x.DownPayment
 	}
-	return 0
+	return nil
 }
 
-func (x *LoanApplication) GetNetPrice() int64 {
+func (x *LoanApplication) GetNetPrice() *Money {
 	if x != nil {
 		return x.NetPrice
 	}
-	return 0
+	return nil
 }
 
 func (x *LoanApplication) GetMarginRate() float64 {
@@ -294,11 +441,11 @@ func (x *LoanApplication) GetTermMonths() int32 {
 	return 0
 }
 
-func (x *LoanApplication) GetMonthlyPayment() int64 {
+func (x *LoanApplication) GetMonthlyPayment() *Money {
 	if x != nil {
 		return x.MonthlyPayment
 	}
-	return 0
+	return nil
 }
 
 func (x *LoanApplication) GetStatus() string {
@@ -322,26 +469,141 @@ func (x *LoanApplication) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *LoanApplication) GetRepaymentMethod() string {
+	if x != nil {
+		return x.RepaymentMethod
+	}
+	return ""
+}
+
+func (x *LoanApplication) GetVehiclePrice() *Money {
+	if x != nil {
+		return x.VehiclePrice
+	}
+	return nil
+}
+
+func (x *LoanApplication) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+func (x *LoanApplication) GetContractNumber() string {
+	if x != nil {
+		return x.ContractNumber
+	}
+	return ""
+}
+
+func (x *LoanApplication) GetDealerNotificationStatus() string {
+	if x != nil {
+		return x.DealerNotificationStatus
+	}
+	return ""
+}
+
+func (x *LoanApplication) GetDealerNotificationAttempts() int32 {
+	if x != nil {
+		return x.DealerNotificationAttempts
+	}
+	return 0
+}
+
+func (x *LoanApplication) GetDealerNotificationError() string {
+	if x != nil {
+		return x.DealerNotificationError
+	}
+	return ""
+}
+
+func (x *LoanApplication) GetDealerNotifiedAt() string {
+	if x != nil {
+		return x.DealerNotifiedAt
+	}
+	return ""
+}
+
+func (x *LoanApplication) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *LoanApplication) GetLegacyPrice() int64 {
+	if x != nil {
+		return x.LegacyPrice
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *LoanApplication) GetLegacyDownPayment() int64 {
+	if x != nil {
+		return x.LegacyDownPayment
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *LoanApplication) GetLegacyNetPrice() int64 {
+	if x != nil {
+		return x.LegacyNetPrice
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *LoanApplication) GetLegacyMonthlyPayment() int64 {
+	if x != nil {
+		return x.LegacyMonthlyPayment
+	}
+	return 0
+}
+
 type Loan struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ApplicationId    string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	UserId           string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CurrencyCode     string                 `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
-	VehicleVin       string                 `protobuf:"bytes,5,opt,name=vehicle_vin,json=vehicleVin,proto3" json:"vehicle_vin,omitempty"`
-	Amount           int64                  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	TermMonths       int32                  `protobuf:"varint,7,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
-	MonthlyPayment   int64                  `protobuf:"varint,8,opt,name=monthly_payment,json=monthlyPayment,proto3" json:"monthly_payment,omitempty"`
-	RemainingBalance int64                  `protobuf:"varint,9,opt,name=remaining_balance,json=remainingBalance,proto3" json:"remaining_balance,omitempty"`
-	Status           string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt        string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId          string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	UserId                 string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CurrencyCode           string                 `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	VehicleVin             string                 `protobuf:"bytes,5,opt,name=vehicle_vin,json=vehicleVin,proto3" json:"vehicle_vin,omitempty"`
+	Amount                 *Money                 `protobuf:"bytes,19,opt,name=amount,proto3" json:"amount,omitempty"`
+	TermMonths             int32                  `protobuf:"varint,7,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	MonthlyPayment         *Money                 `protobuf:"bytes,20,opt,name=monthly_payment,json=monthlyPayment,proto3" json:"monthly_payment,omitempty"`
+	RemainingBalance       *Money                 `protobuf:"bytes,21,opt,name=remaining_balance,json=remainingBalance,proto3" json:"remaining_balance,omitempty"`
+	Status                 string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt              string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MarginRate             float64                `protobuf:"fixed64,12,opt,name=margin_rate,json=marginRate,proto3" json:"margin_rate,omitempty"`
+	RepaymentMethod        string                 `protobuf:"bytes,13,opt,name=repayment_method,json=repaymentMethod,proto3" json:"repayment_method,omitempty"`
+	DaysPastDue            int32                  `protobuf:"varint,14,opt,name=days_past_due,json=daysPastDue,proto3" json:"days_past_due,omitempty"`
+	ChargesOutstanding     *Money                 `protobuf:"bytes,22,opt,name=charges_outstanding,json=chargesOutstanding,proto3" json:"charges_outstanding,omitempty"`               // unpaid penalties and fees
+	TotalOutstanding       *Money                 `protobuf:"bytes,23,opt,name=total_outstanding,json=totalOutstanding,proto3" json:"total_outstanding,omitempty"`                     // remaining_balance + charges_outstanding
+	ContractNumber         string                 `protobuf:"bytes,17,opt,name=contract_number,json=contractNumber,proto3" json:"contract_number,omitempty"`                           // ASR Leasing contract
+	OwnershipTransferredAt string                 `protobuf:"bytes,18,opt,name=ownership_transferred_at,json=ownershipTransferredAt,proto3" json:"ownership_transferred_at,omitempty"` // IJARA: when the asset passed to the customer
+	// Whole currency units, as sent before amounts became Money. Kept for
+	// clients built against that version; responses fill both.
+	//
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyAmount int64 `protobuf:"varint,6,opt,name=legacy_amount,json=legacyAmount,proto3" json:"legacy_amount,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyMonthlyPayment int64 `protobuf:"varint,8,opt,name=legacy_monthly_payment,json=legacyMonthlyPayment,proto3" json:"legacy_monthly_payment,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyRemainingBalance int64 `protobuf:"varint,9,opt,name=legacy_remaining_balance,json=legacyRemainingBalance,proto3" json:"legacy_remaining_balance,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyChargesOutstanding int64 `protobuf:"varint,15,opt,name=legacy_charges_outstanding,json=legacyChargesOutstanding,proto3" json:"legacy_charges_outstanding,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyTotalOutstanding int64 `protobuf:"varint,16,opt,name=legacy_total_outstanding,json=legacyTotalOutstanding,proto3" json:"legacy_total_outstanding,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *Loan) Reset() {
 	*x = Loan{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[3]
+	mi := &file_loan_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -353,7 +615,7 @@ func (x *Loan) String() string {
 func (*Loan) ProtoMessage() {}
 
 func (x *Loan) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[3]
+	mi := &file_loan_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -366,7 +628,7 @@ func (x *Loan) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Loan.ProtoReflect.Descriptor instead.
 func (*Loan) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{3}
+	return file_loan_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Loan) GetId() string {
@@ -404,11 +666,11 @@ func (x *Loan) GetVehicleVin() string {
 	return ""
 }
 
-func (x *Loan) GetAmount() int64 {
+func (x *Loan) GetAmount() *Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
 func (x *Loan) GetTermMonths() int32 {
@@ -418,18 +680,18 @@ func (x *Loan) GetTermMonths() int32 {
 	return 0
 }
 
-func (x *Loan) GetMonthlyPayment() int64 {
+func (x *Loan) GetMonthlyPayment() *Money {
 	if x != nil {
 		return x.MonthlyPayment
 	}
-	return 0
+	return nil
 }
 
-func (x *Loan) GetRemainingBalance() int64 {
+func (x *Loan) GetRemainingBalance() *Money {
 	if x != nil {
 		return x.RemainingBalance
 	}
-	return 0
+	return nil
 }
 
 func (x *Loan) GetStatus() string {
@@ -446,83 +708,121 @@ func (x *Loan) GetCreatedAt() string {
 	return ""
 }
 
-type PageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Loan) GetMarginRate() float64 {
+	if x != nil {
+		return x.MarginRate
+	}
+	return 0
 }
 
-func (x *PageRequest) Reset() {
-	*x = PageRequest{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Loan) GetRepaymentMethod() string {
+	if x != nil {
+		return x.RepaymentMethod
+	}
+	return ""
 }
 
-func (x *PageRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Loan) GetDaysPastDue() int32 {
+	if x != nil {
+		return x.DaysPastDue
+	}
+	return 0
 }
 
-func (*PageRequest) ProtoMessage() {}
+func (x *Loan) GetChargesOutstanding() *Money {
+	if x != nil {
+		return x.ChargesOutstanding
+	}
+	return nil
+}
 
-func (x *PageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[4]
+func (x *Loan) GetTotalOutstanding() *Money {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TotalOutstanding
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
-func (*PageRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{4}
+func (x *Loan) GetContractNumber() string {
+	if x != nil {
+		return x.ContractNumber
+	}
+	return ""
 }
 
-func (x *PageRequest) GetPage() int32 {
+func (x *Loan) GetOwnershipTransferredAt() string {
 	if x != nil {
-		return x.Page
+		return x.OwnershipTransferredAt
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *Loan) GetLegacyAmount() int64 {
+	if x != nil {
+		return x.LegacyAmount
 	}
 	return 0
 }
 
-func (x *PageRequest) GetLimit() int32 {
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *Loan) GetLegacyMonthlyPayment() int64 {
 	if x != nil {
-		return x.Limit
+		return x.LegacyMonthlyPayment
 	}
 	return 0
 }
 
-type PageResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CurrentPage   int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	TotalItems    int32                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *Loan) GetLegacyRemainingBalance() int64 {
+	if x != nil {
+		return x.LegacyRemainingBalance
+	}
+	return 0
 }
 
-func (x *PageResponse) Reset() {
-	*x = PageResponse{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[5]
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *Loan) GetLegacyChargesOutstanding() int64 {
+	if x != nil {
+		return x.LegacyChargesOutstanding
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *Loan) GetLegacyTotalOutstanding() int64 {
+	if x != nil {
+		return x.LegacyTotalOutstanding
+	}
+	return 0
+}
+
+// LeasingContract is a loan's contract as ASR Leasing holds it.
+type LeasingContract struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ContractNumber   string                 `protobuf:"bytes,1,opt,name=contract_number,json=contractNumber,proto3" json:"contract_number,omitempty"`
+	Status           string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	RemainingBalance *Money                 `protobuf:"bytes,3,opt,name=remaining_balance,json=remainingBalance,proto3" json:"remaining_balance,omitempty"`
+	UpdatedAt        string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LeasingContract) Reset() {
+	*x = LeasingContract{}
+	mi := &file_loan_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PageResponse) String() string {
+func (x *LeasingContract) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PageResponse) ProtoMessage() {}
+func (*LeasingContract) ProtoMessage() {}
 
-func (x *PageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[5]
+func (x *LeasingContract) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,72 +833,4123 @@ func (x *PageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PageResponse.ProtoReflect.Descriptor instead.
-func (*PageResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use LeasingContract.ProtoReflect.Descriptor instead.
+func (*LeasingContract) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LeasingContract) GetContractNumber() string {
+	if x != nil {
+		return x.ContractNumber
+	}
+	return ""
+}
+
+func (x *LeasingContract) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LeasingContract) GetRemainingBalance() *Money {
+	if x != nil {
+		return x.RemainingBalance
+	}
+	return nil
+}
+
+func (x *LeasingContract) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type Payment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LoanId        string                 `protobuf:"bytes,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	CurrencyCode  string                 `protobuf:"bytes,3,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	PaymentDate   string                 `protobuf:"bytes,4,opt,name=payment_date,json=paymentDate,proto3" json:"payment_date,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,10,opt,name=amount,proto3" json:"amount,omitempty"`
+	Method        string                 `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	TransactionId string                 `protobuf:"bytes,8,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Whole currency units, as sent before amounts became Money. Kept for
+	// clients built against that version; responses fill both.
+	//
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyAmount  int64 `protobuf:"varint,5,opt,name=legacy_amount,json=legacyAmount,proto3" json:"legacy_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_loan_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Payment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payment) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *Payment) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Payment) GetPaymentDate() string {
+	if x != nil {
+		return x.PaymentDate
+	}
+	return ""
+}
+
+func (x *Payment) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Payment) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Payment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Payment) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Payment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *Payment) GetLegacyAmount() int64 {
+	if x != nil {
+		return x.LegacyAmount
+	}
+	return 0
+}
+
+type RepaymentInstallment struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Number             int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	DueDate            string                 `protobuf:"bytes,2,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Payment            *Money                 `protobuf:"bytes,8,opt,name=payment,proto3" json:"payment,omitempty"`
+	Principal          *Money                 `protobuf:"bytes,9,opt,name=principal,proto3" json:"principal,omitempty"`
+	Margin             *Money                 `protobuf:"bytes,10,opt,name=margin,proto3" json:"margin,omitempty"`
+	OutstandingBalance *Money                 `protobuf:"bytes,11,opt,name=outstanding_balance,json=outstandingBalance,proto3" json:"outstanding_balance,omitempty"` // principal left after this installment
+	OwnershipTransfer  bool                   `protobuf:"varint,7,opt,name=ownership_transfer,json=ownershipTransfer,proto3" json:"ownership_transfer,omitempty"`    // IJARA: the asset passes to the customer with this rental
+	// Whole currency units, as sent before amounts became Money. Kept for
+	// clients built against that version; responses fill both.
+	//
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyPayment int64 `protobuf:"varint,3,opt,name=legacy_payment,json=legacyPayment,proto3" json:"legacy_payment,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyPrincipal int64 `protobuf:"varint,4,opt,name=legacy_principal,json=legacyPrincipal,proto3" json:"legacy_principal,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyMargin int64 `protobuf:"varint,5,opt,name=legacy_margin,json=legacyMargin,proto3" json:"legacy_margin,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyOutstandingBalance int64 `protobuf:"varint,6,opt,name=legacy_outstanding_balance,json=legacyOutstandingBalance,proto3" json:"legacy_outstanding_balance,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *RepaymentInstallment) Reset() {
+	*x = RepaymentInstallment{}
+	mi := &file_loan_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepaymentInstallment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepaymentInstallment) ProtoMessage() {}
+
+func (x *RepaymentInstallment) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepaymentInstallment.ProtoReflect.Descriptor instead.
+func (*RepaymentInstallment) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RepaymentInstallment) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *RepaymentInstallment) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *RepaymentInstallment) GetPayment() *Money {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *RepaymentInstallment) GetPrincipal() *Money {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *RepaymentInstallment) GetMargin() *Money {
+	if x != nil {
+		return x.Margin
+	}
+	return nil
+}
+
+func (x *RepaymentInstallment) GetOutstandingBalance() *Money {
+	if x != nil {
+		return x.OutstandingBalance
+	}
+	return nil
+}
+
+func (x *RepaymentInstallment) GetOwnershipTransfer() bool {
+	if x != nil {
+		return x.OwnershipTransfer
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *RepaymentInstallment) GetLegacyPayment() int64 {
+	if x != nil {
+		return x.LegacyPayment
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *RepaymentInstallment) GetLegacyPrincipal() int64 {
+	if x != nil {
+		return x.LegacyPrincipal
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *RepaymentInstallment) GetLegacyMargin() int64 {
+	if x != nil {
+		return x.LegacyMargin
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *RepaymentInstallment) GetLegacyOutstandingBalance() int64 {
+	if x != nil {
+		return x.LegacyOutstandingBalance
+	}
+	return 0
+}
+
+type Installment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LoanId        string                 `protobuf:"bytes,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Number        int32                  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	DueDate       string                 `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	PrincipalDue  *Money                 `protobuf:"bytes,11,opt,name=principal_due,json=principalDue,proto3" json:"principal_due,omitempty"`
+	MarginDue     *Money                 `protobuf:"bytes,12,opt,name=margin_due,json=marginDue,proto3" json:"margin_due,omitempty"`
+	PrincipalPaid *Money                 `protobuf:"bytes,13,opt,name=principal_paid,json=principalPaid,proto3" json:"principal_paid,omitempty"`
+	MarginPaid    *Money                 `protobuf:"bytes,14,opt,name=margin_paid,json=marginPaid,proto3" json:"margin_paid,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"` // PENDING, PARTIAL, PAID, OVERDUE
+	PaidAt        string                 `protobuf:"bytes,10,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	// Whole currency units, as sent before amounts became Money. Kept for
+	// clients built against that version; responses fill both.
+	//
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyPrincipalDue int64 `protobuf:"varint,5,opt,name=legacy_principal_due,json=legacyPrincipalDue,proto3" json:"legacy_principal_due,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyMarginDue int64 `protobuf:"varint,6,opt,name=legacy_margin_due,json=legacyMarginDue,proto3" json:"legacy_margin_due,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyPrincipalPaid int64 `protobuf:"varint,7,opt,name=legacy_principal_paid,json=legacyPrincipalPaid,proto3" json:"legacy_principal_paid,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyMarginPaid int64 `protobuf:"varint,8,opt,name=legacy_margin_paid,json=legacyMarginPaid,proto3" json:"legacy_margin_paid,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Installment) Reset() {
+	*x = Installment{}
+	mi := &file_loan_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Installment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Installment) ProtoMessage() {}
+
+func (x *Installment) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Installment.ProtoReflect.Descriptor instead.
+func (*Installment) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Installment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Installment) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *Installment) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Installment) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *Installment) GetPrincipalDue() *Money {
+	if x != nil {
+		return x.PrincipalDue
+	}
+	return nil
+}
+
+func (x *Installment) GetMarginDue() *Money {
+	if x != nil {
+		return x.MarginDue
+	}
+	return nil
+}
+
+func (x *Installment) GetPrincipalPaid() *Money {
+	if x != nil {
+		return x.PrincipalPaid
+	}
+	return nil
+}
+
+func (x *Installment) GetMarginPaid() *Money {
+	if x != nil {
+		return x.MarginPaid
+	}
+	return nil
+}
+
+func (x *Installment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Installment) GetPaidAt() string {
+	if x != nil {
+		return x.PaidAt
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *Installment) GetLegacyPrincipalDue() int64 {
+	if x != nil {
+		return x.LegacyPrincipalDue
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *Installment) GetLegacyMarginDue() int64 {
+	if x != nil {
+		return x.LegacyMarginDue
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *Installment) GetLegacyPrincipalPaid() int64 {
+	if x != nil {
+		return x.LegacyPrincipalPaid
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *Installment) GetLegacyMarginPaid() int64 {
+	if x != nil {
+		return x.LegacyMarginPaid
+	}
+	return 0
+}
+
+type PayoffQuote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LoanId        string                 `protobuf:"bytes,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Principal     *Money                 `protobuf:"bytes,12,opt,name=principal,proto3" json:"principal,omitempty"`
+	Margin        *Money                 `protobuf:"bytes,13,opt,name=margin,proto3" json:"margin,omitempty"`                                 // margin earned up to valid_until
+	MarginWaived  *Money                 `protobuf:"bytes,14,opt,name=margin_waived,json=marginWaived,proto3" json:"margin_waived,omitempty"` // margin of later periods that is not charged
+	Charges       *Money                 `protobuf:"bytes,15,opt,name=charges,proto3" json:"charges,omitempty"`
+	SettlementFee *Money                 `protobuf:"bytes,16,opt,name=settlement_fee,json=settlementFee,proto3" json:"settlement_fee,omitempty"`
+	Total         *Money                 `protobuf:"bytes,17,opt,name=total,proto3" json:"total,omitempty"`
+	ValidUntil    string                 `protobuf:"bytes,9,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	Status        string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"` // ISSUED, SETTLED
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Whole currency units, as sent before amounts became Money. Kept for
+	// clients built against that version; responses fill both.
+	//
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyPrincipal int64 `protobuf:"varint,3,opt,name=legacy_principal,json=legacyPrincipal,proto3" json:"legacy_principal,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyMargin int64 `protobuf:"varint,4,opt,name=legacy_margin,json=legacyMargin,proto3" json:"legacy_margin,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyMarginWaived int64 `protobuf:"varint,5,opt,name=legacy_margin_waived,json=legacyMarginWaived,proto3" json:"legacy_margin_waived,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyCharges int64 `protobuf:"varint,6,opt,name=legacy_charges,json=legacyCharges,proto3" json:"legacy_charges,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacySettlementFee int64 `protobuf:"varint,7,opt,name=legacy_settlement_fee,json=legacySettlementFee,proto3" json:"legacy_settlement_fee,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyTotal   int64 `protobuf:"varint,8,opt,name=legacy_total,json=legacyTotal,proto3" json:"legacy_total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayoffQuote) Reset() {
+	*x = PayoffQuote{}
+	mi := &file_loan_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoffQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoffQuote) ProtoMessage() {}
+
+func (x *PayoffQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoffQuote.ProtoReflect.Descriptor instead.
+func (*PayoffQuote) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PayoffQuote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PayoffQuote) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *PayoffQuote) GetPrincipal() *Money {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *PayoffQuote) GetMargin() *Money {
+	if x != nil {
+		return x.Margin
+	}
+	return nil
+}
+
+func (x *PayoffQuote) GetMarginWaived() *Money {
+	if x != nil {
+		return x.MarginWaived
+	}
+	return nil
+}
+
+func (x *PayoffQuote) GetCharges() *Money {
+	if x != nil {
+		return x.Charges
+	}
+	return nil
+}
+
+func (x *PayoffQuote) GetSettlementFee() *Money {
+	if x != nil {
+		return x.SettlementFee
+	}
+	return nil
+}
+
+func (x *PayoffQuote) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *PayoffQuote) GetValidUntil() string {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return ""
+}
+
+func (x *PayoffQuote) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PayoffQuote) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *PayoffQuote) GetLegacyPrincipal() int64 {
+	if x != nil {
+		return x.LegacyPrincipal
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *PayoffQuote) GetLegacyMargin() int64 {
+	if x != nil {
+		return x.LegacyMargin
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *PayoffQuote) GetLegacyMarginWaived() int64 {
+	if x != nil {
+		return x.LegacyMarginWaived
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *PayoffQuote) GetLegacyCharges() int64 {
+	if x != nil {
+		return x.LegacyCharges
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *PayoffQuote) GetLegacySettlementFee() int64 {
+	if x != nil {
+		return x.LegacySettlementFee
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *PayoffQuote) GetLegacyTotal() int64 {
+	if x != nil {
+		return x.LegacyTotal
+	}
+	return 0
+}
+
+type PrepaymentQuote struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	LoanId           string                  `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Amount           *Money                  `protobuf:"bytes,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	Mode             string                  `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	TermMonths       int32                   `protobuf:"varint,4,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	MonthlyPayment   *Money                  `protobuf:"bytes,10,opt,name=monthly_payment,json=monthlyPayment,proto3" json:"monthly_payment,omitempty"`
+	RemainingBalance *Money                  `protobuf:"bytes,11,opt,name=remaining_balance,json=remainingBalance,proto3" json:"remaining_balance,omitempty"`
+	MarginSaved      *Money                  `protobuf:"bytes,12,opt,name=margin_saved,json=marginSaved,proto3" json:"margin_saved,omitempty"`
+	Schedule         []*RepaymentInstallment `protobuf:"bytes,8,rep,name=schedule,proto3" json:"schedule,omitempty"` // recalculated pending installments
+	// Whole currency units, as sent before amounts became Money. Kept for
+	// clients built against that version; responses fill both.
+	//
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyAmount int64 `protobuf:"varint,2,opt,name=legacy_amount,json=legacyAmount,proto3" json:"legacy_amount,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyMonthlyPayment int64 `protobuf:"varint,5,opt,name=legacy_monthly_payment,json=legacyMonthlyPayment,proto3" json:"legacy_monthly_payment,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyRemainingBalance int64 `protobuf:"varint,6,opt,name=legacy_remaining_balance,json=legacyRemainingBalance,proto3" json:"legacy_remaining_balance,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyMarginSaved int64 `protobuf:"varint,7,opt,name=legacy_margin_saved,json=legacyMarginSaved,proto3" json:"legacy_margin_saved,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PrepaymentQuote) Reset() {
+	*x = PrepaymentQuote{}
+	mi := &file_loan_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepaymentQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepaymentQuote) ProtoMessage() {}
+
+func (x *PrepaymentQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepaymentQuote.ProtoReflect.Descriptor instead.
+func (*PrepaymentQuote) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PrepaymentQuote) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *PrepaymentQuote) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PrepaymentQuote) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *PrepaymentQuote) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *PrepaymentQuote) GetMonthlyPayment() *Money {
+	if x != nil {
+		return x.MonthlyPayment
+	}
+	return nil
+}
+
+func (x *PrepaymentQuote) GetRemainingBalance() *Money {
+	if x != nil {
+		return x.RemainingBalance
+	}
+	return nil
+}
+
+func (x *PrepaymentQuote) GetMarginSaved() *Money {
+	if x != nil {
+		return x.MarginSaved
+	}
+	return nil
+}
+
+func (x *PrepaymentQuote) GetSchedule() []*RepaymentInstallment {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *PrepaymentQuote) GetLegacyAmount() int64 {
+	if x != nil {
+		return x.LegacyAmount
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *PrepaymentQuote) GetLegacyMonthlyPayment() int64 {
+	if x != nil {
+		return x.LegacyMonthlyPayment
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *PrepaymentQuote) GetLegacyRemainingBalance() int64 {
+	if x != nil {
+		return x.LegacyRemainingBalance
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *PrepaymentQuote) GetLegacyMarginSaved() int64 {
+	if x != nil {
+		return x.LegacyMarginSaved
+	}
+	return 0
+}
+
+// ReconciliationRun is one pass comparing loans with ASR Leasing.
+type ReconciliationRun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    string                 `protobuf:"bytes,3,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"` // empty while the run is in progress
+	LoansChecked  int32                  `protobuf:"varint,4,opt,name=loans_checked,json=loansChecked,proto3" json:"loans_checked,omitempty"`
+	IssuesFound   int32                  `protobuf:"varint,5,opt,name=issues_found,json=issuesFound,proto3" json:"issues_found,omitempty"`
+	Corrected     int32                  `protobuf:"varint,6,opt,name=corrected,proto3" json:"corrected,omitempty"`
+	Failed        int32                  `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"` // loans ASR Leasing could not be asked about
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`    // why the run stopped early
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconciliationRun) Reset() {
+	*x = ReconciliationRun{}
+	mi := &file_loan_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationRun) ProtoMessage() {}
+
+func (x *ReconciliationRun) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationRun.ProtoReflect.Descriptor instead.
+func (*ReconciliationRun) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReconciliationRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReconciliationRun) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *ReconciliationRun) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *ReconciliationRun) GetLoansChecked() int32 {
+	if x != nil {
+		return x.LoansChecked
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetIssuesFound() int32 {
+	if x != nil {
+		return x.IssuesFound
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetCorrected() int32 {
+	if x != nil {
+		return x.Corrected
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ReconciliationRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReconciliationIssue struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LoanId         string                 `protobuf:"bytes,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	ContractNumber string                 `protobuf:"bytes,3,opt,name=contract_number,json=contractNumber,proto3" json:"contract_number,omitempty"`
+	Kind           string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`     // BALANCE_MISMATCH, STATUS_MISMATCH, CONTRACT_NOT_FOUND
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // OPEN, CORRECTED, RESOLVED
+	LocalBalance   *Money                 `protobuf:"bytes,6,opt,name=local_balance,json=localBalance,proto3" json:"local_balance,omitempty"`
+	RemoteBalance  *Money                 `protobuf:"bytes,7,opt,name=remote_balance,json=remoteBalance,proto3" json:"remote_balance,omitempty"` // as ASR Leasing holds it
+	LocalStatus    string                 `protobuf:"bytes,8,opt,name=local_status,json=localStatus,proto3" json:"local_status,omitempty"`
+	RemoteStatus   string                 `protobuf:"bytes,9,opt,name=remote_status,json=remoteStatus,proto3" json:"remote_status,omitempty"`
+	DetectedAt     string                 `protobuf:"bytes,10,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	ResolvedAt     string                 `protobuf:"bytes,11,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReconciliationIssue) Reset() {
+	*x = ReconciliationIssue{}
+	mi := &file_loan_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationIssue) ProtoMessage() {}
+
+func (x *ReconciliationIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationIssue.ProtoReflect.Descriptor instead.
+func (*ReconciliationIssue) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReconciliationIssue) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReconciliationIssue) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *ReconciliationIssue) GetContractNumber() string {
+	if x != nil {
+		return x.ContractNumber
+	}
+	return ""
+}
+
+func (x *ReconciliationIssue) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReconciliationIssue) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReconciliationIssue) GetLocalBalance() *Money {
+	if x != nil {
+		return x.LocalBalance
+	}
+	return nil
+}
+
+func (x *ReconciliationIssue) GetRemoteBalance() *Money {
+	if x != nil {
+		return x.RemoteBalance
+	}
+	return nil
+}
+
+func (x *ReconciliationIssue) GetLocalStatus() string {
+	if x != nil {
+		return x.LocalStatus
+	}
+	return ""
+}
+
+func (x *ReconciliationIssue) GetRemoteStatus() string {
+	if x != nil {
+		return x.RemoteStatus
+	}
+	return ""
+}
+
+func (x *ReconciliationIssue) GetDetectedAt() string {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return ""
+}
+
+func (x *ReconciliationIssue) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+type ReconciliationIssueCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconciliationIssueCount) Reset() {
+	*x = ReconciliationIssueCount{}
+	mi := &file_loan_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationIssueCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationIssueCount) ProtoMessage() {}
+
+func (x *ReconciliationIssueCount) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationIssueCount.ProtoReflect.Descriptor instead.
+func (*ReconciliationIssueCount) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReconciliationIssueCount) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReconciliationIssueCount) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReconciliationIssueCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ProductRate is a cell of a product's rate grid: the margin rate of terms up
+// to max_term_months with at least min_down_payment_percent down. A term gets
+// the cell with the shortest term covering it and, of those, the largest down
+// payment the application reaches.
+type ProductRate struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	MaxTermMonths         int32                  `protobuf:"varint,1,opt,name=max_term_months,json=maxTermMonths,proto3" json:"max_term_months,omitempty"`
+	MinDownPaymentPercent float64                `protobuf:"fixed64,2,opt,name=min_down_payment_percent,json=minDownPaymentPercent,proto3" json:"min_down_payment_percent,omitempty"`
+	MarginRate            float64                `protobuf:"fixed64,3,opt,name=margin_rate,json=marginRate,proto3" json:"margin_rate,omitempty"` // annual, percent
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ProductRate) Reset() {
+	*x = ProductRate{}
+	mi := &file_loan_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductRate) ProtoMessage() {}
+
+func (x *ProductRate) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductRate.ProtoReflect.Descriptor instead.
+func (*ProductRate) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProductRate) GetMaxTermMonths() int32 {
+	if x != nil {
+		return x.MaxTermMonths
+	}
+	return 0
+}
+
+func (x *ProductRate) GetMinDownPaymentPercent() float64 {
+	if x != nil {
+		return x.MinDownPaymentPercent
+	}
+	return 0
+}
+
+func (x *ProductRate) GetMarginRate() float64 {
+	if x != nil {
+		return x.MarginRate
+	}
+	return 0
+}
+
+type LoanProduct struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code                  string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // e.g. "AUTO_TJS", unique
+	Name                  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ApplicationType       string                 `protobuf:"bytes,4,opt,name=application_type,json=applicationType,proto3" json:"application_type,omitempty"` // AUTO, PERSONAL
+	CurrencyCode          string                 `protobuf:"bytes,5,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	RepaymentMethod       string                 `protobuf:"bytes,6,opt,name=repayment_method,json=repaymentMethod,proto3" json:"repayment_method,omitempty"` // FLAT (default), ANNUITY, DIFFERENTIATED, MURABAHA, IJARA
+	TermMonths            []int32                `protobuf:"varint,7,rep,packed,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`        // terms offered
+	MinAmount             *Money                 `protobuf:"bytes,8,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`                   // of the net price financed
+	MaxAmount             *Money                 `protobuf:"bytes,9,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	MinDownPaymentPercent float64                `protobuf:"fixed64,10,opt,name=min_down_payment_percent,json=minDownPaymentPercent,proto3" json:"min_down_payment_percent,omitempty"` // of the price
+	Rates                 []*ProductRate         `protobuf:"bytes,11,rep,name=rates,proto3" json:"rates,omitempty"`
+	Active                bool                   `protobuf:"varint,12,opt,name=active,proto3" json:"active,omitempty"` // inactive products finance nothing new
+	CreatedAt             string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             string                 `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *LoanProduct) Reset() {
+	*x = LoanProduct{}
+	mi := &file_loan_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanProduct) ProtoMessage() {}
+
+func (x *LoanProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanProduct.ProtoReflect.Descriptor instead.
+func (*LoanProduct) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LoanProduct) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetApplicationType() string {
+	if x != nil {
+		return x.ApplicationType
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetRepaymentMethod() string {
+	if x != nil {
+		return x.RepaymentMethod
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetTermMonths() []int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetMinAmount() *Money {
+	if x != nil {
+		return x.MinAmount
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetMaxAmount() *Money {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetMinDownPaymentPercent() float64 {
+	if x != nil {
+		return x.MinDownPaymentPercent
+	}
+	return 0
+}
+
+func (x *LoanProduct) GetRates() []*ProductRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *LoanProduct) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type PageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageRequest) Reset() {
+	*x = PageRequest{}
+	mi := &file_loan_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageRequest) ProtoMessage() {}
+
+func (x *PageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
+func (*PageRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PageRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *PageRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPage   int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	TotalItems    int32                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageResponse) Reset() {
+	*x = PageResponse{}
+	mi := &file_loan_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageResponse) ProtoMessage() {}
+
+func (x *PageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageResponse.ProtoReflect.Descriptor instead.
+func (*PageResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PageResponse) GetCurrentPage() int32 {
 	if x != nil {
-		return x.CurrentPage
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *PageResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PageResponse) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *PageResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// Application
+type CreateApplicationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type            string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // AUTO, PERSONAL; the product's type by default
+	VehicleVin      string                 `protobuf:"bytes,3,opt,name=vehicle_vin,json=vehicleVin,proto3" json:"vehicle_vin,omitempty"`
+	VehicleName     string                 `protobuf:"bytes,4,opt,name=vehicle_name,json=vehicleName,proto3" json:"vehicle_name,omitempty"`
+	CurrencyCode    string                 `protobuf:"bytes,5,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"` // currency of the loan
+	Price           *Money                 `protobuf:"bytes,14,opt,name=price,proto3" json:"price,omitempty"`                                  // may be in another currency, converted at the current rate
+	DownPayment     *Money                 `protobuf:"bytes,15,opt,name=down_payment,json=downPayment,proto3" json:"down_payment,omitempty"`
+	TermMonths      int32                  `protobuf:"varint,8,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	MarginRate      float64                `protobuf:"fixed64,9,opt,name=margin_rate,json=marginRate,proto3" json:"margin_rate,omitempty"` // from the product's rate grid by default
+	NetPrice        *Money                 `protobuf:"bytes,16,opt,name=net_price,json=netPrice,proto3" json:"net_price,omitempty"`
+	MonthlyPayment  *Money                 `protobuf:"bytes,17,opt,name=monthly_payment,json=monthlyPayment,proto3" json:"monthly_payment,omitempty"`
+	RepaymentMethod string                 `protobuf:"bytes,12,opt,name=repayment_method,json=repaymentMethod,proto3" json:"repayment_method,omitempty"` // the product's method by default
+	ProductId       string                 `protobuf:"bytes,13,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`                   // <type>_<currency_code> product by default
+	// Whole currency units, as sent before amounts became Money. Read only when
+	// the Money field of the same name is not set.
+	//
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyPrice int64 `protobuf:"varint,6,opt,name=legacy_price,json=legacyPrice,proto3" json:"legacy_price,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyDownPayment int64 `protobuf:"varint,7,opt,name=legacy_down_payment,json=legacyDownPayment,proto3" json:"legacy_down_payment,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyNetPrice int64 `protobuf:"varint,10,opt,name=legacy_net_price,json=legacyNetPrice,proto3" json:"legacy_net_price,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyMonthlyPayment int64 `protobuf:"varint,11,opt,name=legacy_monthly_payment,json=legacyMonthlyPayment,proto3" json:"legacy_monthly_payment,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateApplicationRequest) Reset() {
+	*x = CreateApplicationRequest{}
+	mi := &file_loan_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationRequest) ProtoMessage() {}
+
+func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationRequest.ProtoReflect.Descriptor instead.
+func (*CreateApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateApplicationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetVehicleVin() string {
+	if x != nil {
+		return x.VehicleVin
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetVehicleName() string {
+	if x != nil {
+		return x.VehicleName
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CreateApplicationRequest) GetDownPayment() *Money {
+	if x != nil {
+		return x.DownPayment
+	}
+	return nil
+}
+
+func (x *CreateApplicationRequest) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *CreateApplicationRequest) GetMarginRate() float64 {
+	if x != nil {
+		return x.MarginRate
+	}
+	return 0
+}
+
+func (x *CreateApplicationRequest) GetNetPrice() *Money {
+	if x != nil {
+		return x.NetPrice
+	}
+	return nil
+}
+
+func (x *CreateApplicationRequest) GetMonthlyPayment() *Money {
+	if x != nil {
+		return x.MonthlyPayment
+	}
+	return nil
+}
+
+func (x *CreateApplicationRequest) GetRepaymentMethod() string {
+	if x != nil {
+		return x.RepaymentMethod
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *CreateApplicationRequest) GetLegacyPrice() int64 {
+	if x != nil {
+		return x.LegacyPrice
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *CreateApplicationRequest) GetLegacyDownPayment() int64 {
+	if x != nil {
+		return x.LegacyDownPayment
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *CreateApplicationRequest) GetLegacyNetPrice() int64 {
+	if x != nil {
+		return x.LegacyNetPrice
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *CreateApplicationRequest) GetLegacyMonthlyPayment() int64 {
+	if x != nil {
+		return x.LegacyMonthlyPayment
+	}
+	return 0
+}
+
+type CreateApplicationResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Application      *LoanApplication       `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateApplicationResponse) Reset() {
+	*x = CreateApplicationResponse{}
+	mi := &file_loan_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationResponse) ProtoMessage() {}
+
+func (x *CreateApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationResponse.ProtoReflect.Descriptor instead.
+func (*CreateApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateApplicationResponse) GetApplication() *LoanApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *CreateApplicationResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+type GetApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationRequest) Reset() {
+	*x = GetApplicationRequest{}
+	mi := &file_loan_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationRequest) ProtoMessage() {}
+
+func (x *GetApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetApplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetApplicationResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Application      *LoanApplication       `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetApplicationResponse) Reset() {
+	*x = GetApplicationResponse{}
+	mi := &file_loan_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationResponse) ProtoMessage() {}
+
+func (x *GetApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetApplicationResponse) GetApplication() *LoanApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *GetApplicationResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+type ListApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          *PageRequest           `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApplicationsRequest) Reset() {
+	*x = ListApplicationsRequest{}
+	mi := &file_loan_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationsRequest) ProtoMessage() {}
+
+func (x *ListApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListApplicationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListApplicationsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListApplicationsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Applications     []*LoanApplication     `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	Page             *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListApplicationsResponse) Reset() {
+	*x = ListApplicationsResponse{}
+	mi := &file_loan_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationsResponse) ProtoMessage() {}
+
+func (x *ListApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListApplicationsResponse) GetApplications() []*LoanApplication {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+func (x *ListApplicationsResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListApplicationsResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+// Application status
+type ReviewApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewApplicationRequest) Reset() {
+	*x = ReviewApplicationRequest{}
+	mi := &file_loan_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewApplicationRequest) ProtoMessage() {}
+
+func (x *ReviewApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewApplicationRequest.ProtoReflect.Descriptor instead.
+func (*ReviewApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReviewApplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewApplicationRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ReviewApplicationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReviewApplicationResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Application      *LoanApplication       `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReviewApplicationResponse) Reset() {
+	*x = ReviewApplicationResponse{}
+	mi := &file_loan_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewApplicationResponse) ProtoMessage() {}
+
+func (x *ReviewApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewApplicationResponse.ProtoReflect.Descriptor instead.
+func (*ReviewApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReviewApplicationResponse) GetApplication() *LoanApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *ReviewApplicationResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+type ApproveApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveApplicationRequest) Reset() {
+	*x = ApproveApplicationRequest{}
+	mi := &file_loan_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveApplicationRequest) ProtoMessage() {}
+
+func (x *ApproveApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveApplicationRequest.ProtoReflect.Descriptor instead.
+func (*ApproveApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ApproveApplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApproveApplicationRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ApproveApplicationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ApproveApplicationResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Application      *LoanApplication       `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ApproveApplicationResponse) Reset() {
+	*x = ApproveApplicationResponse{}
+	mi := &file_loan_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveApplicationResponse) ProtoMessage() {}
+
+func (x *ApproveApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveApplicationResponse.ProtoReflect.Descriptor instead.
+func (*ApproveApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ApproveApplicationResponse) GetApplication() *LoanApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *ApproveApplicationResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+type RejectApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectApplicationRequest) Reset() {
+	*x = RejectApplicationRequest{}
+	mi := &file_loan_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectApplicationRequest) ProtoMessage() {}
+
+func (x *RejectApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectApplicationRequest.ProtoReflect.Descriptor instead.
+func (*RejectApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RejectApplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RejectApplicationRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *RejectApplicationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RejectApplicationResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Application      *LoanApplication       `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RejectApplicationResponse) Reset() {
+	*x = RejectApplicationResponse{}
+	mi := &file_loan_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectApplicationResponse) ProtoMessage() {}
+
+func (x *RejectApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectApplicationResponse.ProtoReflect.Descriptor instead.
+func (*RejectApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RejectApplicationResponse) GetApplication() *LoanApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *RejectApplicationResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+// Vehicles
+type ListVehiclesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EngineType    string                 `protobuf:"bytes,1,opt,name=engine_type,json=engineType,proto3" json:"engine_type,omitempty"`
+	CurrencyCode  string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"` // only vehicles priced in this currency
+	MinPrice      *Money                 `protobuf:"bytes,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`             // vehicles priced in another currency are compared at the current rate
+	MaxPrice      *Money                 `protobuf:"bytes,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	Search        string                 `protobuf:"bytes,5,opt,name=search,proto3" json:"search,omitempty"` // part of the name, case-insensitive
+	Sort          string                 `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`     // NAME (default), PRICE_ASC, PRICE_DESC
+	Page          *PageRequest           `protobuf:"bytes,7,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVehiclesRequest) Reset() {
+	*x = ListVehiclesRequest{}
+	mi := &file_loan_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVehiclesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVehiclesRequest) ProtoMessage() {}
+
+func (x *ListVehiclesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVehiclesRequest.ProtoReflect.Descriptor instead.
+func (*ListVehiclesRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListVehiclesRequest) GetEngineType() string {
+	if x != nil {
+		return x.EngineType
+	}
+	return ""
+}
+
+func (x *ListVehiclesRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *ListVehiclesRequest) GetMinPrice() *Money {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *ListVehiclesRequest) GetMaxPrice() *Money {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *ListVehiclesRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListVehiclesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListVehiclesRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListVehiclesResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Vehicles         []*Vehicle             `protobuf:"bytes,1,rep,name=vehicles,proto3" json:"vehicles,omitempty"`
+	Page             *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListVehiclesResponse) Reset() {
+	*x = ListVehiclesResponse{}
+	mi := &file_loan_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVehiclesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVehiclesResponse) ProtoMessage() {}
+
+func (x *ListVehiclesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVehiclesResponse.ProtoReflect.Descriptor instead.
+func (*ListVehiclesResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListVehiclesResponse) GetVehicles() []*Vehicle {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+func (x *ListVehiclesResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListVehiclesResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+type GetVehicleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVehicleRequest) Reset() {
+	*x = GetVehicleRequest{}
+	mi := &file_loan_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVehicleRequest) ProtoMessage() {}
+
+func (x *GetVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVehicleRequest.ProtoReflect.Descriptor instead.
+func (*GetVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetVehicleRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+type GetVehicleResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Vehicle          *Vehicle               `protobuf:"bytes,1,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetVehicleResponse) Reset() {
+	*x = GetVehicleResponse{}
+	mi := &file_loan_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVehicleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVehicleResponse) ProtoMessage() {}
+
+func (x *GetVehicleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVehicleResponse.ProtoReflect.Descriptor instead.
+func (*GetVehicleResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetVehicleResponse) GetVehicle() *Vehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+func (x *GetVehicleResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+// Calculator
+type CalculateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode    string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"` // currency of the loan
+	Price           *Money                 `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`                                   // may be in another currency, converted at the current rate
+	DownPayment     *Money                 `protobuf:"bytes,10,opt,name=down_payment,json=downPayment,proto3" json:"down_payment,omitempty"`
+	TermMonths      int32                  `protobuf:"varint,4,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	MarginRate      float64                `protobuf:"fixed64,5,opt,name=margin_rate,json=marginRate,proto3" json:"margin_rate,omitempty"` // from the product's rate grid by default
+	IncludeSchedule bool                   `protobuf:"varint,6,opt,name=include_schedule,json=includeSchedule,proto3" json:"include_schedule,omitempty"`
+	RepaymentMethod string                 `protobuf:"bytes,7,opt,name=repayment_method,json=repaymentMethod,proto3" json:"repayment_method,omitempty"` // the product's method by default
+	ProductId       string                 `protobuf:"bytes,8,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`                   // <type>_<currency_code> product by default
+	Type            string                 `protobuf:"bytes,11,opt,name=type,proto3" json:"type,omitempty"`                                             // AUTO (default), PERSONAL; picks the product when product_id is not set
+	// Whole currency units, as sent before amounts became Money. Read only when
+	// the Money field of the same name is not set.
+	//
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyPrice int64 `protobuf:"varint,2,opt,name=legacy_price,json=legacyPrice,proto3" json:"legacy_price,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyDownPayment int64 `protobuf:"varint,3,opt,name=legacy_down_payment,json=legacyDownPayment,proto3" json:"legacy_down_payment,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CalculateRequest) Reset() {
+	*x = CalculateRequest{}
+	mi := &file_loan_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateRequest) ProtoMessage() {}
+
+func (x *CalculateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateRequest.ProtoReflect.Descriptor instead.
+func (*CalculateRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CalculateRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *CalculateRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CalculateRequest) GetDownPayment() *Money {
+	if x != nil {
+		return x.DownPayment
+	}
+	return nil
+}
+
+func (x *CalculateRequest) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *CalculateRequest) GetMarginRate() float64 {
+	if x != nil {
+		return x.MarginRate
+	}
+	return 0
+}
+
+func (x *CalculateRequest) GetIncludeSchedule() bool {
+	if x != nil {
+		return x.IncludeSchedule
+	}
+	return false
+}
+
+func (x *CalculateRequest) GetRepaymentMethod() string {
+	if x != nil {
+		return x.RepaymentMethod
+	}
+	return ""
+}
+
+func (x *CalculateRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CalculateRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *CalculateRequest) GetLegacyPrice() int64 {
+	if x != nil {
+		return x.LegacyPrice
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *CalculateRequest) GetLegacyDownPayment() int64 {
+	if x != nil {
+		return x.LegacyDownPayment
+	}
+	return 0
+}
+
+type CalculateResponse struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	NetPrice         *Money                  `protobuf:"bytes,9,opt,name=net_price,json=netPrice,proto3" json:"net_price,omitempty"`
+	MonthlyPayment   *Money                  `protobuf:"bytes,10,opt,name=monthly_payment,json=monthlyPayment,proto3" json:"monthly_payment,omitempty"`
+	TotalAmount      *Money                  `protobuf:"bytes,11,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Schedule         []*RepaymentInstallment `protobuf:"bytes,4,rep,name=schedule,proto3" json:"schedule,omitempty"`
+	Price            *Money                  `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"` // price converted into currency_code
+	ExchangeRate     float64                 `protobuf:"fixed64,6,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	MarginRate       float64                 `protobuf:"fixed64,7,opt,name=margin_rate,json=marginRate,proto3" json:"margin_rate,omitempty"` // rate the quote is priced at
+	RepaymentMethod  string                  `protobuf:"bytes,8,opt,name=repayment_method,json=repaymentMethod,proto3" json:"repayment_method,omitempty"`
+	ProductId        string                  `protobuf:"bytes,12,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // product the quote is priced on
+	LoanServiceError *LoanServiceError       `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	// Whole currency units, as sent before amounts became Money. Kept for
+	// clients built against that version; responses fill both.
+	//
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyNetPrice int64 `protobuf:"varint,1,opt,name=legacy_net_price,json=legacyNetPrice,proto3" json:"legacy_net_price,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyMonthlyPayment int64 `protobuf:"varint,2,opt,name=legacy_monthly_payment,json=legacyMonthlyPayment,proto3" json:"legacy_monthly_payment,omitempty"`
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyTotalAmount int64 `protobuf:"varint,3,opt,name=legacy_total_amount,json=legacyTotalAmount,proto3" json:"legacy_total_amount,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CalculateResponse) Reset() {
+	*x = CalculateResponse{}
+	mi := &file_loan_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateResponse) ProtoMessage() {}
+
+func (x *CalculateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateResponse.ProtoReflect.Descriptor instead.
+func (*CalculateResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CalculateResponse) GetNetPrice() *Money {
+	if x != nil {
+		return x.NetPrice
+	}
+	return nil
+}
+
+func (x *CalculateResponse) GetMonthlyPayment() *Money {
+	if x != nil {
+		return x.MonthlyPayment
+	}
+	return nil
+}
+
+func (x *CalculateResponse) GetTotalAmount() *Money {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return nil
+}
+
+func (x *CalculateResponse) GetSchedule() []*RepaymentInstallment {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *CalculateResponse) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CalculateResponse) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+func (x *CalculateResponse) GetMarginRate() float64 {
+	if x != nil {
+		return x.MarginRate
+	}
+	return 0
+}
+
+func (x *CalculateResponse) GetRepaymentMethod() string {
+	if x != nil {
+		return x.RepaymentMethod
+	}
+	return ""
+}
+
+func (x *CalculateResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CalculateResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *CalculateResponse) GetLegacyNetPrice() int64 {
+	if x != nil {
+		return x.LegacyNetPrice
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *CalculateResponse) GetLegacyMonthlyPayment() int64 {
+	if x != nil {
+		return x.LegacyMonthlyPayment
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *CalculateResponse) GetLegacyTotalAmount() int64 {
+	if x != nil {
+		return x.LegacyTotalAmount
+	}
+	return 0
+}
+
+// Products
+type ListProductsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationType string                 `protobuf:"bytes,1,opt,name=application_type,json=applicationType,proto3" json:"application_type,omitempty"`
+	CurrencyCode    string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	IncludeInactive bool                   `protobuf:"varint,3,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	Page            *PageRequest           `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_loan_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListProductsRequest) GetApplicationType() string {
+	if x != nil {
+		return x.ApplicationType
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListProductsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Products         []*LoanProduct         `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Page             *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_loan_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListProductsResponse) GetProducts() []*LoanProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+type GetProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_loan_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetProductResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Product          *LoanProduct           `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
+	mi := &file_loan_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductResponse) ProtoMessage() {}
+
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetProductResponse) GetProduct() *LoanProduct {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *GetProductResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+// Loans
+type GetLoanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoanRequest) Reset() {
+	*x = GetLoanRequest{}
+	mi := &file_loan_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanRequest) ProtoMessage() {}
+
+func (x *GetLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetLoanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetLoanResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Loan             *Loan                  `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetLoanResponse) Reset() {
+	*x = GetLoanResponse{}
+	mi := &file_loan_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanResponse) ProtoMessage() {}
+
+func (x *GetLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanResponse.ProtoReflect.Descriptor instead.
+func (*GetLoanResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *GetLoanResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+type CreateLoanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLoanRequest) Reset() {
+	*x = CreateLoanRequest{}
+	mi := &file_loan_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLoanRequest) ProtoMessage() {}
+
+func (x *CreateLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLoanRequest.ProtoReflect.Descriptor instead.
+func (*CreateLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateLoanRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *CreateLoanRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type CreateLoanResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Loan             *Loan                  `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateLoanResponse) Reset() {
+	*x = CreateLoanResponse{}
+	mi := &file_loan_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLoanResponse) ProtoMessage() {}
+
+func (x *CreateLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLoanResponse.ProtoReflect.Descriptor instead.
+func (*CreateLoanResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *CreateLoanResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+type ListLoansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          *PageRequest           `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoansRequest) Reset() {
+	*x = ListLoansRequest{}
+	mi := &file_loan_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoansRequest) ProtoMessage() {}
+
+func (x *ListLoansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoansRequest.ProtoReflect.Descriptor instead.
+func (*ListLoansRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListLoansRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListLoansRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListLoansResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Loans            []*Loan                `protobuf:"bytes,1,rep,name=loans,proto3" json:"loans,omitempty"`
+	Page             *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListLoansResponse) Reset() {
+	*x = ListLoansResponse{}
+	mi := &file_loan_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoansResponse) ProtoMessage() {}
+
+func (x *ListLoansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoansResponse.ProtoReflect.Descriptor instead.
+func (*ListLoansResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListLoansResponse) GetLoans() []*Loan {
+	if x != nil {
+		return x.Loans
+	}
+	return nil
+}
+
+func (x *ListLoansResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListLoansResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+type GetLoanContractRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoanId        string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoanContractRequest) Reset() {
+	*x = GetLoanContractRequest{}
+	mi := &file_loan_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoanContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanContractRequest) ProtoMessage() {}
+
+func (x *GetLoanContractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanContractRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanContractRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetLoanContractRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type GetLoanContractResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Contract         *LeasingContract       `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetLoanContractResponse) Reset() {
+	*x = GetLoanContractResponse{}
+	mi := &file_loan_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoanContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanContractResponse) ProtoMessage() {}
+
+func (x *GetLoanContractResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanContractResponse.ProtoReflect.Descriptor instead.
+func (*GetLoanContractResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetLoanContractResponse) GetContract() *LeasingContract {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *GetLoanContractResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+type GetRepaymentScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoanId        string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepaymentScheduleRequest) Reset() {
+	*x = GetRepaymentScheduleRequest{}
+	mi := &file_loan_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepaymentScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepaymentScheduleRequest) ProtoMessage() {}
+
+func (x *GetRepaymentScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepaymentScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetRepaymentScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetRepaymentScheduleRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type GetRepaymentScheduleResponse struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	Schedule         []*RepaymentInstallment `protobuf:"bytes,1,rep,name=schedule,proto3" json:"schedule,omitempty"`
+	LoanServiceError *LoanServiceError       `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetRepaymentScheduleResponse) Reset() {
+	*x = GetRepaymentScheduleResponse{}
+	mi := &file_loan_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepaymentScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepaymentScheduleResponse) ProtoMessage() {}
+
+func (x *GetRepaymentScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepaymentScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetRepaymentScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetRepaymentScheduleResponse) GetSchedule() []*RepaymentInstallment {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *GetRepaymentScheduleResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+type ListInstallmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoanId        string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstallmentsRequest) Reset() {
+	*x = ListInstallmentsRequest{}
+	mi := &file_loan_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstallmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstallmentsRequest) ProtoMessage() {}
+
+func (x *ListInstallmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstallmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListInstallmentsRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListInstallmentsRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type ListInstallmentsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Installments     []*Installment         `protobuf:"bytes,1,rep,name=installments,proto3" json:"installments,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListInstallmentsResponse) Reset() {
+	*x = ListInstallmentsResponse{}
+	mi := &file_loan_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstallmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstallmentsResponse) ProtoMessage() {}
+
+func (x *ListInstallmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstallmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListInstallmentsResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListInstallmentsResponse) GetInstallments() []*Installment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+func (x *ListInstallmentsResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+// Payments
+type RecordPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoanId        string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	CurrencyCode  string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	TransactionId string                 `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	PaymentDate   string                 `protobuf:"bytes,6,opt,name=payment_date,json=paymentDate,proto3" json:"payment_date,omitempty"`
+	// Whole currency units, as sent before amounts became Money. Read only when
+	// the Money field of the same name is not set.
+	//
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyAmount  int64 `protobuf:"varint,3,opt,name=legacy_amount,json=legacyAmount,proto3" json:"legacy_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordPaymentRequest) Reset() {
+	*x = RecordPaymentRequest{}
+	mi := &file_loan_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordPaymentRequest) ProtoMessage() {}
+
+func (x *RecordPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RecordPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RecordPaymentRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *RecordPaymentRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *RecordPaymentRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *RecordPaymentRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *RecordPaymentRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *RecordPaymentRequest) GetPaymentDate() string {
+	if x != nil {
+		return x.PaymentDate
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *RecordPaymentRequest) GetLegacyAmount() int64 {
+	if x != nil {
+		return x.LegacyAmount
+	}
+	return 0
+}
+
+type RecordPaymentResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Payment          *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	Loan             *Loan                  `protobuf:"bytes,2,opt,name=loan,proto3" json:"loan,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RecordPaymentResponse) Reset() {
+	*x = RecordPaymentResponse{}
+	mi := &file_loan_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordPaymentResponse) ProtoMessage() {}
+
+func (x *RecordPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RecordPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RecordPaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *RecordPaymentResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *RecordPaymentResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+// InitiateInstallmentPayment charges the customer's card or wallet through
+// the payment service. Without an amount, the amount currently due is paid.
+type InitiateInstallmentPaymentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LoanId          string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Amount          *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Method          string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`                                            // CARD, WALLET
+	PaymentSourceId string                 `protobuf:"bytes,4,opt,name=payment_source_id,json=paymentSourceId,proto3" json:"payment_source_id,omitempty"` // saved card or wallet in the payment service
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InitiateInstallmentPaymentRequest) Reset() {
+	*x = InitiateInstallmentPaymentRequest{}
+	mi := &file_loan_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateInstallmentPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateInstallmentPaymentRequest) ProtoMessage() {}
+
+func (x *InitiateInstallmentPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateInstallmentPaymentRequest.ProtoReflect.Descriptor instead.
+func (*InitiateInstallmentPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *InitiateInstallmentPaymentRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *InitiateInstallmentPaymentRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *InitiateInstallmentPaymentRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *InitiateInstallmentPaymentRequest) GetPaymentSourceId() string {
+	if x != nil {
+		return x.PaymentSourceId
+	}
+	return ""
+}
+
+type InitiateInstallmentPaymentResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Payment          *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InitiateInstallmentPaymentResponse) Reset() {
+	*x = InitiateInstallmentPaymentResponse{}
+	mi := &file_loan_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateInstallmentPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateInstallmentPaymentResponse) ProtoMessage() {}
+
+func (x *InitiateInstallmentPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateInstallmentPaymentResponse.ProtoReflect.Descriptor instead.
+func (*InitiateInstallmentPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *InitiateInstallmentPaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *InitiateInstallmentPaymentResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+type GetPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentRequest) Reset() {
+	*x = GetPaymentRequest{}
+	mi := &file_loan_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentRequest) ProtoMessage() {}
+
+func (x *GetPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetPaymentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPaymentResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Payment          *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetPaymentResponse) Reset() {
+	*x = GetPaymentResponse{}
+	mi := &file_loan_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentResponse) ProtoMessage() {}
+
+func (x *GetPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentResponse.ProtoReflect.Descriptor instead.
+func (*GetPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetPaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *GetPaymentResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+type ListPaymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoanId        string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Page          *PageRequest           `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentsRequest) Reset() {
+	*x = ListPaymentsRequest{}
+	mi := &file_loan_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentsRequest) ProtoMessage() {}
+
+func (x *ListPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListPaymentsRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *ListPaymentsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListPaymentsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Payments         []*Payment             `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	Page             *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListPaymentsResponse) Reset() {
+	*x = ListPaymentsResponse{}
+	mi := &file_loan_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentsResponse) ProtoMessage() {}
+
+func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListPaymentsResponse) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *ListPaymentsResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListPaymentsResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
 	}
-	return 0
+	return nil
 }
 
-func (x *PageResponse) GetLimit() int32 {
+// Prepayments
+type QuotePrepaymentRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	LoanId string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Amount *Money                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Mode   string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"` // REDUCE_TERM or REDUCE_PAYMENT
+	// Whole currency units, as sent before amounts became Money. Read only when
+	// the Money field of the same name is not set.
+	//
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyAmount  int64 `protobuf:"varint,2,opt,name=legacy_amount,json=legacyAmount,proto3" json:"legacy_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotePrepaymentRequest) Reset() {
+	*x = QuotePrepaymentRequest{}
+	mi := &file_loan_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotePrepaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePrepaymentRequest) ProtoMessage() {}
+
+func (x *QuotePrepaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[61]
 	if x != nil {
-		return x.Limit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PageResponse) GetTotalItems() int32 {
+// Deprecated: Use QuotePrepaymentRequest.ProtoReflect.Descriptor instead.
+func (*QuotePrepaymentRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *QuotePrepaymentRequest) GetLoanId() string {
 	if x != nil {
-		return x.TotalItems
+		return x.LoanId
 	}
-	return 0
+	return ""
 }
 
-func (x *PageResponse) GetTotalPages() int32 {
+func (x *QuotePrepaymentRequest) GetAmount() *Money {
 	if x != nil {
-		return x.TotalPages
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *QuotePrepaymentRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *QuotePrepaymentRequest) GetLegacyAmount() int64 {
+	if x != nil {
+		return x.LegacyAmount
 	}
 	return 0
 }
 
-// Application
-type CreateApplicationRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	VehicleVin     string                 `protobuf:"bytes,3,opt,name=vehicle_vin,json=vehicleVin,proto3" json:"vehicle_vin,omitempty"`
-	VehicleName    string                 `protobuf:"bytes,4,opt,name=vehicle_name,json=vehicleName,proto3" json:"vehicle_name,omitempty"`
-	CurrencyCode   string                 `protobuf:"bytes,5,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
-	Price          int64                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
-	DownPayment    int64                  `protobuf:"varint,7,opt,name=down_payment,json=downPayment,proto3" json:"down_payment,omitempty"`
-	TermMonths     int32                  `protobuf:"varint,8,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
-	MarginRate     float64                `protobuf:"fixed64,9,opt,name=margin_rate,json=marginRate,proto3" json:"margin_rate,omitempty"`
-	NetPrice       int64                  `protobuf:"varint,10,opt,name=net_price,json=netPrice,proto3" json:"net_price,omitempty"`
-	MonthlyPayment int64                  `protobuf:"varint,11,opt,name=monthly_payment,json=monthlyPayment,proto3" json:"monthly_payment,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type QuotePrepaymentResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Quote            *PrepaymentQuote       `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CreateApplicationRequest) Reset() {
-	*x = CreateApplicationRequest{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[6]
+func (x *QuotePrepaymentResponse) Reset() {
+	*x = QuotePrepaymentResponse{}
+	mi := &file_loan_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateApplicationRequest) String() string {
+func (x *QuotePrepaymentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateApplicationRequest) ProtoMessage() {}
+func (*QuotePrepaymentResponse) ProtoMessage() {}
 
-func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[6]
+func (x *QuotePrepaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,111 +4960,266 @@ func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateApplicationRequest.ProtoReflect.Descriptor instead.
-func (*CreateApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QuotePrepaymentResponse.ProtoReflect.Descriptor instead.
+func (*QuotePrepaymentResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *CreateApplicationRequest) GetUserId() string {
+func (x *QuotePrepaymentResponse) GetQuote() *PrepaymentQuote {
 	if x != nil {
-		return x.UserId
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *QuotePrepaymentResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+type ApplyPrepaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoanId        string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"` // REDUCE_TERM or REDUCE_PAYMENT
+	CurrencyCode  string                 `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Method        string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	TransactionId string                 `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	PaymentDate   string                 `protobuf:"bytes,7,opt,name=payment_date,json=paymentDate,proto3" json:"payment_date,omitempty"`
+	// Whole currency units, as sent before amounts became Money. Read only when
+	// the Money field of the same name is not set.
+	//
+	// Deprecated: Marked as deprecated in loan_service.proto.
+	LegacyAmount  int64 `protobuf:"varint,2,opt,name=legacy_amount,json=legacyAmount,proto3" json:"legacy_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyPrepaymentRequest) Reset() {
+	*x = ApplyPrepaymentRequest{}
+	mi := &file_loan_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyPrepaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPrepaymentRequest) ProtoMessage() {}
+
+func (x *ApplyPrepaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPrepaymentRequest.ProtoReflect.Descriptor instead.
+func (*ApplyPrepaymentRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ApplyPrepaymentRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
 	}
 	return ""
 }
 
-func (x *CreateApplicationRequest) GetType() string {
+func (x *ApplyPrepaymentRequest) GetAmount() *Money {
 	if x != nil {
-		return x.Type
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ApplyPrepaymentRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *CreateApplicationRequest) GetVehicleVin() string {
+func (x *ApplyPrepaymentRequest) GetCurrencyCode() string {
 	if x != nil {
-		return x.VehicleVin
+		return x.CurrencyCode
 	}
 	return ""
 }
 
-func (x *CreateApplicationRequest) GetVehicleName() string {
+func (x *ApplyPrepaymentRequest) GetMethod() string {
 	if x != nil {
-		return x.VehicleName
+		return x.Method
 	}
 	return ""
 }
 
-func (x *CreateApplicationRequest) GetCurrencyCode() string {
+func (x *ApplyPrepaymentRequest) GetTransactionId() string {
 	if x != nil {
-		return x.CurrencyCode
+		return x.TransactionId
 	}
 	return ""
 }
 
-func (x *CreateApplicationRequest) GetPrice() int64 {
+func (x *ApplyPrepaymentRequest) GetPaymentDate() string {
 	if x != nil {
-		return x.Price
+		return x.PaymentDate
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateApplicationRequest) GetDownPayment() int64 {
+// Deprecated: Marked as deprecated in loan_service.proto.
+func (x *ApplyPrepaymentRequest) GetLegacyAmount() int64 {
 	if x != nil {
-		return x.DownPayment
+		return x.LegacyAmount
 	}
 	return 0
 }
 
-func (x *CreateApplicationRequest) GetTermMonths() int32 {
+type ApplyPrepaymentResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Quote            *PrepaymentQuote       `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	Loan             *Loan                  `protobuf:"bytes,2,opt,name=loan,proto3" json:"loan,omitempty"`
+	Payment          *Payment               `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ApplyPrepaymentResponse) Reset() {
+	*x = ApplyPrepaymentResponse{}
+	mi := &file_loan_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyPrepaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPrepaymentResponse) ProtoMessage() {}
+
+func (x *ApplyPrepaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[64]
 	if x != nil {
-		return x.TermMonths
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateApplicationRequest) GetMarginRate() float64 {
+// Deprecated: Use ApplyPrepaymentResponse.ProtoReflect.Descriptor instead.
+func (*ApplyPrepaymentResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ApplyPrepaymentResponse) GetQuote() *PrepaymentQuote {
 	if x != nil {
-		return x.MarginRate
+		return x.Quote
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateApplicationRequest) GetNetPrice() int64 {
+func (x *ApplyPrepaymentResponse) GetLoan() *Loan {
 	if x != nil {
-		return x.NetPrice
+		return x.Loan
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateApplicationRequest) GetMonthlyPayment() int64 {
+func (x *ApplyPrepaymentResponse) GetPayment() *Payment {
 	if x != nil {
-		return x.MonthlyPayment
+		return x.Payment
 	}
-	return 0
+	return nil
 }
 
-type CreateApplicationResponse struct {
+func (x *ApplyPrepaymentResponse) GetLoanServiceError() *LoanServiceError {
+	if x != nil {
+		return x.LoanServiceError
+	}
+	return nil
+}
+
+// Payoff
+type GetPayoffQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoanId        string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayoffQuoteRequest) Reset() {
+	*x = GetPayoffQuoteRequest{}
+	mi := &file_loan_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayoffQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoffQuoteRequest) ProtoMessage() {}
+
+func (x *GetPayoffQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoffQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetPayoffQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetPayoffQuoteRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type GetPayoffQuoteResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
-	Application      *LoanApplication       `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	Quote            *PayoffQuote           `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
 	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CreateApplicationResponse) Reset() {
-	*x = CreateApplicationResponse{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[7]
+func (x *GetPayoffQuoteResponse) Reset() {
+	*x = GetPayoffQuoteResponse{}
+	mi := &file_loan_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateApplicationResponse) String() string {
+func (x *GetPayoffQuoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateApplicationResponse) ProtoMessage() {}
+func (*GetPayoffQuoteResponse) ProtoMessage() {}
 
-func (x *CreateApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[7]
+func (x *GetPayoffQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -724,47 +5230,51 @@ func (x *CreateApplicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateApplicationResponse.ProtoReflect.Descriptor instead.
-func (*CreateApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetPayoffQuoteResponse.ProtoReflect.Descriptor instead.
+func (*GetPayoffQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *CreateApplicationResponse) GetApplication() *LoanApplication {
+func (x *GetPayoffQuoteResponse) GetQuote() *PayoffQuote {
 	if x != nil {
-		return x.Application
+		return x.Quote
 	}
 	return nil
 }
 
-func (x *CreateApplicationResponse) GetLoanServiceError() *LoanServiceError {
+func (x *GetPayoffQuoteResponse) GetLoanServiceError() *LoanServiceError {
 	if x != nil {
 		return x.LoanServiceError
 	}
 	return nil
 }
 
-type GetApplicationRequest struct {
+type SettleLoanRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuoteId       string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	CurrencyCode  string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	TransactionId string                 `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	PaymentDate   string                 `protobuf:"bytes,5,opt,name=payment_date,json=paymentDate,proto3" json:"payment_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetApplicationRequest) Reset() {
-	*x = GetApplicationRequest{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[8]
+func (x *SettleLoanRequest) Reset() {
+	*x = SettleLoanRequest{}
+	mi := &file_loan_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApplicationRequest) String() string {
+func (x *SettleLoanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApplicationRequest) ProtoMessage() {}
+func (*SettleLoanRequest) ProtoMessage() {}
 
-func (x *GetApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[8]
+func (x *SettleLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,41 +5285,71 @@ func (x *GetApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApplicationRequest.ProtoReflect.Descriptor instead.
-func (*GetApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SettleLoanRequest.ProtoReflect.Descriptor instead.
+func (*SettleLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetApplicationRequest) GetId() string {
+func (x *SettleLoanRequest) GetQuoteId() string {
 	if x != nil {
-		return x.Id
+		return x.QuoteId
 	}
 	return ""
 }
 
-type GetApplicationResponse struct {
+func (x *SettleLoanRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *SettleLoanRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SettleLoanRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *SettleLoanRequest) GetPaymentDate() string {
+	if x != nil {
+		return x.PaymentDate
+	}
+	return ""
+}
+
+type SettleLoanResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
-	Application      *LoanApplication       `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	Quote            *PayoffQuote           `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	Loan             *Loan                  `protobuf:"bytes,2,opt,name=loan,proto3" json:"loan,omitempty"`
+	Payment          *Payment               `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment,omitempty"`
 	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetApplicationResponse) Reset() {
-	*x = GetApplicationResponse{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[9]
+func (x *SettleLoanResponse) Reset() {
+	*x = SettleLoanResponse{}
+	mi := &file_loan_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetApplicationResponse) String() string {
+func (x *SettleLoanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApplicationResponse) ProtoMessage() {}
+func (*SettleLoanResponse) ProtoMessage() {}
 
-func (x *GetApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[9]
+func (x *SettleLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -820,48 +5360,63 @@ func (x *GetApplicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApplicationResponse.ProtoReflect.Descriptor instead.
-func (*GetApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SettleLoanResponse.ProtoReflect.Descriptor instead.
+func (*SettleLoanResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SettleLoanResponse) GetQuote() *PayoffQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *SettleLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
 }
 
-func (x *GetApplicationResponse) GetApplication() *LoanApplication {
+func (x *SettleLoanResponse) GetPayment() *Payment {
 	if x != nil {
-		return x.Application
+		return x.Payment
 	}
 	return nil
 }
 
-func (x *GetApplicationResponse) GetLoanServiceError() *LoanServiceError {
+func (x *SettleLoanResponse) GetLoanServiceError() *LoanServiceError {
 	if x != nil {
 		return x.LoanServiceError
 	}
 	return nil
 }
 
-type ListApplicationsRequest struct {
+// Admin
+type GetReconciliationSummaryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // status of the listed issues, OPEN by default
 	Page          *PageRequest           `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListApplicationsRequest) Reset() {
-	*x = ListApplicationsRequest{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[10]
+func (x *GetReconciliationSummaryRequest) Reset() {
+	*x = GetReconciliationSummaryRequest{}
+	mi := &file_loan_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListApplicationsRequest) String() string {
+func (x *GetReconciliationSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListApplicationsRequest) ProtoMessage() {}
+func (*GetReconciliationSummaryRequest) ProtoMessage() {}
 
-func (x *ListApplicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[10]
+func (x *GetReconciliationSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,49 +5427,51 @@ func (x *ListApplicationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListApplicationsRequest.ProtoReflect.Descriptor instead.
-func (*ListApplicationsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetReconciliationSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ListApplicationsRequest) GetUserId() string {
+func (x *GetReconciliationSummaryRequest) GetStatus() string {
 	if x != nil {
-		return x.UserId
+		return x.Status
 	}
 	return ""
 }
 
-func (x *ListApplicationsRequest) GetPage() *PageRequest {
+func (x *GetReconciliationSummaryRequest) GetPage() *PageRequest {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type ListApplicationsResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Applications     []*LoanApplication     `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
-	Page             *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
-	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
+type GetReconciliationSummaryResponse struct {
+	state            protoimpl.MessageState      `protogen:"open.v1"`
+	LastRun          *ReconciliationRun          `protobuf:"bytes,1,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	Counts           []*ReconciliationIssueCount `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty"`
+	Issues           []*ReconciliationIssue      `protobuf:"bytes,3,rep,name=issues,proto3" json:"issues,omitempty"`
+	Page             *PageResponse               `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`
+	LoanServiceError *LoanServiceError           `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListApplicationsResponse) Reset() {
-	*x = ListApplicationsResponse{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[11]
+func (x *GetReconciliationSummaryResponse) Reset() {
+	*x = GetReconciliationSummaryResponse{}
+	mi := &file_loan_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListApplicationsResponse) String() string {
+func (x *GetReconciliationSummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListApplicationsResponse) ProtoMessage() {}
+func (*GetReconciliationSummaryResponse) ProtoMessage() {}
 
-func (x *ListApplicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[11]
+func (x *GetReconciliationSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -925,53 +5482,71 @@ func (x *ListApplicationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListApplicationsResponse.ProtoReflect.Descriptor instead.
-func (*ListApplicationsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetReconciliationSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetReconciliationSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ListApplicationsResponse) GetApplications() []*LoanApplication {
+func (x *GetReconciliationSummaryResponse) GetLastRun() *ReconciliationRun {
 	if x != nil {
-		return x.Applications
+		return x.LastRun
 	}
 	return nil
 }
 
-func (x *ListApplicationsResponse) GetPage() *PageResponse {
+func (x *GetReconciliationSummaryResponse) GetCounts() []*ReconciliationIssueCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *GetReconciliationSummaryResponse) GetIssues() []*ReconciliationIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *GetReconciliationSummaryResponse) GetPage() *PageResponse {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-func (x *ListApplicationsResponse) GetLoanServiceError() *LoanServiceError {
+func (x *GetReconciliationSummaryResponse) GetLoanServiceError() *LoanServiceError {
 	if x != nil {
 		return x.LoanServiceError
 	}
 	return nil
 }
 
-type ListVehiclesRequest struct {
+// ListDealerNotifications lists applications by the status of their delivery
+// to Koinot Auto, oldest first.
+type ListDealerNotificationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // PENDING, SENT or FAILED (default)
+	Page          *PageRequest           `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListVehiclesRequest) Reset() {
-	*x = ListVehiclesRequest{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[12]
+func (x *ListDealerNotificationsRequest) Reset() {
+	*x = ListDealerNotificationsRequest{}
+	mi := &file_loan_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVehiclesRequest) String() string {
+func (x *ListDealerNotificationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVehiclesRequest) ProtoMessage() {}
+func (*ListDealerNotificationsRequest) ProtoMessage() {}
 
-func (x *ListVehiclesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[12]
+func (x *ListDealerNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,34 +5557,49 @@ func (x *ListVehiclesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVehiclesRequest.ProtoReflect.Descriptor instead.
-func (*ListVehiclesRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListDealerNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListDealerNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{71}
 }
 
-type ListVehiclesResponse struct {
+func (x *ListDealerNotificationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListDealerNotificationsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListDealerNotificationsResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
-	Vehicles         []*Vehicle             `protobuf:"bytes,1,rep,name=vehicles,proto3" json:"vehicles,omitempty"`
+	Applications     []*LoanApplication     `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	Page             *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListVehiclesResponse) Reset() {
-	*x = ListVehiclesResponse{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[13]
+func (x *ListDealerNotificationsResponse) Reset() {
+	*x = ListDealerNotificationsResponse{}
+	mi := &file_loan_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVehiclesResponse) String() string {
+func (x *ListDealerNotificationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVehiclesResponse) ProtoMessage() {}
+func (*ListDealerNotificationsResponse) ProtoMessage() {}
 
-func (x *ListVehiclesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[13]
+func (x *ListDealerNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,52 +5610,55 @@ func (x *ListVehiclesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVehiclesResponse.ProtoReflect.Descriptor instead.
-func (*ListVehiclesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListDealerNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListDealerNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ListVehiclesResponse) GetVehicles() []*Vehicle {
+func (x *ListDealerNotificationsResponse) GetApplications() []*LoanApplication {
 	if x != nil {
-		return x.Vehicles
+		return x.Applications
 	}
 	return nil
 }
 
-func (x *ListVehiclesResponse) GetLoanServiceError() *LoanServiceError {
+func (x *ListDealerNotificationsResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListDealerNotificationsResponse) GetLoanServiceError() *LoanServiceError {
 	if x != nil {
 		return x.LoanServiceError
 	}
 	return nil
 }
 
-// Calculator
-type CalculateRequest struct {
+// CreateProduct and UpdateProduct take the whole product, rate grid included.
+type CreateProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CurrencyCode  string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
-	Price         int64                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
-	DownPayment   int64                  `protobuf:"varint,3,opt,name=down_payment,json=downPayment,proto3" json:"down_payment,omitempty"`
-	TermMonths    int32                  `protobuf:"varint,4,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
-	MarginRate    float64                `protobuf:"fixed64,5,opt,name=margin_rate,json=marginRate,proto3" json:"margin_rate,omitempty"`
+	Product       *LoanProduct           `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalculateRequest) Reset() {
-	*x = CalculateRequest{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[14]
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_loan_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateRequest) String() string {
+func (x *CreateProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateRequest) ProtoMessage() {}
+func (*CreateProductRequest) ProtoMessage() {}
 
-func (x *CalculateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[14]
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,71 +5669,41 @@ func (x *CalculateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateRequest.ProtoReflect.Descriptor instead.
-func (*CalculateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *CalculateRequest) GetCurrencyCode() string {
-	if x != nil {
-		return x.CurrencyCode
-	}
-	return ""
-}
-
-func (x *CalculateRequest) GetPrice() int64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *CalculateRequest) GetDownPayment() int64 {
-	if x != nil {
-		return x.DownPayment
-	}
-	return 0
-}
-
-func (x *CalculateRequest) GetTermMonths() int32 {
-	if x != nil {
-		return x.TermMonths
-	}
-	return 0
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *CalculateRequest) GetMarginRate() float64 {
+func (x *CreateProductRequest) GetProduct() *LoanProduct {
 	if x != nil {
-		return x.MarginRate
+		return x.Product
 	}
-	return 0
+	return nil
 }
 
-type CalculateResponse struct {
+type CreateProductResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
-	NetPrice         int64                  `protobuf:"varint,1,opt,name=net_price,json=netPrice,proto3" json:"net_price,omitempty"`
-	MonthlyPayment   int64                  `protobuf:"varint,2,opt,name=monthly_payment,json=monthlyPayment,proto3" json:"monthly_payment,omitempty"`
-	TotalAmount      int64                  `protobuf:"varint,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Product          *LoanProduct           `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
 	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CalculateResponse) Reset() {
-	*x = CalculateResponse{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[15]
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
+	mi := &file_loan_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateResponse) String() string {
+func (x *CreateProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateResponse) ProtoMessage() {}
+func (*CreateProductResponse) ProtoMessage() {}
 
-func (x *CalculateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[15]
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1151,62 +5714,47 @@ func (x *CalculateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateResponse.ProtoReflect.Descriptor instead.
-func (*CalculateResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *CalculateResponse) GetNetPrice() int64 {
-	if x != nil {
-		return x.NetPrice
-	}
-	return 0
-}
-
-func (x *CalculateResponse) GetMonthlyPayment() int64 {
-	if x != nil {
-		return x.MonthlyPayment
-	}
-	return 0
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *CalculateResponse) GetTotalAmount() int64 {
+func (x *CreateProductResponse) GetProduct() *LoanProduct {
 	if x != nil {
-		return x.TotalAmount
+		return x.Product
 	}
-	return 0
+	return nil
 }
 
-func (x *CalculateResponse) GetLoanServiceError() *LoanServiceError {
+func (x *CreateProductResponse) GetLoanServiceError() *LoanServiceError {
 	if x != nil {
 		return x.LoanServiceError
 	}
 	return nil
 }
 
-// Loans
-type GetLoanRequest struct {
+type UpdateProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Product       *LoanProduct           `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLoanRequest) Reset() {
-	*x = GetLoanRequest{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[16]
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_loan_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLoanRequest) String() string {
+func (x *UpdateProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoanRequest) ProtoMessage() {}
+func (*UpdateProductRequest) ProtoMessage() {}
 
-func (x *GetLoanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[16]
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1217,41 +5765,41 @@ func (x *GetLoanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoanRequest.ProtoReflect.Descriptor instead.
-func (*GetLoanRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetLoanRequest) GetId() string {
+func (x *UpdateProductRequest) GetProduct() *LoanProduct {
 	if x != nil {
-		return x.Id
+		return x.Product
 	}
-	return ""
+	return nil
 }
 
-type GetLoanResponse struct {
+type UpdateProductResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
-	Loan             *Loan                  `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	Product          *LoanProduct           `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
 	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetLoanResponse) Reset() {
-	*x = GetLoanResponse{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[17]
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_loan_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLoanResponse) String() string {
+func (x *UpdateProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoanResponse) ProtoMessage() {}
+func (*UpdateProductResponse) ProtoMessage() {}
 
-func (x *GetLoanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[17]
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1262,48 +5810,49 @@ func (x *GetLoanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoanResponse.ProtoReflect.Descriptor instead.
-func (*GetLoanResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetLoanResponse) GetLoan() *Loan {
+func (x *UpdateProductResponse) GetProduct() *LoanProduct {
 	if x != nil {
-		return x.Loan
+		return x.Product
 	}
 	return nil
 }
 
-func (x *GetLoanResponse) GetLoanServiceError() *LoanServiceError {
+func (x *UpdateProductResponse) GetLoanServiceError() *LoanServiceError {
 	if x != nil {
 		return x.LoanServiceError
 	}
 	return nil
 }
 
-type ListLoansRequest struct {
+// DeleteProduct removes a product no application refers to; deactivate the
+// others instead.
+type DeleteProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          *PageRequest           `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLoansRequest) Reset() {
-	*x = ListLoansRequest{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[18]
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_loan_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLoansRequest) String() string {
+func (x *DeleteProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLoansRequest) ProtoMessage() {}
+func (*DeleteProductRequest) ProtoMessage() {}
 
-func (x *ListLoansRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[18]
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1314,49 +5863,40 @@ func (x *ListLoansRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLoansRequest.ProtoReflect.Descriptor instead.
-func (*ListLoansRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ListLoansRequest) GetUserId() string {
+func (x *DeleteProductRequest) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListLoansRequest) GetPage() *PageRequest {
-	if x != nil {
-		return x.Page
-	}
-	return nil
-}
-
-type ListLoansResponse struct {
+type DeleteProductResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
-	Loans            []*Loan                `protobuf:"bytes,1,rep,name=loans,proto3" json:"loans,omitempty"`
-	Page             *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	LoanServiceError *LoanServiceError      `protobuf:"bytes,100,opt,name=loan_service_error,json=loanServiceError,proto3" json:"loan_service_error,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListLoansResponse) Reset() {
-	*x = ListLoansResponse{}
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[19]
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_loan_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLoansResponse) String() string {
+func (x *DeleteProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLoansResponse) ProtoMessage() {}
+func (*DeleteProductResponse) ProtoMessage() {}
 
-func (x *ListLoansResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_loan_loan_service_proto_msgTypes[19]
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1367,49 +5907,44 @@ func (x *ListLoansResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLoansResponse.ProtoReflect.Descriptor instead.
-func (*ListLoansResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_loan_loan_service_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *ListLoansResponse) GetLoans() []*Loan {
-	if x != nil {
-		return x.Loans
-	}
-	return nil
-}
-
-func (x *ListLoansResponse) GetPage() *PageResponse {
-	if x != nil {
-		return x.Page
-	}
-	return nil
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ListLoansResponse) GetLoanServiceError() *LoanServiceError {
+func (x *DeleteProductResponse) GetLoanServiceError() *LoanServiceError {
 	if x != nil {
 		return x.LoanServiceError
 	}
 	return nil
 }
 
-var File_internal_proto_loan_loan_service_proto protoreflect.FileDescriptor
+var File_loan_service_proto protoreflect.FileDescriptor
 
-const file_internal_proto_loan_loan_service_proto_rawDesc = "" +
+const file_loan_service_proto_rawDesc = "" +
 	"\n" +
-	"&internal/proto/loan/loan_service.proto\x12\x06loanpb\"H\n" +
+	"\x12loan_service.proto\x12\x06loanpb\"\x7f\n" +
 	"\x10LoanServiceError\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\"\xce\x01\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x125\n" +
+	"\ffield_errors\x18\x03 \x03(\v2\x12.loanpb.FieldErrorR\vfieldErrors\"D\n" +
+	"\n" +
+	"FieldError\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"D\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\"\x8c\x02\n" +
 	"\aVehicle\x12\x1b\n" +
 	"\timage_url\x18\x01 \x01(\tR\bimageUrl\x12\x10\n" +
 	"\x03vin\x18\x02 \x01(\tR\x03vin\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
 	"\vengine_type\x18\x04 \x01(\tR\n" +
 	"engineType\x12$\n" +
-	"\rconfiguration\x18\x05 \x01(\tR\rconfiguration\x12\x14\n" +
-	"\x05price\x18\x06 \x01(\x03R\x05price\x12#\n" +
-	"\rcurrency_code\x18\a \x01(\tR\fcurrencyCode\"\xce\x03\n" +
+	"\rconfiguration\x18\x05 \x01(\tR\rconfiguration\x12#\n" +
+	"\x05price\x18\t \x01(\v2\r.loanpb.MoneyR\x05price\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12%\n" +
+	"\flegacy_price\x18\x06 \x01(\x03B\x02\x18\x01R\vlegacyPriceJ\x04\b\a\x10\bR\rcurrency_code\"\x83\t\n" +
 	"\x0fLoanApplication\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
@@ -1417,37 +5952,206 @@ const file_internal_proto_loan_loan_service_proto_rawDesc = "" +
 	"\vvehicle_vin\x18\x04 \x01(\tR\n" +
 	"vehicleVin\x12!\n" +
 	"\fvehicle_name\x18\x05 \x01(\tR\vvehicleName\x12#\n" +
-	"\rcurrency_code\x18\x06 \x01(\tR\fcurrencyCode\x12\x14\n" +
-	"\x05price\x18\a \x01(\x03R\x05price\x12!\n" +
-	"\fdown_payment\x18\b \x01(\x03R\vdownPayment\x12\x1b\n" +
-	"\tnet_price\x18\t \x01(\x03R\bnetPrice\x12\x1f\n" +
+	"\rcurrency_code\x18\x06 \x01(\tR\fcurrencyCode\x12#\n" +
+	"\x05price\x18\x19 \x01(\v2\r.loanpb.MoneyR\x05price\x120\n" +
+	"\fdown_payment\x18\x1a \x01(\v2\r.loanpb.MoneyR\vdownPayment\x12*\n" +
+	"\tnet_price\x18\x1b \x01(\v2\r.loanpb.MoneyR\bnetPrice\x12\x1f\n" +
 	"\vmargin_rate\x18\n" +
 	" \x01(\x01R\n" +
 	"marginRate\x12\x1f\n" +
 	"\vterm_months\x18\v \x01(\x05R\n" +
-	"termMonths\x12'\n" +
-	"\x0fmonthly_payment\x18\f \x01(\x03R\x0emonthlyPayment\x12\x16\n" +
+	"termMonths\x126\n" +
+	"\x0fmonthly_payment\x18\x1c \x01(\v2\r.loanpb.MoneyR\x0emonthlyPayment\x12\x16\n" +
 	"\x06status\x18\r \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x0f \x01(\tR\tupdatedAt\"\xe2\x02\n" +
+	"updated_at\x18\x0f \x01(\tR\tupdatedAt\x12)\n" +
+	"\x10repayment_method\x18\x10 \x01(\tR\x0frepaymentMethod\x122\n" +
+	"\rvehicle_price\x18\x11 \x01(\v2\r.loanpb.MoneyR\fvehiclePrice\x12#\n" +
+	"\rexchange_rate\x18\x12 \x01(\x01R\fexchangeRate\x12'\n" +
+	"\x0fcontract_number\x18\x13 \x01(\tR\x0econtractNumber\x12<\n" +
+	"\x1adealer_notification_status\x18\x14 \x01(\tR\x18dealerNotificationStatus\x12@\n" +
+	"\x1cdealer_notification_attempts\x18\x15 \x01(\x05R\x1adealerNotificationAttempts\x12:\n" +
+	"\x19dealer_notification_error\x18\x16 \x01(\tR\x17dealerNotificationError\x12,\n" +
+	"\x12dealer_notified_at\x18\x17 \x01(\tR\x10dealerNotifiedAt\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x18 \x01(\tR\tproductId\x12%\n" +
+	"\flegacy_price\x18\a \x01(\x03B\x02\x18\x01R\vlegacyPrice\x122\n" +
+	"\x13legacy_down_payment\x18\b \x01(\x03B\x02\x18\x01R\x11legacyDownPayment\x12,\n" +
+	"\x10legacy_net_price\x18\t \x01(\x03B\x02\x18\x01R\x0elegacyNetPrice\x128\n" +
+	"\x16legacy_monthly_payment\x18\f \x01(\x03B\x02\x18\x01R\x14legacyMonthlyPayment\"\xff\a\n" +
 	"\x04Loan\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
 	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\x12#\n" +
 	"\rcurrency_code\x18\x04 \x01(\tR\fcurrencyCode\x12\x1f\n" +
 	"\vvehicle_vin\x18\x05 \x01(\tR\n" +
-	"vehicleVin\x12\x16\n" +
-	"\x06amount\x18\x06 \x01(\x03R\x06amount\x12\x1f\n" +
+	"vehicleVin\x12%\n" +
+	"\x06amount\x18\x13 \x01(\v2\r.loanpb.MoneyR\x06amount\x12\x1f\n" +
 	"\vterm_months\x18\a \x01(\x05R\n" +
-	"termMonths\x12'\n" +
-	"\x0fmonthly_payment\x18\b \x01(\x03R\x0emonthlyPayment\x12+\n" +
-	"\x11remaining_balance\x18\t \x01(\x03R\x10remainingBalance\x12\x16\n" +
+	"termMonths\x126\n" +
+	"\x0fmonthly_payment\x18\x14 \x01(\v2\r.loanpb.MoneyR\x0emonthlyPayment\x12:\n" +
+	"\x11remaining_balance\x18\x15 \x01(\v2\r.loanpb.MoneyR\x10remainingBalance\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1f\n" +
+	"\vmargin_rate\x18\f \x01(\x01R\n" +
+	"marginRate\x12)\n" +
+	"\x10repayment_method\x18\r \x01(\tR\x0frepaymentMethod\x12\"\n" +
+	"\rdays_past_due\x18\x0e \x01(\x05R\vdaysPastDue\x12>\n" +
+	"\x13charges_outstanding\x18\x16 \x01(\v2\r.loanpb.MoneyR\x12chargesOutstanding\x12:\n" +
+	"\x11total_outstanding\x18\x17 \x01(\v2\r.loanpb.MoneyR\x10totalOutstanding\x12'\n" +
+	"\x0fcontract_number\x18\x11 \x01(\tR\x0econtractNumber\x128\n" +
+	"\x18ownership_transferred_at\x18\x12 \x01(\tR\x16ownershipTransferredAt\x12'\n" +
+	"\rlegacy_amount\x18\x06 \x01(\x03B\x02\x18\x01R\flegacyAmount\x128\n" +
+	"\x16legacy_monthly_payment\x18\b \x01(\x03B\x02\x18\x01R\x14legacyMonthlyPayment\x12<\n" +
+	"\x18legacy_remaining_balance\x18\t \x01(\x03B\x02\x18\x01R\x16legacyRemainingBalance\x12@\n" +
+	"\x1alegacy_charges_outstanding\x18\x0f \x01(\x03B\x02\x18\x01R\x18legacyChargesOutstanding\x12<\n" +
+	"\x18legacy_total_outstanding\x18\x10 \x01(\x03B\x02\x18\x01R\x16legacyTotalOutstanding\"\xad\x01\n" +
+	"\x0fLeasingContract\x12'\n" +
+	"\x0fcontract_number\x18\x01 \x01(\tR\x0econtractNumber\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12:\n" +
+	"\x11remaining_balance\x18\x03 \x01(\v2\r.loanpb.MoneyR\x10remainingBalance\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\"\xc0\x02\n" +
+	"\aPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aloan_id\x18\x02 \x01(\tR\x06loanId\x12#\n" +
+	"\rcurrency_code\x18\x03 \x01(\tR\fcurrencyCode\x12!\n" +
+	"\fpayment_date\x18\x04 \x01(\tR\vpaymentDate\x12%\n" +
+	"\x06amount\x18\n" +
+	" \x01(\v2\r.loanpb.MoneyR\x06amount\x12\x16\n" +
+	"\x06method\x18\x06 \x01(\tR\x06method\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12%\n" +
+	"\x0etransaction_id\x18\b \x01(\tR\rtransactionId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12'\n" +
+	"\rlegacy_amount\x18\x05 \x01(\x03B\x02\x18\x01R\flegacyAmount\"\xfa\x03\n" +
+	"\x14RepaymentInstallment\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12\x19\n" +
+	"\bdue_date\x18\x02 \x01(\tR\adueDate\x12'\n" +
+	"\apayment\x18\b \x01(\v2\r.loanpb.MoneyR\apayment\x12+\n" +
+	"\tprincipal\x18\t \x01(\v2\r.loanpb.MoneyR\tprincipal\x12%\n" +
+	"\x06margin\x18\n" +
+	" \x01(\v2\r.loanpb.MoneyR\x06margin\x12>\n" +
+	"\x13outstanding_balance\x18\v \x01(\v2\r.loanpb.MoneyR\x12outstandingBalance\x12-\n" +
+	"\x12ownership_transfer\x18\a \x01(\bR\x11ownershipTransfer\x12)\n" +
+	"\x0elegacy_payment\x18\x03 \x01(\x03B\x02\x18\x01R\rlegacyPayment\x12-\n" +
+	"\x10legacy_principal\x18\x04 \x01(\x03B\x02\x18\x01R\x0flegacyPrincipal\x12'\n" +
+	"\rlegacy_margin\x18\x05 \x01(\x03B\x02\x18\x01R\flegacyMargin\x12@\n" +
+	"\x1alegacy_outstanding_balance\x18\x06 \x01(\x03B\x02\x18\x01R\x18legacyOutstandingBalance\"\xb2\x04\n" +
+	"\vInstallment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aloan_id\x18\x02 \x01(\tR\x06loanId\x12\x16\n" +
+	"\x06number\x18\x03 \x01(\x05R\x06number\x12\x19\n" +
+	"\bdue_date\x18\x04 \x01(\tR\adueDate\x122\n" +
+	"\rprincipal_due\x18\v \x01(\v2\r.loanpb.MoneyR\fprincipalDue\x12,\n" +
+	"\n" +
+	"margin_due\x18\f \x01(\v2\r.loanpb.MoneyR\tmarginDue\x124\n" +
+	"\x0eprincipal_paid\x18\r \x01(\v2\r.loanpb.MoneyR\rprincipalPaid\x12.\n" +
+	"\vmargin_paid\x18\x0e \x01(\v2\r.loanpb.MoneyR\n" +
+	"marginPaid\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x17\n" +
+	"\apaid_at\x18\n" +
+	" \x01(\tR\x06paidAt\x124\n" +
+	"\x14legacy_principal_due\x18\x05 \x01(\x03B\x02\x18\x01R\x12legacyPrincipalDue\x12.\n" +
+	"\x11legacy_margin_due\x18\x06 \x01(\x03B\x02\x18\x01R\x0flegacyMarginDue\x126\n" +
+	"\x15legacy_principal_paid\x18\a \x01(\x03B\x02\x18\x01R\x13legacyPrincipalPaid\x120\n" +
+	"\x12legacy_margin_paid\x18\b \x01(\x03B\x02\x18\x01R\x10legacyMarginPaid\"\xb2\x05\n" +
+	"\vPayoffQuote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aloan_id\x18\x02 \x01(\tR\x06loanId\x12+\n" +
+	"\tprincipal\x18\f \x01(\v2\r.loanpb.MoneyR\tprincipal\x12%\n" +
+	"\x06margin\x18\r \x01(\v2\r.loanpb.MoneyR\x06margin\x122\n" +
+	"\rmargin_waived\x18\x0e \x01(\v2\r.loanpb.MoneyR\fmarginWaived\x12'\n" +
+	"\acharges\x18\x0f \x01(\v2\r.loanpb.MoneyR\acharges\x124\n" +
+	"\x0esettlement_fee\x18\x10 \x01(\v2\r.loanpb.MoneyR\rsettlementFee\x12#\n" +
+	"\x05total\x18\x11 \x01(\v2\r.loanpb.MoneyR\x05total\x12\x1f\n" +
+	"\vvalid_until\x18\t \x01(\tR\n" +
+	"validUntil\x12\x16\n" +
 	"\x06status\x18\n" +
 	" \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\v \x01(\tR\tcreatedAt\"7\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12-\n" +
+	"\x10legacy_principal\x18\x03 \x01(\x03B\x02\x18\x01R\x0flegacyPrincipal\x12'\n" +
+	"\rlegacy_margin\x18\x04 \x01(\x03B\x02\x18\x01R\flegacyMargin\x124\n" +
+	"\x14legacy_margin_waived\x18\x05 \x01(\x03B\x02\x18\x01R\x12legacyMarginWaived\x12)\n" +
+	"\x0elegacy_charges\x18\x06 \x01(\x03B\x02\x18\x01R\rlegacyCharges\x126\n" +
+	"\x15legacy_settlement_fee\x18\a \x01(\x03B\x02\x18\x01R\x13legacySettlementFee\x12%\n" +
+	"\flegacy_total\x18\b \x01(\x03B\x02\x18\x01R\vlegacyTotal\"\xbb\x04\n" +
+	"\x0fPrepaymentQuote\x12\x17\n" +
+	"\aloan_id\x18\x01 \x01(\tR\x06loanId\x12%\n" +
+	"\x06amount\x18\t \x01(\v2\r.loanpb.MoneyR\x06amount\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12\x1f\n" +
+	"\vterm_months\x18\x04 \x01(\x05R\n" +
+	"termMonths\x126\n" +
+	"\x0fmonthly_payment\x18\n" +
+	" \x01(\v2\r.loanpb.MoneyR\x0emonthlyPayment\x12:\n" +
+	"\x11remaining_balance\x18\v \x01(\v2\r.loanpb.MoneyR\x10remainingBalance\x120\n" +
+	"\fmargin_saved\x18\f \x01(\v2\r.loanpb.MoneyR\vmarginSaved\x128\n" +
+	"\bschedule\x18\b \x03(\v2\x1c.loanpb.RepaymentInstallmentR\bschedule\x12'\n" +
+	"\rlegacy_amount\x18\x02 \x01(\x03B\x02\x18\x01R\flegacyAmount\x128\n" +
+	"\x16legacy_monthly_payment\x18\x05 \x01(\x03B\x02\x18\x01R\x14legacyMonthlyPayment\x12<\n" +
+	"\x18legacy_remaining_balance\x18\x06 \x01(\x03B\x02\x18\x01R\x16legacyRemainingBalance\x122\n" +
+	"\x13legacy_margin_saved\x18\a \x01(\x03B\x02\x18\x01R\x11legacyMarginSaved\"\xf7\x01\n" +
+	"\x11ReconciliationRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x02 \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\x03 \x01(\tR\n" +
+	"finishedAt\x12#\n" +
+	"\rloans_checked\x18\x04 \x01(\x05R\floansChecked\x12!\n" +
+	"\fissues_found\x18\x05 \x01(\x05R\vissuesFound\x12\x1c\n" +
+	"\tcorrected\x18\x06 \x01(\x05R\tcorrected\x12\x16\n" +
+	"\x06failed\x18\a \x01(\x05R\x06failed\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"\x87\x03\n" +
+	"\x13ReconciliationIssue\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aloan_id\x18\x02 \x01(\tR\x06loanId\x12'\n" +
+	"\x0fcontract_number\x18\x03 \x01(\tR\x0econtractNumber\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x122\n" +
+	"\rlocal_balance\x18\x06 \x01(\v2\r.loanpb.MoneyR\flocalBalance\x124\n" +
+	"\x0eremote_balance\x18\a \x01(\v2\r.loanpb.MoneyR\rremoteBalance\x12!\n" +
+	"\flocal_status\x18\b \x01(\tR\vlocalStatus\x12#\n" +
+	"\rremote_status\x18\t \x01(\tR\fremoteStatus\x12\x1f\n" +
+	"\vdetected_at\x18\n" +
+	" \x01(\tR\n" +
+	"detectedAt\x12\x1f\n" +
+	"\vresolved_at\x18\v \x01(\tR\n" +
+	"resolvedAt\"\\\n" +
+	"\x18ReconciliationIssueCount\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"\x8f\x01\n" +
+	"\vProductRate\x12&\n" +
+	"\x0fmax_term_months\x18\x01 \x01(\x05R\rmaxTermMonths\x127\n" +
+	"\x18min_down_payment_percent\x18\x02 \x01(\x01R\x15minDownPaymentPercent\x12\x1f\n" +
+	"\vmargin_rate\x18\x03 \x01(\x01R\n" +
+	"marginRate\"\xf7\x03\n" +
+	"\vLoanProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12)\n" +
+	"\x10application_type\x18\x04 \x01(\tR\x0fapplicationType\x12#\n" +
+	"\rcurrency_code\x18\x05 \x01(\tR\fcurrencyCode\x12)\n" +
+	"\x10repayment_method\x18\x06 \x01(\tR\x0frepaymentMethod\x12\x1f\n" +
+	"\vterm_months\x18\a \x03(\x05R\n" +
+	"termMonths\x12,\n" +
+	"\n" +
+	"min_amount\x18\b \x01(\v2\r.loanpb.MoneyR\tminAmount\x12,\n" +
+	"\n" +
+	"max_amount\x18\t \x01(\v2\r.loanpb.MoneyR\tmaxAmount\x127\n" +
+	"\x18min_down_payment_percent\x18\n" +
+	" \x01(\x01R\x15minDownPaymentPercent\x12)\n" +
+	"\x05rates\x18\v \x03(\v2\x13.loanpb.ProductRateR\x05rates\x12\x16\n" +
+	"\x06active\x18\f \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\tR\tupdatedAt\"7\n" +
 	"\vPageRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x89\x01\n" +
@@ -1457,23 +6161,30 @@ const file_internal_proto_loan_loan_service_proto_rawDesc = "" +
 	"\vtotal_items\x18\x03 \x01(\x05R\n" +
 	"totalItems\x12\x1f\n" +
 	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
-	"totalPages\"\xf1\x02\n" +
+	"totalPages\"\xba\x05\n" +
 	"\x18CreateApplicationRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1f\n" +
 	"\vvehicle_vin\x18\x03 \x01(\tR\n" +
 	"vehicleVin\x12!\n" +
 	"\fvehicle_name\x18\x04 \x01(\tR\vvehicleName\x12#\n" +
-	"\rcurrency_code\x18\x05 \x01(\tR\fcurrencyCode\x12\x14\n" +
-	"\x05price\x18\x06 \x01(\x03R\x05price\x12!\n" +
-	"\fdown_payment\x18\a \x01(\x03R\vdownPayment\x12\x1f\n" +
+	"\rcurrency_code\x18\x05 \x01(\tR\fcurrencyCode\x12#\n" +
+	"\x05price\x18\x0e \x01(\v2\r.loanpb.MoneyR\x05price\x120\n" +
+	"\fdown_payment\x18\x0f \x01(\v2\r.loanpb.MoneyR\vdownPayment\x12\x1f\n" +
 	"\vterm_months\x18\b \x01(\x05R\n" +
 	"termMonths\x12\x1f\n" +
 	"\vmargin_rate\x18\t \x01(\x01R\n" +
-	"marginRate\x12\x1b\n" +
-	"\tnet_price\x18\n" +
-	" \x01(\x03R\bnetPrice\x12'\n" +
-	"\x0fmonthly_payment\x18\v \x01(\x03R\x0emonthlyPayment\"\x9e\x01\n" +
+	"marginRate\x12*\n" +
+	"\tnet_price\x18\x10 \x01(\v2\r.loanpb.MoneyR\bnetPrice\x126\n" +
+	"\x0fmonthly_payment\x18\x11 \x01(\v2\r.loanpb.MoneyR\x0emonthlyPayment\x12)\n" +
+	"\x10repayment_method\x18\f \x01(\tR\x0frepaymentMethod\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\r \x01(\tR\tproductId\x12%\n" +
+	"\flegacy_price\x18\x06 \x01(\x03B\x02\x18\x01R\vlegacyPrice\x122\n" +
+	"\x13legacy_down_payment\x18\a \x01(\x03B\x02\x18\x01R\x11legacyDownPayment\x12,\n" +
+	"\x10legacy_net_price\x18\n" +
+	" \x01(\x03B\x02\x18\x01R\x0elegacyNetPrice\x128\n" +
+	"\x16legacy_monthly_payment\x18\v \x01(\x03B\x02\x18\x01R\x14legacyMonthlyPayment\"\x9e\x01\n" +
 	"\x19CreateApplicationResponse\x129\n" +
 	"\vapplication\x18\x01 \x01(\v2\x17.loanpb.LoanApplicationR\vapplication\x12F\n" +
 	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"'\n" +
@@ -1488,28 +6199,103 @@ const file_internal_proto_loan_loan_service_proto_rawDesc = "" +
 	"\x18ListApplicationsResponse\x12;\n" +
 	"\fapplications\x18\x01 \x03(\v2\x17.loanpb.LoanApplicationR\fapplications\x12(\n" +
 	"\x04page\x18\x02 \x01(\v2\x14.loanpb.PageResponseR\x04page\x12F\n" +
-	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"\x15\n" +
-	"\x13ListVehiclesRequest\"\x8b\x01\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"X\n" +
+	"\x18ReviewApplicationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x9e\x01\n" +
+	"\x19ReviewApplicationResponse\x129\n" +
+	"\vapplication\x18\x01 \x01(\v2\x17.loanpb.LoanApplicationR\vapplication\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"Y\n" +
+	"\x19ApproveApplicationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x9f\x01\n" +
+	"\x1aApproveApplicationResponse\x129\n" +
+	"\vapplication\x18\x01 \x01(\v2\x17.loanpb.LoanApplicationR\vapplication\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"X\n" +
+	"\x18RejectApplicationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x9e\x01\n" +
+	"\x19RejectApplicationResponse\x129\n" +
+	"\vapplication\x18\x01 \x01(\v2\x17.loanpb.LoanApplicationR\vapplication\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"\x88\x02\n" +
+	"\x13ListVehiclesRequest\x12\x1f\n" +
+	"\vengine_type\x18\x01 \x01(\tR\n" +
+	"engineType\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\x12*\n" +
+	"\tmin_price\x18\x03 \x01(\v2\r.loanpb.MoneyR\bminPrice\x12*\n" +
+	"\tmax_price\x18\x04 \x01(\v2\r.loanpb.MoneyR\bmaxPrice\x12\x16\n" +
+	"\x06search\x18\x05 \x01(\tR\x06search\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\tR\x04sort\x12'\n" +
+	"\x04page\x18\a \x01(\v2\x13.loanpb.PageRequestR\x04page\"\xb5\x01\n" +
 	"\x14ListVehiclesResponse\x12+\n" +
-	"\bvehicles\x18\x01 \x03(\v2\x0f.loanpb.VehicleR\bvehicles\x12F\n" +
-	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"\xb2\x01\n" +
+	"\bvehicles\x18\x01 \x03(\v2\x0f.loanpb.VehicleR\bvehicles\x12(\n" +
+	"\x04page\x18\x02 \x01(\v2\x14.loanpb.PageResponseR\x04page\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"%\n" +
+	"\x11GetVehicleRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\"\x87\x01\n" +
+	"\x12GetVehicleResponse\x12)\n" +
+	"\avehicle\x18\x01 \x01(\v2\x0f.loanpb.VehicleR\avehicle\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"\xb4\x03\n" +
 	"\x10CalculateRequest\x12#\n" +
-	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12\x14\n" +
-	"\x05price\x18\x02 \x01(\x03R\x05price\x12!\n" +
-	"\fdown_payment\x18\x03 \x01(\x03R\vdownPayment\x12\x1f\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12#\n" +
+	"\x05price\x18\t \x01(\v2\r.loanpb.MoneyR\x05price\x120\n" +
+	"\fdown_payment\x18\n" +
+	" \x01(\v2\r.loanpb.MoneyR\vdownPayment\x12\x1f\n" +
 	"\vterm_months\x18\x04 \x01(\x05R\n" +
 	"termMonths\x12\x1f\n" +
 	"\vmargin_rate\x18\x05 \x01(\x01R\n" +
-	"marginRate\"\xc4\x01\n" +
-	"\x11CalculateResponse\x12\x1b\n" +
-	"\tnet_price\x18\x01 \x01(\x03R\bnetPrice\x12'\n" +
-	"\x0fmonthly_payment\x18\x02 \x01(\x03R\x0emonthlyPayment\x12!\n" +
-	"\ftotal_amount\x18\x03 \x01(\x03R\vtotalAmount\x12F\n" +
+	"marginRate\x12)\n" +
+	"\x10include_schedule\x18\x06 \x01(\bR\x0fincludeSchedule\x12)\n" +
+	"\x10repayment_method\x18\a \x01(\tR\x0frepaymentMethod\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\b \x01(\tR\tproductId\x12\x12\n" +
+	"\x04type\x18\v \x01(\tR\x04type\x12%\n" +
+	"\flegacy_price\x18\x02 \x01(\x03B\x02\x18\x01R\vlegacyPrice\x122\n" +
+	"\x13legacy_down_payment\x18\x03 \x01(\x03B\x02\x18\x01R\x11legacyDownPayment\"\xfc\x04\n" +
+	"\x11CalculateResponse\x12*\n" +
+	"\tnet_price\x18\t \x01(\v2\r.loanpb.MoneyR\bnetPrice\x126\n" +
+	"\x0fmonthly_payment\x18\n" +
+	" \x01(\v2\r.loanpb.MoneyR\x0emonthlyPayment\x120\n" +
+	"\ftotal_amount\x18\v \x01(\v2\r.loanpb.MoneyR\vtotalAmount\x128\n" +
+	"\bschedule\x18\x04 \x03(\v2\x1c.loanpb.RepaymentInstallmentR\bschedule\x12#\n" +
+	"\x05price\x18\x05 \x01(\v2\r.loanpb.MoneyR\x05price\x12#\n" +
+	"\rexchange_rate\x18\x06 \x01(\x01R\fexchangeRate\x12\x1f\n" +
+	"\vmargin_rate\x18\a \x01(\x01R\n" +
+	"marginRate\x12)\n" +
+	"\x10repayment_method\x18\b \x01(\tR\x0frepaymentMethod\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\f \x01(\tR\tproductId\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\x12,\n" +
+	"\x10legacy_net_price\x18\x01 \x01(\x03B\x02\x18\x01R\x0elegacyNetPrice\x128\n" +
+	"\x16legacy_monthly_payment\x18\x02 \x01(\x03B\x02\x18\x01R\x14legacyMonthlyPayment\x122\n" +
+	"\x13legacy_total_amount\x18\x03 \x01(\x03B\x02\x18\x01R\x11legacyTotalAmount\"\xb9\x01\n" +
+	"\x13ListProductsRequest\x12)\n" +
+	"\x10application_type\x18\x01 \x01(\tR\x0fapplicationType\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\x12)\n" +
+	"\x10include_inactive\x18\x03 \x01(\bR\x0fincludeInactive\x12'\n" +
+	"\x04page\x18\x04 \x01(\v2\x13.loanpb.PageRequestR\x04page\"\xb9\x01\n" +
+	"\x14ListProductsResponse\x12/\n" +
+	"\bproducts\x18\x01 \x03(\v2\x13.loanpb.LoanProductR\bproducts\x12(\n" +
+	"\x04page\x18\x02 \x01(\v2\x14.loanpb.PageResponseR\x04page\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"#\n" +
+	"\x11GetProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8b\x01\n" +
+	"\x12GetProductResponse\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.loanpb.LoanProductR\aproduct\x12F\n" +
 	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\" \n" +
 	"\x0eGetLoanRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"{\n" +
 	"\x0fGetLoanResponse\x12 \n" +
 	"\x04loan\x18\x01 \x01(\v2\f.loanpb.LoanR\x04loan\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"P\n" +
+	"\x11CreateLoanRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\"~\n" +
+	"\x12CreateLoanResponse\x12 \n" +
+	"\x04loan\x18\x01 \x01(\v2\f.loanpb.LoanR\x04loan\x12F\n" +
 	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"T\n" +
 	"\x10ListLoansRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
@@ -1517,110 +6303,477 @@ const file_internal_proto_loan_loan_service_proto_rawDesc = "" +
 	"\x11ListLoansResponse\x12\"\n" +
 	"\x05loans\x18\x01 \x03(\v2\f.loanpb.LoanR\x05loans\x12(\n" +
 	"\x04page\x18\x02 \x01(\v2\x14.loanpb.PageResponseR\x04page\x12F\n" +
-	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError2\x9b\x04\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"1\n" +
+	"\x16GetLoanContractRequest\x12\x17\n" +
+	"\aloan_id\x18\x01 \x01(\tR\x06loanId\"\x96\x01\n" +
+	"\x17GetLoanContractResponse\x123\n" +
+	"\bcontract\x18\x01 \x01(\v2\x17.loanpb.LeasingContractR\bcontract\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"6\n" +
+	"\x1bGetRepaymentScheduleRequest\x12\x17\n" +
+	"\aloan_id\x18\x01 \x01(\tR\x06loanId\"\xa0\x01\n" +
+	"\x1cGetRepaymentScheduleResponse\x128\n" +
+	"\bschedule\x18\x01 \x03(\v2\x1c.loanpb.RepaymentInstallmentR\bschedule\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"2\n" +
+	"\x17ListInstallmentsRequest\x12\x17\n" +
+	"\aloan_id\x18\x01 \x01(\tR\x06loanId\"\x9b\x01\n" +
+	"\x18ListInstallmentsResponse\x127\n" +
+	"\finstallments\x18\x01 \x03(\v2\x13.loanpb.InstallmentR\finstallments\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"\x86\x02\n" +
+	"\x14RecordPaymentRequest\x12\x17\n" +
+	"\aloan_id\x18\x01 \x01(\tR\x06loanId\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\x12%\n" +
+	"\x06amount\x18\a \x01(\v2\r.loanpb.MoneyR\x06amount\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12%\n" +
+	"\x0etransaction_id\x18\x05 \x01(\tR\rtransactionId\x12!\n" +
+	"\fpayment_date\x18\x06 \x01(\tR\vpaymentDate\x12'\n" +
+	"\rlegacy_amount\x18\x03 \x01(\x03B\x02\x18\x01R\flegacyAmount\"\xac\x01\n" +
+	"\x15RecordPaymentResponse\x12)\n" +
+	"\apayment\x18\x01 \x01(\v2\x0f.loanpb.PaymentR\apayment\x12 \n" +
+	"\x04loan\x18\x02 \x01(\v2\f.loanpb.LoanR\x04loan\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"\xa7\x01\n" +
+	"!InitiateInstallmentPaymentRequest\x12\x17\n" +
+	"\aloan_id\x18\x01 \x01(\tR\x06loanId\x12%\n" +
+	"\x06amount\x18\x02 \x01(\v2\r.loanpb.MoneyR\x06amount\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12*\n" +
+	"\x11payment_source_id\x18\x04 \x01(\tR\x0fpaymentSourceId\"\x97\x01\n" +
+	"\"InitiateInstallmentPaymentResponse\x12)\n" +
+	"\apayment\x18\x01 \x01(\v2\x0f.loanpb.PaymentR\apayment\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"#\n" +
+	"\x11GetPaymentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x87\x01\n" +
+	"\x12GetPaymentResponse\x12)\n" +
+	"\apayment\x18\x01 \x01(\v2\x0f.loanpb.PaymentR\apayment\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"W\n" +
+	"\x13ListPaymentsRequest\x12\x17\n" +
+	"\aloan_id\x18\x01 \x01(\tR\x06loanId\x12'\n" +
+	"\x04page\x18\x02 \x01(\v2\x13.loanpb.PageRequestR\x04page\"\xb5\x01\n" +
+	"\x14ListPaymentsResponse\x12+\n" +
+	"\bpayments\x18\x01 \x03(\v2\x0f.loanpb.PaymentR\bpayments\x12(\n" +
+	"\x04page\x18\x02 \x01(\v2\x14.loanpb.PageResponseR\x04page\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"\x95\x01\n" +
+	"\x16QuotePrepaymentRequest\x12\x17\n" +
+	"\aloan_id\x18\x01 \x01(\tR\x06loanId\x12%\n" +
+	"\x06amount\x18\x04 \x01(\v2\r.loanpb.MoneyR\x06amount\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12'\n" +
+	"\rlegacy_amount\x18\x02 \x01(\x03B\x02\x18\x01R\flegacyAmount\"\x90\x01\n" +
+	"\x17QuotePrepaymentResponse\x12-\n" +
+	"\x05quote\x18\x01 \x01(\v2\x17.loanpb.PrepaymentQuoteR\x05quote\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"\x9c\x02\n" +
+	"\x16ApplyPrepaymentRequest\x12\x17\n" +
+	"\aloan_id\x18\x01 \x01(\tR\x06loanId\x12%\n" +
+	"\x06amount\x18\b \x01(\v2\r.loanpb.MoneyR\x06amount\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12#\n" +
+	"\rcurrency_code\x18\x04 \x01(\tR\fcurrencyCode\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12%\n" +
+	"\x0etransaction_id\x18\x06 \x01(\tR\rtransactionId\x12!\n" +
+	"\fpayment_date\x18\a \x01(\tR\vpaymentDate\x12'\n" +
+	"\rlegacy_amount\x18\x02 \x01(\x03B\x02\x18\x01R\flegacyAmount\"\xdd\x01\n" +
+	"\x17ApplyPrepaymentResponse\x12-\n" +
+	"\x05quote\x18\x01 \x01(\v2\x17.loanpb.PrepaymentQuoteR\x05quote\x12 \n" +
+	"\x04loan\x18\x02 \x01(\v2\f.loanpb.LoanR\x04loan\x12)\n" +
+	"\apayment\x18\x03 \x01(\v2\x0f.loanpb.PaymentR\apayment\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"0\n" +
+	"\x15GetPayoffQuoteRequest\x12\x17\n" +
+	"\aloan_id\x18\x01 \x01(\tR\x06loanId\"\x8b\x01\n" +
+	"\x16GetPayoffQuoteResponse\x12)\n" +
+	"\x05quote\x18\x01 \x01(\v2\x13.loanpb.PayoffQuoteR\x05quote\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"\xb5\x01\n" +
+	"\x11SettleLoanRequest\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12%\n" +
+	"\x0etransaction_id\x18\x04 \x01(\tR\rtransactionId\x12!\n" +
+	"\fpayment_date\x18\x05 \x01(\tR\vpaymentDate\"\xd4\x01\n" +
+	"\x12SettleLoanResponse\x12)\n" +
+	"\x05quote\x18\x01 \x01(\v2\x13.loanpb.PayoffQuoteR\x05quote\x12 \n" +
+	"\x04loan\x18\x02 \x01(\v2\f.loanpb.LoanR\x04loan\x12)\n" +
+	"\apayment\x18\x03 \x01(\v2\x0f.loanpb.PaymentR\apayment\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"b\n" +
+	"\x1fGetReconciliationSummaryRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12'\n" +
+	"\x04page\x18\x02 \x01(\v2\x13.loanpb.PageRequestR\x04page\"\xb9\x02\n" +
+	" GetReconciliationSummaryResponse\x124\n" +
+	"\blast_run\x18\x01 \x01(\v2\x19.loanpb.ReconciliationRunR\alastRun\x128\n" +
+	"\x06counts\x18\x02 \x03(\v2 .loanpb.ReconciliationIssueCountR\x06counts\x123\n" +
+	"\x06issues\x18\x03 \x03(\v2\x1b.loanpb.ReconciliationIssueR\x06issues\x12(\n" +
+	"\x04page\x18\x04 \x01(\v2\x14.loanpb.PageResponseR\x04page\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"a\n" +
+	"\x1eListDealerNotificationsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12'\n" +
+	"\x04page\x18\x02 \x01(\v2\x13.loanpb.PageRequestR\x04page\"\xd0\x01\n" +
+	"\x1fListDealerNotificationsResponse\x12;\n" +
+	"\fapplications\x18\x01 \x03(\v2\x17.loanpb.LoanApplicationR\fapplications\x12(\n" +
+	"\x04page\x18\x02 \x01(\v2\x14.loanpb.PageResponseR\x04page\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"E\n" +
+	"\x14CreateProductRequest\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.loanpb.LoanProductR\aproduct\"\x8e\x01\n" +
+	"\x15CreateProductResponse\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.loanpb.LoanProductR\aproduct\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"E\n" +
+	"\x14UpdateProductRequest\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.loanpb.LoanProductR\aproduct\"\x8e\x01\n" +
+	"\x15UpdateProductResponse\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.loanpb.LoanProductR\aproduct\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError\"&\n" +
+	"\x14DeleteProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"_\n" +
+	"\x15DeleteProductResponse\x12F\n" +
+	"\x12loan_service_error\x18d \x01(\v2\x18.loanpb.LoanServiceErrorR\x10loanServiceError2\xaa\x13\n" +
 	"\fLoansService\x12X\n" +
 	"\x11CreateApplication\x12 .loanpb.CreateApplicationRequest\x1a!.loanpb.CreateApplicationResponse\x12O\n" +
 	"\x0eGetApplication\x12\x1d.loanpb.GetApplicationRequest\x1a\x1e.loanpb.GetApplicationResponse\x12U\n" +
-	"\x10ListApplications\x12\x1f.loanpb.ListApplicationsRequest\x1a .loanpb.ListApplicationsResponse\x12I\n" +
-	"\fListVehicles\x12\x1b.loanpb.ListVehiclesRequest\x1a\x1c.loanpb.ListVehiclesResponse\x12@\n" +
-	"\tCalculate\x12\x18.loanpb.CalculateRequest\x1a\x19.loanpb.CalculateResponse\x12:\n" +
+	"\x10ListApplications\x12\x1f.loanpb.ListApplicationsRequest\x1a .loanpb.ListApplicationsResponse\x12X\n" +
+	"\x11ReviewApplication\x12 .loanpb.ReviewApplicationRequest\x1a!.loanpb.ReviewApplicationResponse\x12[\n" +
+	"\x12ApproveApplication\x12!.loanpb.ApproveApplicationRequest\x1a\".loanpb.ApproveApplicationResponse\x12X\n" +
+	"\x11RejectApplication\x12 .loanpb.RejectApplicationRequest\x1a!.loanpb.RejectApplicationResponse\x12I\n" +
+	"\fListVehicles\x12\x1b.loanpb.ListVehiclesRequest\x1a\x1c.loanpb.ListVehiclesResponse\x12C\n" +
+	"\n" +
+	"GetVehicle\x12\x19.loanpb.GetVehicleRequest\x1a\x1a.loanpb.GetVehicleResponse\x12@\n" +
+	"\tCalculate\x12\x18.loanpb.CalculateRequest\x1a\x19.loanpb.CalculateResponse\x12I\n" +
+	"\fListProducts\x12\x1b.loanpb.ListProductsRequest\x1a\x1c.loanpb.ListProductsResponse\x12C\n" +
+	"\n" +
+	"GetProduct\x12\x19.loanpb.GetProductRequest\x1a\x1a.loanpb.GetProductResponse\x12C\n" +
+	"\n" +
+	"CreateLoan\x12\x19.loanpb.CreateLoanRequest\x1a\x1a.loanpb.CreateLoanResponse\x12:\n" +
 	"\aGetLoan\x12\x16.loanpb.GetLoanRequest\x1a\x17.loanpb.GetLoanResponse\x12@\n" +
-	"\tListLoans\x12\x18.loanpb.ListLoansRequest\x1a\x19.loanpb.ListLoansResponseB\x17Z\x15internal/proto/loanpbb\x06proto3"
+	"\tListLoans\x12\x18.loanpb.ListLoansRequest\x1a\x19.loanpb.ListLoansResponse\x12R\n" +
+	"\x0fGetLoanContract\x12\x1e.loanpb.GetLoanContractRequest\x1a\x1f.loanpb.GetLoanContractResponse\x12a\n" +
+	"\x14GetRepaymentSchedule\x12#.loanpb.GetRepaymentScheduleRequest\x1a$.loanpb.GetRepaymentScheduleResponse\x12U\n" +
+	"\x10ListInstallments\x12\x1f.loanpb.ListInstallmentsRequest\x1a .loanpb.ListInstallmentsResponse\x12L\n" +
+	"\rRecordPayment\x12\x1c.loanpb.RecordPaymentRequest\x1a\x1d.loanpb.RecordPaymentResponse\x12C\n" +
+	"\n" +
+	"GetPayment\x12\x19.loanpb.GetPaymentRequest\x1a\x1a.loanpb.GetPaymentResponse\x12I\n" +
+	"\fListPayments\x12\x1b.loanpb.ListPaymentsRequest\x1a\x1c.loanpb.ListPaymentsResponse\x12s\n" +
+	"\x1aInitiateInstallmentPayment\x12).loanpb.InitiateInstallmentPaymentRequest\x1a*.loanpb.InitiateInstallmentPaymentResponse\x12R\n" +
+	"\x0fQuotePrepayment\x12\x1e.loanpb.QuotePrepaymentRequest\x1a\x1f.loanpb.QuotePrepaymentResponse\x12R\n" +
+	"\x0fApplyPrepayment\x12\x1e.loanpb.ApplyPrepaymentRequest\x1a\x1f.loanpb.ApplyPrepaymentResponse\x12O\n" +
+	"\x0eGetPayoffQuote\x12\x1d.loanpb.GetPayoffQuoteRequest\x1a\x1e.loanpb.GetPayoffQuoteResponse\x12C\n" +
+	"\n" +
+	"SettleLoan\x12\x19.loanpb.SettleLoanRequest\x1a\x1a.loanpb.SettleLoanResponse\x12m\n" +
+	"\x18GetReconciliationSummary\x12'.loanpb.GetReconciliationSummaryRequest\x1a(.loanpb.GetReconciliationSummaryResponse\x12j\n" +
+	"\x17ListDealerNotifications\x12&.loanpb.ListDealerNotificationsRequest\x1a'.loanpb.ListDealerNotificationsResponse\x12L\n" +
+	"\rCreateProduct\x12\x1c.loanpb.CreateProductRequest\x1a\x1d.loanpb.CreateProductResponse\x12L\n" +
+	"\rUpdateProduct\x12\x1c.loanpb.UpdateProductRequest\x1a\x1d.loanpb.UpdateProductResponse\x12L\n" +
+	"\rDeleteProduct\x12\x1c.loanpb.DeleteProductRequest\x1a\x1d.loanpb.DeleteProductResponseB\x17Z\x15internal/proto/loanpbb\x06proto3"
 
 var (
-	file_internal_proto_loan_loan_service_proto_rawDescOnce sync.Once
-	file_internal_proto_loan_loan_service_proto_rawDescData []byte
+	file_loan_service_proto_rawDescOnce sync.Once
+	file_loan_service_proto_rawDescData []byte
 )
 
-func file_internal_proto_loan_loan_service_proto_rawDescGZIP() []byte {
-	file_internal_proto_loan_loan_service_proto_rawDescOnce.Do(func() {
-		file_internal_proto_loan_loan_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_proto_loan_loan_service_proto_rawDesc), len(file_internal_proto_loan_loan_service_proto_rawDesc)))
+func file_loan_service_proto_rawDescGZIP() []byte {
+	file_loan_service_proto_rawDescOnce.Do(func() {
+		file_loan_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_loan_service_proto_rawDesc), len(file_loan_service_proto_rawDesc)))
 	})
-	return file_internal_proto_loan_loan_service_proto_rawDescData
-}
-
-var file_internal_proto_loan_loan_service_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
-var file_internal_proto_loan_loan_service_proto_goTypes = []any{
-	(*LoanServiceError)(nil),          // 0: loanpb.LoanServiceError
-	(*Vehicle)(nil),                   // 1: loanpb.Vehicle
-	(*LoanApplication)(nil),           // 2: loanpb.LoanApplication
-	(*Loan)(nil),                      // 3: loanpb.Loan
-	(*PageRequest)(nil),               // 4: loanpb.PageRequest
-	(*PageResponse)(nil),              // 5: loanpb.PageResponse
-	(*CreateApplicationRequest)(nil),  // 6: loanpb.CreateApplicationRequest
-	(*CreateApplicationResponse)(nil), // 7: loanpb.CreateApplicationResponse
-	(*GetApplicationRequest)(nil),     // 8: loanpb.GetApplicationRequest
-	(*GetApplicationResponse)(nil),    // 9: loanpb.GetApplicationResponse
-	(*ListApplicationsRequest)(nil),   // 10: loanpb.ListApplicationsRequest
-	(*ListApplicationsResponse)(nil),  // 11: loanpb.ListApplicationsResponse
-	(*ListVehiclesRequest)(nil),       // 12: loanpb.ListVehiclesRequest
-	(*ListVehiclesResponse)(nil),      // 13: loanpb.ListVehiclesResponse
-	(*CalculateRequest)(nil),          // 14: loanpb.CalculateRequest
-	(*CalculateResponse)(nil),         // 15: loanpb.CalculateResponse
-	(*GetLoanRequest)(nil),            // 16: loanpb.GetLoanRequest
-	(*GetLoanResponse)(nil),           // 17: loanpb.GetLoanResponse
-	(*ListLoansRequest)(nil),          // 18: loanpb.ListLoansRequest
-	(*ListLoansResponse)(nil),         // 19: loanpb.ListLoansResponse
-}
-var file_internal_proto_loan_loan_service_proto_depIdxs = []int32{
-	2,  // 0: loanpb.CreateApplicationResponse.application:type_name -> loanpb.LoanApplication
-	0,  // 1: loanpb.CreateApplicationResponse.loan_service_error:type_name -> loanpb.LoanServiceError
-	2,  // 2: loanpb.GetApplicationResponse.application:type_name -> loanpb.LoanApplication
-	0,  // 3: loanpb.GetApplicationResponse.loan_service_error:type_name -> loanpb.LoanServiceError
-	4,  // 4: loanpb.ListApplicationsRequest.page:type_name -> loanpb.PageRequest
-	2,  // 5: loanpb.ListApplicationsResponse.applications:type_name -> loanpb.LoanApplication
-	5,  // 6: loanpb.ListApplicationsResponse.page:type_name -> loanpb.PageResponse
-	0,  // 7: loanpb.ListApplicationsResponse.loan_service_error:type_name -> loanpb.LoanServiceError
-	1,  // 8: loanpb.ListVehiclesResponse.vehicles:type_name -> loanpb.Vehicle
-	0,  // 9: loanpb.ListVehiclesResponse.loan_service_error:type_name -> loanpb.LoanServiceError
-	0,  // 10: loanpb.CalculateResponse.loan_service_error:type_name -> loanpb.LoanServiceError
-	3,  // 11: loanpb.GetLoanResponse.loan:type_name -> loanpb.Loan
-	0,  // 12: loanpb.GetLoanResponse.loan_service_error:type_name -> loanpb.LoanServiceError
-	4,  // 13: loanpb.ListLoansRequest.page:type_name -> loanpb.PageRequest
-	3,  // 14: loanpb.ListLoansResponse.loans:type_name -> loanpb.Loan
-	5,  // 15: loanpb.ListLoansResponse.page:type_name -> loanpb.PageResponse
-	0,  // 16: loanpb.ListLoansResponse.loan_service_error:type_name -> loanpb.LoanServiceError
-	6,  // 17: loanpb.LoansService.CreateApplication:input_type -> loanpb.CreateApplicationRequest
-	8,  // 18: loanpb.LoansService.GetApplication:input_type -> loanpb.GetApplicationRequest
-	10, // 19: loanpb.LoansService.ListApplications:input_type -> loanpb.ListApplicationsRequest
-	12, // 20: loanpb.LoansService.ListVehicles:input_type -> loanpb.ListVehiclesRequest
-	14, // 21: loanpb.LoansService.Calculate:input_type -> loanpb.CalculateRequest
-	16, // 22: loanpb.LoansService.GetLoan:input_type -> loanpb.GetLoanRequest
-	18, // 23: loanpb.LoansService.ListLoans:input_type -> loanpb.ListLoansRequest
-	7,  // 24: loanpb.LoansService.CreateApplication:output_type -> loanpb.CreateApplicationResponse
-	9,  // 25: loanpb.LoansService.GetApplication:output_type -> loanpb.GetApplicationResponse
-	11, // 26: loanpb.LoansService.ListApplications:output_type -> loanpb.ListApplicationsResponse
-	13, // 27: loanpb.LoansService.ListVehicles:output_type -> loanpb.ListVehiclesResponse
-	15, // 28: loanpb.LoansService.Calculate:output_type -> loanpb.CalculateResponse
-	17, // 29: loanpb.LoansService.GetLoan:output_type -> loanpb.GetLoanResponse
-	19, // 30: loanpb.LoansService.ListLoans:output_type -> loanpb.ListLoansResponse
-	24, // [24:31] is the sub-list for method output_type
-	17, // [17:24] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
-}
-
-func init() { file_internal_proto_loan_loan_service_proto_init() }
-func file_internal_proto_loan_loan_service_proto_init() {
-	if File_internal_proto_loan_loan_service_proto != nil {
+	return file_loan_service_proto_rawDescData
+}
+
+var file_loan_service_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
+var file_loan_service_proto_goTypes = []any{
+	(*LoanServiceError)(nil),                   // 0: loanpb.LoanServiceError
+	(*FieldError)(nil),                         // 1: loanpb.FieldError
+	(*Money)(nil),                              // 2: loanpb.Money
+	(*Vehicle)(nil),                            // 3: loanpb.Vehicle
+	(*LoanApplication)(nil),                    // 4: loanpb.LoanApplication
+	(*Loan)(nil),                               // 5: loanpb.Loan
+	(*LeasingContract)(nil),                    // 6: loanpb.LeasingContract
+	(*Payment)(nil),                            // 7: loanpb.Payment
+	(*RepaymentInstallment)(nil),               // 8: loanpb.RepaymentInstallment
+	(*Installment)(nil),                        // 9: loanpb.Installment
+	(*PayoffQuote)(nil),                        // 10: loanpb.PayoffQuote
+	(*PrepaymentQuote)(nil),                    // 11: loanpb.PrepaymentQuote
+	(*ReconciliationRun)(nil),                  // 12: loanpb.ReconciliationRun
+	(*ReconciliationIssue)(nil),                // 13: loanpb.ReconciliationIssue
+	(*ReconciliationIssueCount)(nil),           // 14: loanpb.ReconciliationIssueCount
+	(*ProductRate)(nil),                        // 15: loanpb.ProductRate
+	(*LoanProduct)(nil),                        // 16: loanpb.LoanProduct
+	(*PageRequest)(nil),                        // 17: loanpb.PageRequest
+	(*PageResponse)(nil),                       // 18: loanpb.PageResponse
+	(*CreateApplicationRequest)(nil),           // 19: loanpb.CreateApplicationRequest
+	(*CreateApplicationResponse)(nil),          // 20: loanpb.CreateApplicationResponse
+	(*GetApplicationRequest)(nil),              // 21: loanpb.GetApplicationRequest
+	(*GetApplicationResponse)(nil),             // 22: loanpb.GetApplicationResponse
+	(*ListApplicationsRequest)(nil),            // 23: loanpb.ListApplicationsRequest
+	(*ListApplicationsResponse)(nil),           // 24: loanpb.ListApplicationsResponse
+	(*ReviewApplicationRequest)(nil),           // 25: loanpb.ReviewApplicationRequest
+	(*ReviewApplicationResponse)(nil),          // 26: loanpb.ReviewApplicationResponse
+	(*ApproveApplicationRequest)(nil),          // 27: loanpb.ApproveApplicationRequest
+	(*ApproveApplicationResponse)(nil),         // 28: loanpb.ApproveApplicationResponse
+	(*RejectApplicationRequest)(nil),           // 29: loanpb.RejectApplicationRequest
+	(*RejectApplicationResponse)(nil),          // 30: loanpb.RejectApplicationResponse
+	(*ListVehiclesRequest)(nil),                // 31: loanpb.ListVehiclesRequest
+	(*ListVehiclesResponse)(nil),               // 32: loanpb.ListVehiclesResponse
+	(*GetVehicleRequest)(nil),                  // 33: loanpb.GetVehicleRequest
+	(*GetVehicleResponse)(nil),                 // 34: loanpb.GetVehicleResponse
+	(*CalculateRequest)(nil),                   // 35: loanpb.CalculateRequest
+	(*CalculateResponse)(nil),                  // 36: loanpb.CalculateResponse
+	(*ListProductsRequest)(nil),                // 37: loanpb.ListProductsRequest
+	(*ListProductsResponse)(nil),               // 38: loanpb.ListProductsResponse
+	(*GetProductRequest)(nil),                  // 39: loanpb.GetProductRequest
+	(*GetProductResponse)(nil),                 // 40: loanpb.GetProductResponse
+	(*GetLoanRequest)(nil),                     // 41: loanpb.GetLoanRequest
+	(*GetLoanResponse)(nil),                    // 42: loanpb.GetLoanResponse
+	(*CreateLoanRequest)(nil),                  // 43: loanpb.CreateLoanRequest
+	(*CreateLoanResponse)(nil),                 // 44: loanpb.CreateLoanResponse
+	(*ListLoansRequest)(nil),                   // 45: loanpb.ListLoansRequest
+	(*ListLoansResponse)(nil),                  // 46: loanpb.ListLoansResponse
+	(*GetLoanContractRequest)(nil),             // 47: loanpb.GetLoanContractRequest
+	(*GetLoanContractResponse)(nil),            // 48: loanpb.GetLoanContractResponse
+	(*GetRepaymentScheduleRequest)(nil),        // 49: loanpb.GetRepaymentScheduleRequest
+	(*GetRepaymentScheduleResponse)(nil),       // 50: loanpb.GetRepaymentScheduleResponse
+	(*ListInstallmentsRequest)(nil),            // 51: loanpb.ListInstallmentsRequest
+	(*ListInstallmentsResponse)(nil),           // 52: loanpb.ListInstallmentsResponse
+	(*RecordPaymentRequest)(nil),               // 53: loanpb.RecordPaymentRequest
+	(*RecordPaymentResponse)(nil),              // 54: loanpb.RecordPaymentResponse
+	(*InitiateInstallmentPaymentRequest)(nil),  // 55: loanpb.InitiateInstallmentPaymentRequest
+	(*InitiateInstallmentPaymentResponse)(nil), // 56: loanpb.InitiateInstallmentPaymentResponse
+	(*GetPaymentRequest)(nil),                  // 57: loanpb.GetPaymentRequest
+	(*GetPaymentResponse)(nil),                 // 58: loanpb.GetPaymentResponse
+	(*ListPaymentsRequest)(nil),                // 59: loanpb.ListPaymentsRequest
+	(*ListPaymentsResponse)(nil),               // 60: loanpb.ListPaymentsResponse
+	(*QuotePrepaymentRequest)(nil),             // 61: loanpb.QuotePrepaymentRequest
+	(*QuotePrepaymentResponse)(nil),            // 62: loanpb.QuotePrepaymentResponse
+	(*ApplyPrepaymentRequest)(nil),             // 63: loanpb.ApplyPrepaymentRequest
+	(*ApplyPrepaymentResponse)(nil),            // 64: loanpb.ApplyPrepaymentResponse
+	(*GetPayoffQuoteRequest)(nil),              // 65: loanpb.GetPayoffQuoteRequest
+	(*GetPayoffQuoteResponse)(nil),             // 66: loanpb.GetPayoffQuoteResponse
+	(*SettleLoanRequest)(nil),                  // 67: loanpb.SettleLoanRequest
+	(*SettleLoanResponse)(nil),                 // 68: loanpb.SettleLoanResponse
+	(*GetReconciliationSummaryRequest)(nil),    // 69: loanpb.GetReconciliationSummaryRequest
+	(*GetReconciliationSummaryResponse)(nil),   // 70: loanpb.GetReconciliationSummaryResponse
+	(*ListDealerNotificationsRequest)(nil),     // 71: loanpb.ListDealerNotificationsRequest
+	(*ListDealerNotificationsResponse)(nil),    // 72: loanpb.ListDealerNotificationsResponse
+	(*CreateProductRequest)(nil),               // 73: loanpb.CreateProductRequest
+	(*CreateProductResponse)(nil),              // 74: loanpb.CreateProductResponse
+	(*UpdateProductRequest)(nil),               // 75: loanpb.UpdateProductRequest
+	(*UpdateProductResponse)(nil),              // 76: loanpb.UpdateProductResponse
+	(*DeleteProductRequest)(nil),               // 77: loanpb.DeleteProductRequest
+	(*DeleteProductResponse)(nil),              // 78: loanpb.DeleteProductResponse
+}
+var file_loan_service_proto_depIdxs = []int32{
+	1,   // 0: loanpb.LoanServiceError.field_errors:type_name -> loanpb.FieldError
+	2,   // 1: loanpb.Vehicle.price:type_name -> loanpb.Money
+	2,   // 2: loanpb.LoanApplication.price:type_name -> loanpb.Money
+	2,   // 3: loanpb.LoanApplication.down_payment:type_name -> loanpb.Money
+	2,   // 4: loanpb.LoanApplication.net_price:type_name -> loanpb.Money
+	2,   // 5: loanpb.LoanApplication.monthly_payment:type_name -> loanpb.Money
+	2,   // 6: loanpb.LoanApplication.vehicle_price:type_name -> loanpb.Money
+	2,   // 7: loanpb.Loan.amount:type_name -> loanpb.Money
+	2,   // 8: loanpb.Loan.monthly_payment:type_name -> loanpb.Money
+	2,   // 9: loanpb.Loan.remaining_balance:type_name -> loanpb.Money
+	2,   // 10: loanpb.Loan.charges_outstanding:type_name -> loanpb.Money
+	2,   // 11: loanpb.Loan.total_outstanding:type_name -> loanpb.Money
+	2,   // 12: loanpb.LeasingContract.remaining_balance:type_name -> loanpb.Money
+	2,   // 13: loanpb.Payment.amount:type_name -> loanpb.Money
+	2,   // 14: loanpb.RepaymentInstallment.payment:type_name -> loanpb.Money
+	2,   // 15: loanpb.RepaymentInstallment.principal:type_name -> loanpb.Money
+	2,   // 16: loanpb.RepaymentInstallment.margin:type_name -> loanpb.Money
+	2,   // 17: loanpb.RepaymentInstallment.outstanding_balance:type_name -> loanpb.Money
+	2,   // 18: loanpb.Installment.principal_due:type_name -> loanpb.Money
+	2,   // 19: loanpb.Installment.margin_due:type_name -> loanpb.Money
+	2,   // 20: loanpb.Installment.principal_paid:type_name -> loanpb.Money
+	2,   // 21: loanpb.Installment.margin_paid:type_name -> loanpb.Money
+	2,   // 22: loanpb.PayoffQuote.principal:type_name -> loanpb.Money
+	2,   // 23: loanpb.PayoffQuote.margin:type_name -> loanpb.Money
+	2,   // 24: loanpb.PayoffQuote.margin_waived:type_name -> loanpb.Money
+	2,   // 25: loanpb.PayoffQuote.charges:type_name -> loanpb.Money
+	2,   // 26: loanpb.PayoffQuote.settlement_fee:type_name -> loanpb.Money
+	2,   // 27: loanpb.PayoffQuote.total:type_name -> loanpb.Money
+	2,   // 28: loanpb.PrepaymentQuote.amount:type_name -> loanpb.Money
+	2,   // 29: loanpb.PrepaymentQuote.monthly_payment:type_name -> loanpb.Money
+	2,   // 30: loanpb.PrepaymentQuote.remaining_balance:type_name -> loanpb.Money
+	2,   // 31: loanpb.PrepaymentQuote.margin_saved:type_name -> loanpb.Money
+	8,   // 32: loanpb.PrepaymentQuote.schedule:type_name -> loanpb.RepaymentInstallment
+	2,   // 33: loanpb.ReconciliationIssue.local_balance:type_name -> loanpb.Money
+	2,   // 34: loanpb.ReconciliationIssue.remote_balance:type_name -> loanpb.Money
+	2,   // 35: loanpb.LoanProduct.min_amount:type_name -> loanpb.Money
+	2,   // 36: loanpb.LoanProduct.max_amount:type_name -> loanpb.Money
+	15,  // 37: loanpb.LoanProduct.rates:type_name -> loanpb.ProductRate
+	2,   // 38: loanpb.CreateApplicationRequest.price:type_name -> loanpb.Money
+	2,   // 39: loanpb.CreateApplicationRequest.down_payment:type_name -> loanpb.Money
+	2,   // 40: loanpb.CreateApplicationRequest.net_price:type_name -> loanpb.Money
+	2,   // 41: loanpb.CreateApplicationRequest.monthly_payment:type_name -> loanpb.Money
+	4,   // 42: loanpb.CreateApplicationResponse.application:type_name -> loanpb.LoanApplication
+	0,   // 43: loanpb.CreateApplicationResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	4,   // 44: loanpb.GetApplicationResponse.application:type_name -> loanpb.LoanApplication
+	0,   // 45: loanpb.GetApplicationResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	17,  // 46: loanpb.ListApplicationsRequest.page:type_name -> loanpb.PageRequest
+	4,   // 47: loanpb.ListApplicationsResponse.applications:type_name -> loanpb.LoanApplication
+	18,  // 48: loanpb.ListApplicationsResponse.page:type_name -> loanpb.PageResponse
+	0,   // 49: loanpb.ListApplicationsResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	4,   // 50: loanpb.ReviewApplicationResponse.application:type_name -> loanpb.LoanApplication
+	0,   // 51: loanpb.ReviewApplicationResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	4,   // 52: loanpb.ApproveApplicationResponse.application:type_name -> loanpb.LoanApplication
+	0,   // 53: loanpb.ApproveApplicationResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	4,   // 54: loanpb.RejectApplicationResponse.application:type_name -> loanpb.LoanApplication
+	0,   // 55: loanpb.RejectApplicationResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	2,   // 56: loanpb.ListVehiclesRequest.min_price:type_name -> loanpb.Money
+	2,   // 57: loanpb.ListVehiclesRequest.max_price:type_name -> loanpb.Money
+	17,  // 58: loanpb.ListVehiclesRequest.page:type_name -> loanpb.PageRequest
+	3,   // 59: loanpb.ListVehiclesResponse.vehicles:type_name -> loanpb.Vehicle
+	18,  // 60: loanpb.ListVehiclesResponse.page:type_name -> loanpb.PageResponse
+	0,   // 61: loanpb.ListVehiclesResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	3,   // 62: loanpb.GetVehicleResponse.vehicle:type_name -> loanpb.Vehicle
+	0,   // 63: loanpb.GetVehicleResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	2,   // 64: loanpb.CalculateRequest.price:type_name -> loanpb.Money
+	2,   // 65: loanpb.CalculateRequest.down_payment:type_name -> loanpb.Money
+	2,   // 66: loanpb.CalculateResponse.net_price:type_name -> loanpb.Money
+	2,   // 67: loanpb.CalculateResponse.monthly_payment:type_name -> loanpb.Money
+	2,   // 68: loanpb.CalculateResponse.total_amount:type_name -> loanpb.Money
+	8,   // 69: loanpb.CalculateResponse.schedule:type_name -> loanpb.RepaymentInstallment
+	2,   // 70: loanpb.CalculateResponse.price:type_name -> loanpb.Money
+	0,   // 71: loanpb.CalculateResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	17,  // 72: loanpb.ListProductsRequest.page:type_name -> loanpb.PageRequest
+	16,  // 73: loanpb.ListProductsResponse.products:type_name -> loanpb.LoanProduct
+	18,  // 74: loanpb.ListProductsResponse.page:type_name -> loanpb.PageResponse
+	0,   // 75: loanpb.ListProductsResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	16,  // 76: loanpb.GetProductResponse.product:type_name -> loanpb.LoanProduct
+	0,   // 77: loanpb.GetProductResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	5,   // 78: loanpb.GetLoanResponse.loan:type_name -> loanpb.Loan
+	0,   // 79: loanpb.GetLoanResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	5,   // 80: loanpb.CreateLoanResponse.loan:type_name -> loanpb.Loan
+	0,   // 81: loanpb.CreateLoanResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	17,  // 82: loanpb.ListLoansRequest.page:type_name -> loanpb.PageRequest
+	5,   // 83: loanpb.ListLoansResponse.loans:type_name -> loanpb.Loan
+	18,  // 84: loanpb.ListLoansResponse.page:type_name -> loanpb.PageResponse
+	0,   // 85: loanpb.ListLoansResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	6,   // 86: loanpb.GetLoanContractResponse.contract:type_name -> loanpb.LeasingContract
+	0,   // 87: loanpb.GetLoanContractResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	8,   // 88: loanpb.GetRepaymentScheduleResponse.schedule:type_name -> loanpb.RepaymentInstallment
+	0,   // 89: loanpb.GetRepaymentScheduleResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	9,   // 90: loanpb.ListInstallmentsResponse.installments:type_name -> loanpb.Installment
+	0,   // 91: loanpb.ListInstallmentsResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	2,   // 92: loanpb.RecordPaymentRequest.amount:type_name -> loanpb.Money
+	7,   // 93: loanpb.RecordPaymentResponse.payment:type_name -> loanpb.Payment
+	5,   // 94: loanpb.RecordPaymentResponse.loan:type_name -> loanpb.Loan
+	0,   // 95: loanpb.RecordPaymentResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	2,   // 96: loanpb.InitiateInstallmentPaymentRequest.amount:type_name -> loanpb.Money
+	7,   // 97: loanpb.InitiateInstallmentPaymentResponse.payment:type_name -> loanpb.Payment
+	0,   // 98: loanpb.InitiateInstallmentPaymentResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	7,   // 99: loanpb.GetPaymentResponse.payment:type_name -> loanpb.Payment
+	0,   // 100: loanpb.GetPaymentResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	17,  // 101: loanpb.ListPaymentsRequest.page:type_name -> loanpb.PageRequest
+	7,   // 102: loanpb.ListPaymentsResponse.payments:type_name -> loanpb.Payment
+	18,  // 103: loanpb.ListPaymentsResponse.page:type_name -> loanpb.PageResponse
+	0,   // 104: loanpb.ListPaymentsResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	2,   // 105: loanpb.QuotePrepaymentRequest.amount:type_name -> loanpb.Money
+	11,  // 106: loanpb.QuotePrepaymentResponse.quote:type_name -> loanpb.PrepaymentQuote
+	0,   // 107: loanpb.QuotePrepaymentResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	2,   // 108: loanpb.ApplyPrepaymentRequest.amount:type_name -> loanpb.Money
+	11,  // 109: loanpb.ApplyPrepaymentResponse.quote:type_name -> loanpb.PrepaymentQuote
+	5,   // 110: loanpb.ApplyPrepaymentResponse.loan:type_name -> loanpb.Loan
+	7,   // 111: loanpb.ApplyPrepaymentResponse.payment:type_name -> loanpb.Payment
+	0,   // 112: loanpb.ApplyPrepaymentResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	10,  // 113: loanpb.GetPayoffQuoteResponse.quote:type_name -> loanpb.PayoffQuote
+	0,   // 114: loanpb.GetPayoffQuoteResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	10,  // 115: loanpb.SettleLoanResponse.quote:type_name -> loanpb.PayoffQuote
+	5,   // 116: loanpb.SettleLoanResponse.loan:type_name -> loanpb.Loan
+	7,   // 117: loanpb.SettleLoanResponse.payment:type_name -> loanpb.Payment
+	0,   // 118: loanpb.SettleLoanResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	17,  // 119: loanpb.GetReconciliationSummaryRequest.page:type_name -> loanpb.PageRequest
+	12,  // 120: loanpb.GetReconciliationSummaryResponse.last_run:type_name -> loanpb.ReconciliationRun
+	14,  // 121: loanpb.GetReconciliationSummaryResponse.counts:type_name -> loanpb.ReconciliationIssueCount
+	13,  // 122: loanpb.GetReconciliationSummaryResponse.issues:type_name -> loanpb.ReconciliationIssue
+	18,  // 123: loanpb.GetReconciliationSummaryResponse.page:type_name -> loanpb.PageResponse
+	0,   // 124: loanpb.GetReconciliationSummaryResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	17,  // 125: loanpb.ListDealerNotificationsRequest.page:type_name -> loanpb.PageRequest
+	4,   // 126: loanpb.ListDealerNotificationsResponse.applications:type_name -> loanpb.LoanApplication
+	18,  // 127: loanpb.ListDealerNotificationsResponse.page:type_name -> loanpb.PageResponse
+	0,   // 128: loanpb.ListDealerNotificationsResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	16,  // 129: loanpb.CreateProductRequest.product:type_name -> loanpb.LoanProduct
+	16,  // 130: loanpb.CreateProductResponse.product:type_name -> loanpb.LoanProduct
+	0,   // 131: loanpb.CreateProductResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	16,  // 132: loanpb.UpdateProductRequest.product:type_name -> loanpb.LoanProduct
+	16,  // 133: loanpb.UpdateProductResponse.product:type_name -> loanpb.LoanProduct
+	0,   // 134: loanpb.UpdateProductResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	0,   // 135: loanpb.DeleteProductResponse.loan_service_error:type_name -> loanpb.LoanServiceError
+	19,  // 136: loanpb.LoansService.CreateApplication:input_type -> loanpb.CreateApplicationRequest
+	21,  // 137: loanpb.LoansService.GetApplication:input_type -> loanpb.GetApplicationRequest
+	23,  // 138: loanpb.LoansService.ListApplications:input_type -> loanpb.ListApplicationsRequest
+	25,  // 139: loanpb.LoansService.ReviewApplication:input_type -> loanpb.ReviewApplicationRequest
+	27,  // 140: loanpb.LoansService.ApproveApplication:input_type -> loanpb.ApproveApplicationRequest
+	29,  // 141: loanpb.LoansService.RejectApplication:input_type -> loanpb.RejectApplicationRequest
+	31,  // 142: loanpb.LoansService.ListVehicles:input_type -> loanpb.ListVehiclesRequest
+	33,  // 143: loanpb.LoansService.GetVehicle:input_type -> loanpb.GetVehicleRequest
+	35,  // 144: loanpb.LoansService.Calculate:input_type -> loanpb.CalculateRequest
+	37,  // 145: loanpb.LoansService.ListProducts:input_type -> loanpb.ListProductsRequest
+	39,  // 146: loanpb.LoansService.GetProduct:input_type -> loanpb.GetProductRequest
+	43,  // 147: loanpb.LoansService.CreateLoan:input_type -> loanpb.CreateLoanRequest
+	41,  // 148: loanpb.LoansService.GetLoan:input_type -> loanpb.GetLoanRequest
+	45,  // 149: loanpb.LoansService.ListLoans:input_type -> loanpb.ListLoansRequest
+	47,  // 150: loanpb.LoansService.GetLoanContract:input_type -> loanpb.GetLoanContractRequest
+	49,  // 151: loanpb.LoansService.GetRepaymentSchedule:input_type -> loanpb.GetRepaymentScheduleRequest
+	51,  // 152: loanpb.LoansService.ListInstallments:input_type -> loanpb.ListInstallmentsRequest
+	53,  // 153: loanpb.LoansService.RecordPayment:input_type -> loanpb.RecordPaymentRequest
+	57,  // 154: loanpb.LoansService.GetPayment:input_type -> loanpb.GetPaymentRequest
+	59,  // 155: loanpb.LoansService.ListPayments:input_type -> loanpb.ListPaymentsRequest
+	55,  // 156: loanpb.LoansService.InitiateInstallmentPayment:input_type -> loanpb.InitiateInstallmentPaymentRequest
+	61,  // 157: loanpb.LoansService.QuotePrepayment:input_type -> loanpb.QuotePrepaymentRequest
+	63,  // 158: loanpb.LoansService.ApplyPrepayment:input_type -> loanpb.ApplyPrepaymentRequest
+	65,  // 159: loanpb.LoansService.GetPayoffQuote:input_type -> loanpb.GetPayoffQuoteRequest
+	67,  // 160: loanpb.LoansService.SettleLoan:input_type -> loanpb.SettleLoanRequest
+	69,  // 161: loanpb.LoansService.GetReconciliationSummary:input_type -> loanpb.GetReconciliationSummaryRequest
+	71,  // 162: loanpb.LoansService.ListDealerNotifications:input_type -> loanpb.ListDealerNotificationsRequest
+	73,  // 163: loanpb.LoansService.CreateProduct:input_type -> loanpb.CreateProductRequest
+	75,  // 164: loanpb.LoansService.UpdateProduct:input_type -> loanpb.UpdateProductRequest
+	77,  // 165: loanpb.LoansService.DeleteProduct:input_type -> loanpb.DeleteProductRequest
+	20,  // 166: loanpb.LoansService.CreateApplication:output_type -> loanpb.CreateApplicationResponse
+	22,  // 167: loanpb.LoansService.GetApplication:output_type -> loanpb.GetApplicationResponse
+	24,  // 168: loanpb.LoansService.ListApplications:output_type -> loanpb.ListApplicationsResponse
+	26,  // 169: loanpb.LoansService.ReviewApplication:output_type -> loanpb.ReviewApplicationResponse
+	28,  // 170: loanpb.LoansService.ApproveApplication:output_type -> loanpb.ApproveApplicationResponse
+	30,  // 171: loanpb.LoansService.RejectApplication:output_type -> loanpb.RejectApplicationResponse
+	32,  // 172: loanpb.LoansService.ListVehicles:output_type -> loanpb.ListVehiclesResponse
+	34,  // 173: loanpb.LoansService.GetVehicle:output_type -> loanpb.GetVehicleResponse
+	36,  // 174: loanpb.LoansService.Calculate:output_type -> loanpb.CalculateResponse
+	38,  // 175: loanpb.LoansService.ListProducts:output_type -> loanpb.ListProductsResponse
+	40,  // 176: loanpb.LoansService.GetProduct:output_type -> loanpb.GetProductResponse
+	44,  // 177: loanpb.LoansService.CreateLoan:output_type -> loanpb.CreateLoanResponse
+	42,  // 178: loanpb.LoansService.GetLoan:output_type -> loanpb.GetLoanResponse
+	46,  // 179: loanpb.LoansService.ListLoans:output_type -> loanpb.ListLoansResponse
+	48,  // 180: loanpb.LoansService.GetLoanContract:output_type -> loanpb.GetLoanContractResponse
+	50,  // 181: loanpb.LoansService.GetRepaymentSchedule:output_type -> loanpb.GetRepaymentScheduleResponse
+	52,  // 182: loanpb.LoansService.ListInstallments:output_type -> loanpb.ListInstallmentsResponse
+	54,  // 183: loanpb.LoansService.RecordPayment:output_type -> loanpb.RecordPaymentResponse
+	58,  // 184: loanpb.LoansService.GetPayment:output_type -> loanpb.GetPaymentResponse
+	60,  // 185: loanpb.LoansService.ListPayments:output_type -> loanpb.ListPaymentsResponse
+	56,  // 186: loanpb.LoansService.InitiateInstallmentPayment:output_type -> loanpb.InitiateInstallmentPaymentResponse
+	62,  // 187: loanpb.LoansService.QuotePrepayment:output_type -> loanpb.QuotePrepaymentResponse
+	64,  // 188: loanpb.LoansService.ApplyPrepayment:output_type -> loanpb.ApplyPrepaymentResponse
+	66,  // 189: loanpb.LoansService.GetPayoffQuote:output_type -> loanpb.GetPayoffQuoteResponse
+	68,  // 190: loanpb.LoansService.SettleLoan:output_type -> loanpb.SettleLoanResponse
+	70,  // 191: loanpb.LoansService.GetReconciliationSummary:output_type -> loanpb.GetReconciliationSummaryResponse
+	72,  // 192: loanpb.LoansService.ListDealerNotifications:output_type -> loanpb.ListDealerNotificationsResponse
+	74,  // 193: loanpb.LoansService.CreateProduct:output_type -> loanpb.CreateProductResponse
+	76,  // 194: loanpb.LoansService.UpdateProduct:output_type -> loanpb.UpdateProductResponse
+	78,  // 195: loanpb.LoansService.DeleteProduct:output_type -> loanpb.DeleteProductResponse
+	166, // [166:196] is the sub-list for method output_type
+	136, // [136:166] is the sub-list for method input_type
+	136, // [136:136] is the sub-list for extension type_name
+	136, // [136:136] is the sub-list for extension extendee
+	0,   // [0:136] is the sub-list for field type_name
+}
+
+func init() { file_loan_service_proto_init() }
+func file_loan_service_proto_init() {
+	if File_loan_service_proto != nil {
 		return
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_proto_loan_loan_service_proto_rawDesc), len(file_internal_proto_loan_loan_service_proto_rawDesc)),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_loan_service_proto_rawDesc), len(file_loan_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
-		GoTypes:           file_internal_proto_loan_loan_service_proto_goTypes,
-		DependencyIndexes: file_internal_proto_loan_loan_service_proto_depIdxs,
-		MessageInfos:      file_internal_proto_loan_loan_service_proto_msgTypes,
+		GoTypes:           file_loan_service_proto_goTypes,
+		DependencyIndexes: file_loan_service_proto_depIdxs,
+		MessageInfos:      file_loan_service_proto_msgTypes,
 	}.Build()
-	File_internal_proto_loan_loan_service_proto = out.File
-	file_internal_proto_loan_loan_service_proto_goTypes = nil
-	file_internal_proto_loan_loan_service_proto_depIdxs = nil
+	File_loan_service_proto = out.File
+	file_loan_service_proto_goTypes = nil
+	file_loan_service_proto_depIdxs = nil
 }
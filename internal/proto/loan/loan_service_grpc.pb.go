@@ -2,7 +2,7 @@
 // versions:
 // - protoc-gen-go-grpc v1.5.1
 // - protoc             v3.21.12
-// source: internal/proto/loan/loan_service.proto
+// source: loan_service.proto
 
 package loanpb
 
@@ -19,13 +19,36 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LoansService_CreateApplication_FullMethodName = "/loanpb.LoansService/CreateApplication"
-	LoansService_GetApplication_FullMethodName    = "/loanpb.LoansService/GetApplication"
-	LoansService_ListApplications_FullMethodName  = "/loanpb.LoansService/ListApplications"
-	LoansService_ListVehicles_FullMethodName      = "/loanpb.LoansService/ListVehicles"
-	LoansService_Calculate_FullMethodName         = "/loanpb.LoansService/Calculate"
-	LoansService_GetLoan_FullMethodName           = "/loanpb.LoansService/GetLoan"
-	LoansService_ListLoans_FullMethodName         = "/loanpb.LoansService/ListLoans"
+	LoansService_CreateApplication_FullMethodName          = "/loanpb.LoansService/CreateApplication"
+	LoansService_GetApplication_FullMethodName             = "/loanpb.LoansService/GetApplication"
+	LoansService_ListApplications_FullMethodName           = "/loanpb.LoansService/ListApplications"
+	LoansService_ReviewApplication_FullMethodName          = "/loanpb.LoansService/ReviewApplication"
+	LoansService_ApproveApplication_FullMethodName         = "/loanpb.LoansService/ApproveApplication"
+	LoansService_RejectApplication_FullMethodName          = "/loanpb.LoansService/RejectApplication"
+	LoansService_ListVehicles_FullMethodName               = "/loanpb.LoansService/ListVehicles"
+	LoansService_GetVehicle_FullMethodName                 = "/loanpb.LoansService/GetVehicle"
+	LoansService_Calculate_FullMethodName                  = "/loanpb.LoansService/Calculate"
+	LoansService_ListProducts_FullMethodName               = "/loanpb.LoansService/ListProducts"
+	LoansService_GetProduct_FullMethodName                 = "/loanpb.LoansService/GetProduct"
+	LoansService_CreateLoan_FullMethodName                 = "/loanpb.LoansService/CreateLoan"
+	LoansService_GetLoan_FullMethodName                    = "/loanpb.LoansService/GetLoan"
+	LoansService_ListLoans_FullMethodName                  = "/loanpb.LoansService/ListLoans"
+	LoansService_GetLoanContract_FullMethodName            = "/loanpb.LoansService/GetLoanContract"
+	LoansService_GetRepaymentSchedule_FullMethodName       = "/loanpb.LoansService/GetRepaymentSchedule"
+	LoansService_ListInstallments_FullMethodName           = "/loanpb.LoansService/ListInstallments"
+	LoansService_RecordPayment_FullMethodName              = "/loanpb.LoansService/RecordPayment"
+	LoansService_GetPayment_FullMethodName                 = "/loanpb.LoansService/GetPayment"
+	LoansService_ListPayments_FullMethodName               = "/loanpb.LoansService/ListPayments"
+	LoansService_InitiateInstallmentPayment_FullMethodName = "/loanpb.LoansService/InitiateInstallmentPayment"
+	LoansService_QuotePrepayment_FullMethodName            = "/loanpb.LoansService/QuotePrepayment"
+	LoansService_ApplyPrepayment_FullMethodName            = "/loanpb.LoansService/ApplyPrepayment"
+	LoansService_GetPayoffQuote_FullMethodName             = "/loanpb.LoansService/GetPayoffQuote"
+	LoansService_SettleLoan_FullMethodName                 = "/loanpb.LoansService/SettleLoan"
+	LoansService_GetReconciliationSummary_FullMethodName   = "/loanpb.LoansService/GetReconciliationSummary"
+	LoansService_ListDealerNotifications_FullMethodName    = "/loanpb.LoansService/ListDealerNotifications"
+	LoansService_CreateProduct_FullMethodName              = "/loanpb.LoansService/CreateProduct"
+	LoansService_UpdateProduct_FullMethodName              = "/loanpb.LoansService/UpdateProduct"
+	LoansService_DeleteProduct_FullMethodName              = "/loanpb.LoansService/DeleteProduct"
 )
 
 // LoansServiceClient is the client API for LoansService service.
@@ -36,13 +59,39 @@ type LoansServiceClient interface {
 	CreateApplication(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*CreateApplicationResponse, error)
 	GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error)
 	ListApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error)
+	ReviewApplication(ctx context.Context, in *ReviewApplicationRequest, opts ...grpc.CallOption) (*ReviewApplicationResponse, error)
+	ApproveApplication(ctx context.Context, in *ApproveApplicationRequest, opts ...grpc.CallOption) (*ApproveApplicationResponse, error)
+	RejectApplication(ctx context.Context, in *RejectApplicationRequest, opts ...grpc.CallOption) (*RejectApplicationResponse, error)
 	// Vehicles
 	ListVehicles(ctx context.Context, in *ListVehiclesRequest, opts ...grpc.CallOption) (*ListVehiclesResponse, error)
+	GetVehicle(ctx context.Context, in *GetVehicleRequest, opts ...grpc.CallOption) (*GetVehicleResponse, error)
 	// Pricing calculator
 	Calculate(ctx context.Context, in *CalculateRequest, opts ...grpc.CallOption) (*CalculateResponse, error)
+	// Loan products
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error)
 	// Loans
+	CreateLoan(ctx context.Context, in *CreateLoanRequest, opts ...grpc.CallOption) (*CreateLoanResponse, error)
 	GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*GetLoanResponse, error)
 	ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error)
+	GetLoanContract(ctx context.Context, in *GetLoanContractRequest, opts ...grpc.CallOption) (*GetLoanContractResponse, error)
+	GetRepaymentSchedule(ctx context.Context, in *GetRepaymentScheduleRequest, opts ...grpc.CallOption) (*GetRepaymentScheduleResponse, error)
+	ListInstallments(ctx context.Context, in *ListInstallmentsRequest, opts ...grpc.CallOption) (*ListInstallmentsResponse, error)
+	// Payments
+	RecordPayment(ctx context.Context, in *RecordPaymentRequest, opts ...grpc.CallOption) (*RecordPaymentResponse, error)
+	GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*GetPaymentResponse, error)
+	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
+	InitiateInstallmentPayment(ctx context.Context, in *InitiateInstallmentPaymentRequest, opts ...grpc.CallOption) (*InitiateInstallmentPaymentResponse, error)
+	QuotePrepayment(ctx context.Context, in *QuotePrepaymentRequest, opts ...grpc.CallOption) (*QuotePrepaymentResponse, error)
+	ApplyPrepayment(ctx context.Context, in *ApplyPrepaymentRequest, opts ...grpc.CallOption) (*ApplyPrepaymentResponse, error)
+	GetPayoffQuote(ctx context.Context, in *GetPayoffQuoteRequest, opts ...grpc.CallOption) (*GetPayoffQuoteResponse, error)
+	SettleLoan(ctx context.Context, in *SettleLoanRequest, opts ...grpc.CallOption) (*SettleLoanResponse, error)
+	// Admin
+	GetReconciliationSummary(ctx context.Context, in *GetReconciliationSummaryRequest, opts ...grpc.CallOption) (*GetReconciliationSummaryResponse, error)
+	ListDealerNotifications(ctx context.Context, in *ListDealerNotificationsRequest, opts ...grpc.CallOption) (*ListDealerNotificationsResponse, error)
+	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error)
+	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error)
+	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
 }
 
 type loansServiceClient struct {
@@ -83,6 +132,36 @@ func (c *loansServiceClient) ListApplications(ctx context.Context, in *ListAppli
 	return out, nil
 }
 
+func (c *loansServiceClient) ReviewApplication(ctx context.Context, in *ReviewApplicationRequest, opts ...grpc.CallOption) (*ReviewApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewApplicationResponse)
+	err := c.cc.Invoke(ctx, LoansService_ReviewApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) ApproveApplication(ctx context.Context, in *ApproveApplicationRequest, opts ...grpc.CallOption) (*ApproveApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveApplicationResponse)
+	err := c.cc.Invoke(ctx, LoansService_ApproveApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) RejectApplication(ctx context.Context, in *RejectApplicationRequest, opts ...grpc.CallOption) (*RejectApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectApplicationResponse)
+	err := c.cc.Invoke(ctx, LoansService_RejectApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *loansServiceClient) ListVehicles(ctx context.Context, in *ListVehiclesRequest, opts ...grpc.CallOption) (*ListVehiclesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListVehiclesResponse)
@@ -93,6 +172,16 @@ func (c *loansServiceClient) ListVehicles(ctx context.Context, in *ListVehiclesR
 	return out, nil
 }
 
+func (c *loansServiceClient) GetVehicle(ctx context.Context, in *GetVehicleRequest, opts ...grpc.CallOption) (*GetVehicleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVehicleResponse)
+	err := c.cc.Invoke(ctx, LoansService_GetVehicle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *loansServiceClient) Calculate(ctx context.Context, in *CalculateRequest, opts ...grpc.CallOption) (*CalculateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CalculateResponse)
@@ -103,6 +192,36 @@ func (c *loansServiceClient) Calculate(ctx context.Context, in *CalculateRequest
 	return out, nil
 }
 
+func (c *loansServiceClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductsResponse)
+	err := c.cc.Invoke(ctx, LoansService_ListProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductResponse)
+	err := c.cc.Invoke(ctx, LoansService_GetProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) CreateLoan(ctx context.Context, in *CreateLoanRequest, opts ...grpc.CallOption) (*CreateLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLoanResponse)
+	err := c.cc.Invoke(ctx, LoansService_CreateLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *loansServiceClient) GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*GetLoanResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetLoanResponse)
@@ -123,6 +242,166 @@ func (c *loansServiceClient) ListLoans(ctx context.Context, in *ListLoansRequest
 	return out, nil
 }
 
+func (c *loansServiceClient) GetLoanContract(ctx context.Context, in *GetLoanContractRequest, opts ...grpc.CallOption) (*GetLoanContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLoanContractResponse)
+	err := c.cc.Invoke(ctx, LoansService_GetLoanContract_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) GetRepaymentSchedule(ctx context.Context, in *GetRepaymentScheduleRequest, opts ...grpc.CallOption) (*GetRepaymentScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRepaymentScheduleResponse)
+	err := c.cc.Invoke(ctx, LoansService_GetRepaymentSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) ListInstallments(ctx context.Context, in *ListInstallmentsRequest, opts ...grpc.CallOption) (*ListInstallmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInstallmentsResponse)
+	err := c.cc.Invoke(ctx, LoansService_ListInstallments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) RecordPayment(ctx context.Context, in *RecordPaymentRequest, opts ...grpc.CallOption) (*RecordPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordPaymentResponse)
+	err := c.cc.Invoke(ctx, LoansService_RecordPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*GetPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPaymentResponse)
+	err := c.cc.Invoke(ctx, LoansService_GetPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPaymentsResponse)
+	err := c.cc.Invoke(ctx, LoansService_ListPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) InitiateInstallmentPayment(ctx context.Context, in *InitiateInstallmentPaymentRequest, opts ...grpc.CallOption) (*InitiateInstallmentPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InitiateInstallmentPaymentResponse)
+	err := c.cc.Invoke(ctx, LoansService_InitiateInstallmentPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) QuotePrepayment(ctx context.Context, in *QuotePrepaymentRequest, opts ...grpc.CallOption) (*QuotePrepaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotePrepaymentResponse)
+	err := c.cc.Invoke(ctx, LoansService_QuotePrepayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) ApplyPrepayment(ctx context.Context, in *ApplyPrepaymentRequest, opts ...grpc.CallOption) (*ApplyPrepaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyPrepaymentResponse)
+	err := c.cc.Invoke(ctx, LoansService_ApplyPrepayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) GetPayoffQuote(ctx context.Context, in *GetPayoffQuoteRequest, opts ...grpc.CallOption) (*GetPayoffQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPayoffQuoteResponse)
+	err := c.cc.Invoke(ctx, LoansService_GetPayoffQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) SettleLoan(ctx context.Context, in *SettleLoanRequest, opts ...grpc.CallOption) (*SettleLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleLoanResponse)
+	err := c.cc.Invoke(ctx, LoansService_SettleLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) GetReconciliationSummary(ctx context.Context, in *GetReconciliationSummaryRequest, opts ...grpc.CallOption) (*GetReconciliationSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReconciliationSummaryResponse)
+	err := c.cc.Invoke(ctx, LoansService_GetReconciliationSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) ListDealerNotifications(ctx context.Context, in *ListDealerNotificationsRequest, opts ...grpc.CallOption) (*ListDealerNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDealerNotificationsResponse)
+	err := c.cc.Invoke(ctx, LoansService_ListDealerNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProductResponse)
+	err := c.cc.Invoke(ctx, LoansService_CreateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProductResponse)
+	err := c.cc.Invoke(ctx, LoansService_UpdateProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loansServiceClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductResponse)
+	err := c.cc.Invoke(ctx, LoansService_DeleteProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoansServiceServer is the server API for LoansService service.
 // All implementations must embed UnimplementedLoansServiceServer
 // for forward compatibility.
@@ -131,13 +410,39 @@ type LoansServiceServer interface {
 	CreateApplication(context.Context, *CreateApplicationRequest) (*CreateApplicationResponse, error)
 	GetApplication(context.Context, *GetApplicationRequest) (*GetApplicationResponse, error)
 	ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error)
+	ReviewApplication(context.Context, *ReviewApplicationRequest) (*ReviewApplicationResponse, error)
+	ApproveApplication(context.Context, *ApproveApplicationRequest) (*ApproveApplicationResponse, error)
+	RejectApplication(context.Context, *RejectApplicationRequest) (*RejectApplicationResponse, error)
 	// Vehicles
 	ListVehicles(context.Context, *ListVehiclesRequest) (*ListVehiclesResponse, error)
+	GetVehicle(context.Context, *GetVehicleRequest) (*GetVehicleResponse, error)
 	// Pricing calculator
 	Calculate(context.Context, *CalculateRequest) (*CalculateResponse, error)
+	// Loan products
+	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error)
 	// Loans
+	CreateLoan(context.Context, *CreateLoanRequest) (*CreateLoanResponse, error)
 	GetLoan(context.Context, *GetLoanRequest) (*GetLoanResponse, error)
 	ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error)
+	GetLoanContract(context.Context, *GetLoanContractRequest) (*GetLoanContractResponse, error)
+	GetRepaymentSchedule(context.Context, *GetRepaymentScheduleRequest) (*GetRepaymentScheduleResponse, error)
+	ListInstallments(context.Context, *ListInstallmentsRequest) (*ListInstallmentsResponse, error)
+	// Payments
+	RecordPayment(context.Context, *RecordPaymentRequest) (*RecordPaymentResponse, error)
+	GetPayment(context.Context, *GetPaymentRequest) (*GetPaymentResponse, error)
+	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
+	InitiateInstallmentPayment(context.Context, *InitiateInstallmentPaymentRequest) (*InitiateInstallmentPaymentResponse, error)
+	QuotePrepayment(context.Context, *QuotePrepaymentRequest) (*QuotePrepaymentResponse, error)
+	ApplyPrepayment(context.Context, *ApplyPrepaymentRequest) (*ApplyPrepaymentResponse, error)
+	GetPayoffQuote(context.Context, *GetPayoffQuoteRequest) (*GetPayoffQuoteResponse, error)
+	SettleLoan(context.Context, *SettleLoanRequest) (*SettleLoanResponse, error)
+	// Admin
+	GetReconciliationSummary(context.Context, *GetReconciliationSummaryRequest) (*GetReconciliationSummaryResponse, error)
+	ListDealerNotifications(context.Context, *ListDealerNotificationsRequest) (*ListDealerNotificationsResponse, error)
+	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
+	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
 	mustEmbedUnimplementedLoansServiceServer()
 }
 
@@ -157,18 +462,87 @@ func (UnimplementedLoansServiceServer) GetApplication(context.Context, *GetAppli
 func (UnimplementedLoansServiceServer) ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListApplications not implemented")
 }
+func (UnimplementedLoansServiceServer) ReviewApplication(context.Context, *ReviewApplicationRequest) (*ReviewApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewApplication not implemented")
+}
+func (UnimplementedLoansServiceServer) ApproveApplication(context.Context, *ApproveApplicationRequest) (*ApproveApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveApplication not implemented")
+}
+func (UnimplementedLoansServiceServer) RejectApplication(context.Context, *RejectApplicationRequest) (*RejectApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectApplication not implemented")
+}
 func (UnimplementedLoansServiceServer) ListVehicles(context.Context, *ListVehiclesRequest) (*ListVehiclesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListVehicles not implemented")
 }
+func (UnimplementedLoansServiceServer) GetVehicle(context.Context, *GetVehicleRequest) (*GetVehicleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVehicle not implemented")
+}
 func (UnimplementedLoansServiceServer) Calculate(context.Context, *CalculateRequest) (*CalculateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Calculate not implemented")
 }
+func (UnimplementedLoansServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedLoansServiceServer) GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
+}
+func (UnimplementedLoansServiceServer) CreateLoan(context.Context, *CreateLoanRequest) (*CreateLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLoan not implemented")
+}
 func (UnimplementedLoansServiceServer) GetLoan(context.Context, *GetLoanRequest) (*GetLoanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLoan not implemented")
 }
 func (UnimplementedLoansServiceServer) ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLoans not implemented")
 }
+func (UnimplementedLoansServiceServer) GetLoanContract(context.Context, *GetLoanContractRequest) (*GetLoanContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoanContract not implemented")
+}
+func (UnimplementedLoansServiceServer) GetRepaymentSchedule(context.Context, *GetRepaymentScheduleRequest) (*GetRepaymentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepaymentSchedule not implemented")
+}
+func (UnimplementedLoansServiceServer) ListInstallments(context.Context, *ListInstallmentsRequest) (*ListInstallmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstallments not implemented")
+}
+func (UnimplementedLoansServiceServer) RecordPayment(context.Context, *RecordPaymentRequest) (*RecordPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordPayment not implemented")
+}
+func (UnimplementedLoansServiceServer) GetPayment(context.Context, *GetPaymentRequest) (*GetPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayment not implemented")
+}
+func (UnimplementedLoansServiceServer) ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPayments not implemented")
+}
+func (UnimplementedLoansServiceServer) InitiateInstallmentPayment(context.Context, *InitiateInstallmentPaymentRequest) (*InitiateInstallmentPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateInstallmentPayment not implemented")
+}
+func (UnimplementedLoansServiceServer) QuotePrepayment(context.Context, *QuotePrepaymentRequest) (*QuotePrepaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotePrepayment not implemented")
+}
+func (UnimplementedLoansServiceServer) ApplyPrepayment(context.Context, *ApplyPrepaymentRequest) (*ApplyPrepaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyPrepayment not implemented")
+}
+func (UnimplementedLoansServiceServer) GetPayoffQuote(context.Context, *GetPayoffQuoteRequest) (*GetPayoffQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayoffQuote not implemented")
+}
+func (UnimplementedLoansServiceServer) SettleLoan(context.Context, *SettleLoanRequest) (*SettleLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleLoan not implemented")
+}
+func (UnimplementedLoansServiceServer) GetReconciliationSummary(context.Context, *GetReconciliationSummaryRequest) (*GetReconciliationSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliationSummary not implemented")
+}
+func (UnimplementedLoansServiceServer) ListDealerNotifications(context.Context, *ListDealerNotificationsRequest) (*ListDealerNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDealerNotifications not implemented")
+}
+func (UnimplementedLoansServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedLoansServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedLoansServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
 func (UnimplementedLoansServiceServer) mustEmbedUnimplementedLoansServiceServer() {}
 func (UnimplementedLoansServiceServer) testEmbeddedByValue()                      {}
 
@@ -244,6 +618,60 @@ func _LoansService_ListApplications_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoansService_ReviewApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).ReviewApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_ReviewApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).ReviewApplication(ctx, req.(*ReviewApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_ApproveApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).ApproveApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_ApproveApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).ApproveApplication(ctx, req.(*ApproveApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_RejectApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).RejectApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_RejectApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).RejectApplication(ctx, req.(*RejectApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LoansService_ListVehicles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListVehiclesRequest)
 	if err := dec(in); err != nil {
@@ -262,6 +690,24 @@ func _LoansService_ListVehicles_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoansService_GetVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).GetVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_GetVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).GetVehicle(ctx, req.(*GetVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LoansService_Calculate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CalculateRequest)
 	if err := dec(in); err != nil {
@@ -280,52 +726,394 @@ func _LoansService_Calculate_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LoansService_GetLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLoanRequest)
+func _LoansService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LoansServiceServer).GetLoan(ctx, in)
+		return srv.(LoansServiceServer).ListProducts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LoansService_GetLoan_FullMethodName,
+		FullMethod: LoansService_ListProducts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LoansServiceServer).GetLoan(ctx, req.(*GetLoanRequest))
+		return srv.(LoansServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LoansService_ListLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListLoansRequest)
+func _LoansService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LoansServiceServer).ListLoans(ctx, in)
+		return srv.(LoansServiceServer).GetProduct(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LoansService_ListLoans_FullMethodName,
+		FullMethod: LoansService_GetProduct_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LoansServiceServer).ListLoans(ctx, req.(*ListLoansRequest))
+		return srv.(LoansServiceServer).GetProduct(ctx, req.(*GetProductRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// LoansService_ServiceDesc is the grpc.ServiceDesc for LoansService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var LoansService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "loanpb.LoansService",
-	HandlerType: (*LoansServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateApplication",
-			Handler:    _LoansService_CreateApplication_Handler,
+func _LoansService_CreateLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).CreateLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_CreateLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).CreateLoan(ctx, req.(*CreateLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_GetLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).GetLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_GetLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).GetLoan(ctx, req.(*GetLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_ListLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).ListLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_ListLoans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).ListLoans(ctx, req.(*ListLoansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_GetLoanContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).GetLoanContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_GetLoanContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).GetLoanContract(ctx, req.(*GetLoanContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_GetRepaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepaymentScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).GetRepaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_GetRepaymentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).GetRepaymentSchedule(ctx, req.(*GetRepaymentScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_ListInstallments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstallmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).ListInstallments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_ListInstallments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).ListInstallments(ctx, req.(*ListInstallmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_RecordPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).RecordPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_RecordPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).RecordPayment(ctx, req.(*RecordPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_GetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).GetPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_GetPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).GetPayment(ctx, req.(*GetPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_ListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).ListPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_ListPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).ListPayments(ctx, req.(*ListPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_InitiateInstallmentPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateInstallmentPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).InitiateInstallmentPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_InitiateInstallmentPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).InitiateInstallmentPayment(ctx, req.(*InitiateInstallmentPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_QuotePrepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotePrepaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).QuotePrepayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_QuotePrepayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).QuotePrepayment(ctx, req.(*QuotePrepaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_ApplyPrepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyPrepaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).ApplyPrepayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_ApplyPrepayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).ApplyPrepayment(ctx, req.(*ApplyPrepaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_GetPayoffQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoffQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).GetPayoffQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_GetPayoffQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).GetPayoffQuote(ctx, req.(*GetPayoffQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_SettleLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).SettleLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_SettleLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).SettleLoan(ctx, req.(*SettleLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_GetReconciliationSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).GetReconciliationSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_GetReconciliationSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).GetReconciliationSummary(ctx, req.(*GetReconciliationSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_ListDealerNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDealerNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).ListDealerNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_ListDealerNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).ListDealerNotifications(ctx, req.(*ListDealerNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoansService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoansServiceServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoansService_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoansServiceServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LoansService_ServiceDesc is the grpc.ServiceDesc for LoansService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LoansService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "loanpb.LoansService",
+	HandlerType: (*LoansServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateApplication",
+			Handler:    _LoansService_CreateApplication_Handler,
 		},
 		{
 			MethodName: "GetApplication",
@@ -335,14 +1123,42 @@ var LoansService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListApplications",
 			Handler:    _LoansService_ListApplications_Handler,
 		},
+		{
+			MethodName: "ReviewApplication",
+			Handler:    _LoansService_ReviewApplication_Handler,
+		},
+		{
+			MethodName: "ApproveApplication",
+			Handler:    _LoansService_ApproveApplication_Handler,
+		},
+		{
+			MethodName: "RejectApplication",
+			Handler:    _LoansService_RejectApplication_Handler,
+		},
 		{
 			MethodName: "ListVehicles",
 			Handler:    _LoansService_ListVehicles_Handler,
 		},
+		{
+			MethodName: "GetVehicle",
+			Handler:    _LoansService_GetVehicle_Handler,
+		},
 		{
 			MethodName: "Calculate",
 			Handler:    _LoansService_Calculate_Handler,
 		},
+		{
+			MethodName: "ListProducts",
+			Handler:    _LoansService_ListProducts_Handler,
+		},
+		{
+			MethodName: "GetProduct",
+			Handler:    _LoansService_GetProduct_Handler,
+		},
+		{
+			MethodName: "CreateLoan",
+			Handler:    _LoansService_CreateLoan_Handler,
+		},
 		{
 			MethodName: "GetLoan",
 			Handler:    _LoansService_GetLoan_Handler,
@@ -351,7 +1167,71 @@ var LoansService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLoans",
 			Handler:    _LoansService_ListLoans_Handler,
 		},
+		{
+			MethodName: "GetLoanContract",
+			Handler:    _LoansService_GetLoanContract_Handler,
+		},
+		{
+			MethodName: "GetRepaymentSchedule",
+			Handler:    _LoansService_GetRepaymentSchedule_Handler,
+		},
+		{
+			MethodName: "ListInstallments",
+			Handler:    _LoansService_ListInstallments_Handler,
+		},
+		{
+			MethodName: "RecordPayment",
+			Handler:    _LoansService_RecordPayment_Handler,
+		},
+		{
+			MethodName: "GetPayment",
+			Handler:    _LoansService_GetPayment_Handler,
+		},
+		{
+			MethodName: "ListPayments",
+			Handler:    _LoansService_ListPayments_Handler,
+		},
+		{
+			MethodName: "InitiateInstallmentPayment",
+			Handler:    _LoansService_InitiateInstallmentPayment_Handler,
+		},
+		{
+			MethodName: "QuotePrepayment",
+			Handler:    _LoansService_QuotePrepayment_Handler,
+		},
+		{
+			MethodName: "ApplyPrepayment",
+			Handler:    _LoansService_ApplyPrepayment_Handler,
+		},
+		{
+			MethodName: "GetPayoffQuote",
+			Handler:    _LoansService_GetPayoffQuote_Handler,
+		},
+		{
+			MethodName: "SettleLoan",
+			Handler:    _LoansService_SettleLoan_Handler,
+		},
+		{
+			MethodName: "GetReconciliationSummary",
+			Handler:    _LoansService_GetReconciliationSummary_Handler,
+		},
+		{
+			MethodName: "ListDealerNotifications",
+			Handler:    _LoansService_ListDealerNotifications_Handler,
+		},
+		{
+			MethodName: "CreateProduct",
+			Handler:    _LoansService_CreateProduct_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _LoansService_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _LoansService_DeleteProduct_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "internal/proto/loan/loan_service.proto",
+	Metadata: "loan_service.proto",
 }
@@ -0,0 +1,560 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: internal/proto/payment/payment_service.proto
+
+package paymentpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PaymentServiceError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentServiceError) Reset() {
+	*x = PaymentServiceError{}
+	mi := &file_internal_proto_payment_payment_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentServiceError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentServiceError) ProtoMessage() {}
+
+func (x *PaymentServiceError) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_payment_payment_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentServiceError.ProtoReflect.Descriptor instead.
+func (*PaymentServiceError) Descriptor() ([]byte, []int) {
+	return file_internal_proto_payment_payment_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PaymentServiceError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PaymentServiceError) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// -------------------- Core models --------------------
+// Money is an exact amount in hundredths of the major currency unit:
+// amount 123456 with currency_code "TJS" is 1234.56 TJS.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	CurrencyCode  string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_internal_proto_payment_payment_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_payment_payment_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_internal_proto_payment_payment_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+type Charge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // PENDING, SUCCEEDED, FAILED
+	FailureReason string                 `protobuf:"bytes,5,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Charge) Reset() {
+	*x = Charge{}
+	mi := &file_internal_proto_payment_payment_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Charge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Charge) ProtoMessage() {}
+
+func (x *Charge) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_payment_payment_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Charge.ProtoReflect.Descriptor instead.
+func (*Charge) Descriptor() ([]byte, []int) {
+	return file_internal_proto_payment_payment_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Charge) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Charge) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Charge) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Charge) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Charge) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Charge) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// CreateChargeRequest charges a customer's saved card or wallet. A repeated
+// request with the same transaction_id returns the existing charge. The
+// outcome is published as a PaymentConfirmed message once the charge
+// succeeds, or as a PaymentFailed message if it fails after being accepted.
+type CreateChargeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId   string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount          *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Method          string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"` // CARD, WALLET
+	PaymentSourceId string                 `protobuf:"bytes,5,opt,name=payment_source_id,json=paymentSourceId,proto3" json:"payment_source_id,omitempty"`
+	Description     string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Reference       string                 `protobuf:"bytes,7,opt,name=reference,proto3" json:"reference,omitempty"` // loan id the charge pays towards
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateChargeRequest) Reset() {
+	*x = CreateChargeRequest{}
+	mi := &file_internal_proto_payment_payment_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChargeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChargeRequest) ProtoMessage() {}
+
+func (x *CreateChargeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_payment_payment_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChargeRequest.ProtoReflect.Descriptor instead.
+func (*CreateChargeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_payment_payment_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateChargeRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *CreateChargeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateChargeRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CreateChargeRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CreateChargeRequest) GetPaymentSourceId() string {
+	if x != nil {
+		return x.PaymentSourceId
+	}
+	return ""
+}
+
+func (x *CreateChargeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateChargeRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type CreateChargeResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Charge              *Charge                `protobuf:"bytes,1,opt,name=charge,proto3" json:"charge,omitempty"`
+	PaymentServiceError *PaymentServiceError   `protobuf:"bytes,100,opt,name=payment_service_error,json=paymentServiceError,proto3" json:"payment_service_error,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateChargeResponse) Reset() {
+	*x = CreateChargeResponse{}
+	mi := &file_internal_proto_payment_payment_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChargeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChargeResponse) ProtoMessage() {}
+
+func (x *CreateChargeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_payment_payment_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChargeResponse.ProtoReflect.Descriptor instead.
+func (*CreateChargeResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_payment_payment_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateChargeResponse) GetCharge() *Charge {
+	if x != nil {
+		return x.Charge
+	}
+	return nil
+}
+
+func (x *CreateChargeResponse) GetPaymentServiceError() *PaymentServiceError {
+	if x != nil {
+		return x.PaymentServiceError
+	}
+	return nil
+}
+
+type GetChargeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChargeRequest) Reset() {
+	*x = GetChargeRequest{}
+	mi := &file_internal_proto_payment_payment_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChargeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChargeRequest) ProtoMessage() {}
+
+func (x *GetChargeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_payment_payment_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChargeRequest.ProtoReflect.Descriptor instead.
+func (*GetChargeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_payment_payment_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetChargeRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type GetChargeResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Charge              *Charge                `protobuf:"bytes,1,opt,name=charge,proto3" json:"charge,omitempty"`
+	PaymentServiceError *PaymentServiceError   `protobuf:"bytes,100,opt,name=payment_service_error,json=paymentServiceError,proto3" json:"payment_service_error,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetChargeResponse) Reset() {
+	*x = GetChargeResponse{}
+	mi := &file_internal_proto_payment_payment_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChargeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChargeResponse) ProtoMessage() {}
+
+func (x *GetChargeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_payment_payment_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChargeResponse.ProtoReflect.Descriptor instead.
+func (*GetChargeResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_payment_payment_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetChargeResponse) GetCharge() *Charge {
+	if x != nil {
+		return x.Charge
+	}
+	return nil
+}
+
+func (x *GetChargeResponse) GetPaymentServiceError() *PaymentServiceError {
+	if x != nil {
+		return x.PaymentServiceError
+	}
+	return nil
+}
+
+var File_internal_proto_payment_payment_service_proto protoreflect.FileDescriptor
+
+const file_internal_proto_payment_payment_service_proto_rawDesc = "" +
+	"\n" +
+	",internal/proto/payment/payment_service.proto\x12\tpaymentpb\"K\n" +
+	"\x13PaymentServiceError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"D\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\"\xcf\x01\n" +
+	"\x06Charge\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12(\n" +
+	"\x06amount\x18\x02 \x01(\v2\x10.paymentpb.MoneyR\x06amount\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12%\n" +
+	"\x0efailure_reason\x18\x05 \x01(\tR\rfailureReason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\x83\x02\n" +
+	"\x13CreateChargeRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12(\n" +
+	"\x06amount\x18\x03 \x01(\v2\x10.paymentpb.MoneyR\x06amount\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12*\n" +
+	"\x11payment_source_id\x18\x05 \x01(\tR\x0fpaymentSourceId\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1c\n" +
+	"\treference\x18\a \x01(\tR\treference\"\x95\x01\n" +
+	"\x14CreateChargeResponse\x12)\n" +
+	"\x06charge\x18\x01 \x01(\v2\x11.paymentpb.ChargeR\x06charge\x12R\n" +
+	"\x15payment_service_error\x18d \x01(\v2\x1e.paymentpb.PaymentServiceErrorR\x13paymentServiceError\"9\n" +
+	"\x10GetChargeRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\"\x92\x01\n" +
+	"\x11GetChargeResponse\x12)\n" +
+	"\x06charge\x18\x01 \x01(\v2\x11.paymentpb.ChargeR\x06charge\x12R\n" +
+	"\x15payment_service_error\x18d \x01(\v2\x1e.paymentpb.PaymentServiceErrorR\x13paymentServiceError2\xa9\x01\n" +
+	"\x0ePaymentService\x12O\n" +
+	"\fCreateCharge\x12\x1e.paymentpb.CreateChargeRequest\x1a\x1f.paymentpb.CreateChargeResponse\x12F\n" +
+	"\tGetCharge\x12\x1b.paymentpb.GetChargeRequest\x1a\x1c.paymentpb.GetChargeResponseB\x1aZ\x18internal/proto/paymentpbb\x06proto3"
+
+var (
+	file_internal_proto_payment_payment_service_proto_rawDescOnce sync.Once
+	file_internal_proto_payment_payment_service_proto_rawDescData []byte
+)
+
+func file_internal_proto_payment_payment_service_proto_rawDescGZIP() []byte {
+	file_internal_proto_payment_payment_service_proto_rawDescOnce.Do(func() {
+		file_internal_proto_payment_payment_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_proto_payment_payment_service_proto_rawDesc), len(file_internal_proto_payment_payment_service_proto_rawDesc)))
+	})
+	return file_internal_proto_payment_payment_service_proto_rawDescData
+}
+
+var file_internal_proto_payment_payment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_internal_proto_payment_payment_service_proto_goTypes = []any{
+	(*PaymentServiceError)(nil),  // 0: paymentpb.PaymentServiceError
+	(*Money)(nil),                // 1: paymentpb.Money
+	(*Charge)(nil),               // 2: paymentpb.Charge
+	(*CreateChargeRequest)(nil),  // 3: paymentpb.CreateChargeRequest
+	(*CreateChargeResponse)(nil), // 4: paymentpb.CreateChargeResponse
+	(*GetChargeRequest)(nil),     // 5: paymentpb.GetChargeRequest
+	(*GetChargeResponse)(nil),    // 6: paymentpb.GetChargeResponse
+}
+var file_internal_proto_payment_payment_service_proto_depIdxs = []int32{
+	1, // 0: paymentpb.Charge.amount:type_name -> paymentpb.Money
+	1, // 1: paymentpb.CreateChargeRequest.amount:type_name -> paymentpb.Money
+	2, // 2: paymentpb.CreateChargeResponse.charge:type_name -> paymentpb.Charge
+	0, // 3: paymentpb.CreateChargeResponse.payment_service_error:type_name -> paymentpb.PaymentServiceError
+	2, // 4: paymentpb.GetChargeResponse.charge:type_name -> paymentpb.Charge
+	0, // 5: paymentpb.GetChargeResponse.payment_service_error:type_name -> paymentpb.PaymentServiceError
+	3, // 6: paymentpb.PaymentService.CreateCharge:input_type -> paymentpb.CreateChargeRequest
+	5, // 7: paymentpb.PaymentService.GetCharge:input_type -> paymentpb.GetChargeRequest
+	4, // 8: paymentpb.PaymentService.CreateCharge:output_type -> paymentpb.CreateChargeResponse
+	6, // 9: paymentpb.PaymentService.GetCharge:output_type -> paymentpb.GetChargeResponse
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_internal_proto_payment_payment_service_proto_init() }
+func file_internal_proto_payment_payment_service_proto_init() {
+	if File_internal_proto_payment_payment_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_proto_payment_payment_service_proto_rawDesc), len(file_internal_proto_payment_payment_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_proto_payment_payment_service_proto_goTypes,
+		DependencyIndexes: file_internal_proto_payment_payment_service_proto_depIdxs,
+		MessageInfos:      file_internal_proto_payment_payment_service_proto_msgTypes,
+	}.Build()
+	File_internal_proto_payment_payment_service_proto = out.File
+	file_internal_proto_payment_payment_service_proto_goTypes = nil
+	file_internal_proto_payment_payment_service_proto_depIdxs = nil
+}
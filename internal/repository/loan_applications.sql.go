@@ -7,7 +7,107 @@ package repository
 
 import (
 	"context"
+	"time"
+
+	"loan_service/pkg/money"
+)
+
+const claimApplicationOrigination = `-- name: ClaimApplicationOrigination :exec
+update loan_applications
+set origination_claimed_until = $2
+where id = $1
+`
+
+type ClaimApplicationOriginationParams struct {
+	ID                      int64      `json:"id"`
+	OriginationClaimedUntil *time.Time `json:"origination_claimed_until"`
+}
+
+func (q *Queries) ClaimApplicationOrigination(ctx context.Context, arg ClaimApplicationOriginationParams) error {
+	_, err := q.db.Exec(ctx, claimApplicationOrigination, arg.ID, arg.OriginationClaimedUntil)
+	return err
+}
+
+const claimPendingDealerNotifications = `-- name: ClaimPendingDealerNotifications :many
+update loan_applications
+set dealer_notification_next_attempt_at = $1
+where id in (
+  select id
+  from loan_applications
+  where dealer_notification_status = 'PENDING'
+    and dealer_notification_next_attempt_at <= NOW()
+  order by id
+  limit $2
+  for update skip locked
 )
+returning id, user_id, type, vehicle_vin, vehicle_name, currency_code, price, down_payment, net_price, margin_rate, term_months, monthly_payment, status, created_at, updated_at, repayment_method, vehicle_price, vehicle_currency_code, exchange_rate, contract_number, dealer_notification_status, dealer_notification_attempts, dealer_notification_error, dealer_notification_next_attempt_at, dealer_notified_at, product_id, origination_claimed_until
+`
+
+type ClaimPendingDealerNotificationsParams struct {
+	ClaimedUntil time.Time `json:"claimed_until"`
+	BatchSize    int32     `json:"batch_size"`
+}
+
+func (q *Queries) ClaimPendingDealerNotifications(ctx context.Context, arg ClaimPendingDealerNotificationsParams) ([]LoanApplication, error) {
+	rows, err := q.db.Query(ctx, claimPendingDealerNotifications, arg.ClaimedUntil, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LoanApplication
+	for rows.Next() {
+		var i LoanApplication
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Type,
+			&i.VehicleVin,
+			&i.VehicleName,
+			&i.CurrencyCode,
+			&i.Price,
+			&i.DownPayment,
+			&i.NetPrice,
+			&i.MarginRate,
+			&i.TermMonths,
+			&i.MonthlyPayment,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RepaymentMethod,
+			&i.VehiclePrice,
+			&i.VehicleCurrencyCode,
+			&i.ExchangeRate,
+			&i.ContractNumber,
+			&i.DealerNotificationStatus,
+			&i.DealerNotificationAttempts,
+			&i.DealerNotificationError,
+			&i.DealerNotificationNextAttemptAt,
+			&i.DealerNotifiedAt,
+			&i.ProductID,
+			&i.OriginationClaimedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countApplicationsByDealerNotificationStatus = `-- name: CountApplicationsByDealerNotificationStatus :one
+select count(*)
+from loan_applications
+where dealer_notification_status = $1
+`
+
+func (q *Queries) CountApplicationsByDealerNotificationStatus(ctx context.Context, dealerNotificationStatus string) (int64, error) {
+	row := q.db.QueryRow(ctx, countApplicationsByDealerNotificationStatus, dealerNotificationStatus)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
 
 const countApplicationsByUser = `-- name: CountApplicationsByUser :one
 select count(*)
@@ -35,25 +135,35 @@ INSERT INTO loan_applications(
   margin_rate,
   term_months,
   monthly_payment,
-  status
+  status,
+  repayment_method,
+  vehicle_price,
+  vehicle_currency_code,
+  exchange_rate,
+  product_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12  
-) RETURNING id, user_id, type, vehicle_vin, vehicle_name, currency_code, price, down_payment, net_price, margin_rate, term_months, monthly_payment, status, created_at, updated_at
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+) RETURNING id, user_id, type, vehicle_vin, vehicle_name, currency_code, price, down_payment, net_price, margin_rate, term_months, monthly_payment, status, created_at, updated_at, repayment_method, vehicle_price, vehicle_currency_code, exchange_rate, contract_number, dealer_notification_status, dealer_notification_attempts, dealer_notification_error, dealer_notification_next_attempt_at, dealer_notified_at, product_id, origination_claimed_until
 `
 
 type CreateApplicationParams struct {
-	UserID         int64                 `json:"user_id"`
-	Type           ApplicationType       `json:"type"`
-	VehicleVin     *string               `json:"vehicle_vin"`
-	VehicleName    *string               `json:"vehicle_name"`
-	CurrencyCode   string                `json:"currency_code"`
-	Price          *float64              `json:"price"`
-	DownPayment    *float64              `json:"down_payment"`
-	NetPrice       *float64              `json:"net_price"`
-	MarginRate     *float64              `json:"margin_rate"`
-	TermMonths     *int64                `json:"term_months"`
-	MonthlyPayment *float64              `json:"monthly_payment"`
-	Status         NullApplicationStatus `json:"status"`
+	UserID              int64                 `json:"user_id"`
+	Type                ApplicationType       `json:"type"`
+	VehicleVin          *string               `json:"vehicle_vin"`
+	VehicleName         *string               `json:"vehicle_name"`
+	CurrencyCode        string                `json:"currency_code"`
+	Price               *money.Amount         `json:"price"`
+	DownPayment         *money.Amount         `json:"down_payment"`
+	NetPrice            *money.Amount         `json:"net_price"`
+	MarginRate          *float64              `json:"margin_rate"`
+	TermMonths          *int64                `json:"term_months"`
+	MonthlyPayment      *money.Amount         `json:"monthly_payment"`
+	Status              NullApplicationStatus `json:"status"`
+	RepaymentMethod     RepaymentMethod       `json:"repayment_method"`
+	VehiclePrice        *money.Amount         `json:"vehicle_price"`
+	VehicleCurrencyCode *string               `json:"vehicle_currency_code"`
+	ExchangeRate        *float64              `json:"exchange_rate"`
+	ProductID           *int64                `json:"product_id"`
 }
 
 func (q *Queries) CreateApplication(ctx context.Context, arg CreateApplicationParams) (LoanApplication, error) {
@@ -70,6 +180,11 @@ func (q *Queries) CreateApplication(ctx context.Context, arg CreateApplicationPa
 		arg.TermMonths,
 		arg.MonthlyPayment,
 		arg.Status,
+		arg.RepaymentMethod,
+		arg.VehiclePrice,
+		arg.VehicleCurrencyCode,
+		arg.ExchangeRate,
+		arg.ProductID,
 	)
 	var i LoanApplication
 	err := row.Scan(
@@ -88,12 +203,24 @@ func (q *Queries) CreateApplication(ctx context.Context, arg CreateApplicationPa
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RepaymentMethod,
+		&i.VehiclePrice,
+		&i.VehicleCurrencyCode,
+		&i.ExchangeRate,
+		&i.ContractNumber,
+		&i.DealerNotificationStatus,
+		&i.DealerNotificationAttempts,
+		&i.DealerNotificationError,
+		&i.DealerNotificationNextAttemptAt,
+		&i.DealerNotifiedAt,
+		&i.ProductID,
+		&i.OriginationClaimedUntil,
 	)
 	return i, err
 }
 
 const getApplication = `-- name: GetApplication :one
-select id, user_id, type, vehicle_vin, vehicle_name, currency_code, price, down_payment, net_price, margin_rate, term_months, monthly_payment, status, created_at, updated_at
+select id, user_id, type, vehicle_vin, vehicle_name, currency_code, price, down_payment, net_price, margin_rate, term_months, monthly_payment, status, created_at, updated_at, repayment_method, vehicle_price, vehicle_currency_code, exchange_rate, contract_number, dealer_notification_status, dealer_notification_attempts, dealer_notification_error, dealer_notification_next_attempt_at, dealer_notified_at, product_id, origination_claimed_until
 from loan_applications
 where id = $1
 `
@@ -117,12 +244,129 @@ func (q *Queries) GetApplication(ctx context.Context, id int64) (LoanApplication
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RepaymentMethod,
+		&i.VehiclePrice,
+		&i.VehicleCurrencyCode,
+		&i.ExchangeRate,
+		&i.ContractNumber,
+		&i.DealerNotificationStatus,
+		&i.DealerNotificationAttempts,
+		&i.DealerNotificationError,
+		&i.DealerNotificationNextAttemptAt,
+		&i.DealerNotifiedAt,
+		&i.ProductID,
+		&i.OriginationClaimedUntil,
+	)
+	return i, err
+}
+
+const getApplicationForUpdate = `-- name: GetApplicationForUpdate :one
+select id, user_id, type, vehicle_vin, vehicle_name, currency_code, price, down_payment, net_price, margin_rate, term_months, monthly_payment, status, created_at, updated_at, repayment_method, vehicle_price, vehicle_currency_code, exchange_rate, contract_number, dealer_notification_status, dealer_notification_attempts, dealer_notification_error, dealer_notification_next_attempt_at, dealer_notified_at, product_id, origination_claimed_until
+from loan_applications
+where id = $1
+for update
+`
+
+func (q *Queries) GetApplicationForUpdate(ctx context.Context, id int64) (LoanApplication, error) {
+	row := q.db.QueryRow(ctx, getApplicationForUpdate, id)
+	var i LoanApplication
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Type,
+		&i.VehicleVin,
+		&i.VehicleName,
+		&i.CurrencyCode,
+		&i.Price,
+		&i.DownPayment,
+		&i.NetPrice,
+		&i.MarginRate,
+		&i.TermMonths,
+		&i.MonthlyPayment,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RepaymentMethod,
+		&i.VehiclePrice,
+		&i.VehicleCurrencyCode,
+		&i.ExchangeRate,
+		&i.ContractNumber,
+		&i.DealerNotificationStatus,
+		&i.DealerNotificationAttempts,
+		&i.DealerNotificationError,
+		&i.DealerNotificationNextAttemptAt,
+		&i.DealerNotifiedAt,
+		&i.ProductID,
+		&i.OriginationClaimedUntil,
 	)
 	return i, err
 }
 
+const listApplicationsByDealerNotificationStatus = `-- name: ListApplicationsByDealerNotificationStatus :many
+select id, user_id, type, vehicle_vin, vehicle_name, currency_code, price, down_payment, net_price, margin_rate, term_months, monthly_payment, status, created_at, updated_at, repayment_method, vehicle_price, vehicle_currency_code, exchange_rate, contract_number, dealer_notification_status, dealer_notification_attempts, dealer_notification_error, dealer_notification_next_attempt_at, dealer_notified_at, product_id, origination_claimed_until
+from loan_applications
+where dealer_notification_status = $1
+order by id
+limit $2
+offset $3
+`
+
+type ListApplicationsByDealerNotificationStatusParams struct {
+	DealerNotificationStatus string `json:"dealer_notification_status"`
+	Limit                    int32  `json:"limit"`
+	Offset                   int32  `json:"offset"`
+}
+
+func (q *Queries) ListApplicationsByDealerNotificationStatus(ctx context.Context, arg ListApplicationsByDealerNotificationStatusParams) ([]LoanApplication, error) {
+	rows, err := q.db.Query(ctx, listApplicationsByDealerNotificationStatus, arg.DealerNotificationStatus, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LoanApplication
+	for rows.Next() {
+		var i LoanApplication
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Type,
+			&i.VehicleVin,
+			&i.VehicleName,
+			&i.CurrencyCode,
+			&i.Price,
+			&i.DownPayment,
+			&i.NetPrice,
+			&i.MarginRate,
+			&i.TermMonths,
+			&i.MonthlyPayment,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RepaymentMethod,
+			&i.VehiclePrice,
+			&i.VehicleCurrencyCode,
+			&i.ExchangeRate,
+			&i.ContractNumber,
+			&i.DealerNotificationStatus,
+			&i.DealerNotificationAttempts,
+			&i.DealerNotificationError,
+			&i.DealerNotificationNextAttemptAt,
+			&i.DealerNotifiedAt,
+			&i.ProductID,
+			&i.OriginationClaimedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listApplicationsByUser = `-- name: ListApplicationsByUser :many
-select id, user_id, type, vehicle_vin, vehicle_name, currency_code, price, down_payment, net_price, margin_rate, term_months, monthly_payment, status, created_at, updated_at
+select id, user_id, type, vehicle_vin, vehicle_name, currency_code, price, down_payment, net_price, margin_rate, term_months, monthly_payment, status, created_at, updated_at, repayment_method, vehicle_price, vehicle_currency_code, exchange_rate, contract_number, dealer_notification_status, dealer_notification_attempts, dealer_notification_error, dealer_notification_next_attempt_at, dealer_notified_at, product_id, origination_claimed_until
 from loan_applications
 where user_id = $1
 order by id desc
@@ -161,6 +405,18 @@ func (q *Queries) ListApplicationsByUser(ctx context.Context, arg ListApplicatio
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RepaymentMethod,
+			&i.VehiclePrice,
+			&i.VehicleCurrencyCode,
+			&i.ExchangeRate,
+			&i.ContractNumber,
+			&i.DealerNotificationStatus,
+			&i.DealerNotificationAttempts,
+			&i.DealerNotificationError,
+			&i.DealerNotificationNextAttemptAt,
+			&i.DealerNotifiedAt,
+			&i.ProductID,
+			&i.OriginationClaimedUntil,
 		); err != nil {
 			return nil, err
 		}
@@ -171,3 +427,150 @@ func (q *Queries) ListApplicationsByUser(ctx context.Context, arg ListApplicatio
 	}
 	return items, nil
 }
+
+const markDealerNotificationFailed = `-- name: MarkDealerNotificationFailed :exec
+update loan_applications
+set dealer_notification_status = $2,
+    dealer_notification_attempts = dealer_notification_attempts + 1,
+    dealer_notification_error = $3,
+    dealer_notification_next_attempt_at = $4
+where id = $1
+`
+
+type MarkDealerNotificationFailedParams struct {
+	ID                              int64     `json:"id"`
+	DealerNotificationStatus        string    `json:"dealer_notification_status"`
+	DealerNotificationError         *string   `json:"dealer_notification_error"`
+	DealerNotificationNextAttemptAt time.Time `json:"dealer_notification_next_attempt_at"`
+}
+
+func (q *Queries) MarkDealerNotificationFailed(ctx context.Context, arg MarkDealerNotificationFailedParams) error {
+	_, err := q.db.Exec(ctx, markDealerNotificationFailed,
+		arg.ID,
+		arg.DealerNotificationStatus,
+		arg.DealerNotificationError,
+		arg.DealerNotificationNextAttemptAt,
+	)
+	return err
+}
+
+const markDealerNotificationSent = `-- name: MarkDealerNotificationSent :exec
+update loan_applications
+set dealer_notification_status = 'SENT',
+    dealer_notification_attempts = dealer_notification_attempts + 1,
+    dealer_notification_error = null,
+    dealer_notified_at = NOW()
+where id = $1
+`
+
+func (q *Queries) MarkDealerNotificationSent(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, markDealerNotificationSent, id)
+	return err
+}
+
+const releaseApplicationOrigination = `-- name: ReleaseApplicationOrigination :exec
+update loan_applications
+set origination_claimed_until = null
+where id = $1
+`
+
+func (q *Queries) ReleaseApplicationOrigination(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, releaseApplicationOrigination, id)
+	return err
+}
+
+const updateApplicationContractNumber = `-- name: UpdateApplicationContractNumber :one
+update loan_applications
+set contract_number = $2,
+    updated_at = NOW()
+where id = $1
+returning id, user_id, type, vehicle_vin, vehicle_name, currency_code, price, down_payment, net_price, margin_rate, term_months, monthly_payment, status, created_at, updated_at, repayment_method, vehicle_price, vehicle_currency_code, exchange_rate, contract_number, dealer_notification_status, dealer_notification_attempts, dealer_notification_error, dealer_notification_next_attempt_at, dealer_notified_at, product_id, origination_claimed_until
+`
+
+type UpdateApplicationContractNumberParams struct {
+	ID             int64   `json:"id"`
+	ContractNumber *string `json:"contract_number"`
+}
+
+func (q *Queries) UpdateApplicationContractNumber(ctx context.Context, arg UpdateApplicationContractNumberParams) (LoanApplication, error) {
+	row := q.db.QueryRow(ctx, updateApplicationContractNumber, arg.ID, arg.ContractNumber)
+	var i LoanApplication
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Type,
+		&i.VehicleVin,
+		&i.VehicleName,
+		&i.CurrencyCode,
+		&i.Price,
+		&i.DownPayment,
+		&i.NetPrice,
+		&i.MarginRate,
+		&i.TermMonths,
+		&i.MonthlyPayment,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RepaymentMethod,
+		&i.VehiclePrice,
+		&i.VehicleCurrencyCode,
+		&i.ExchangeRate,
+		&i.ContractNumber,
+		&i.DealerNotificationStatus,
+		&i.DealerNotificationAttempts,
+		&i.DealerNotificationError,
+		&i.DealerNotificationNextAttemptAt,
+		&i.DealerNotifiedAt,
+		&i.ProductID,
+		&i.OriginationClaimedUntil,
+	)
+	return i, err
+}
+
+const updateApplicationStatus = `-- name: UpdateApplicationStatus :one
+update loan_applications
+set status = $2,
+    updated_at = NOW()
+where id = $1
+returning id, user_id, type, vehicle_vin, vehicle_name, currency_code, price, down_payment, net_price, margin_rate, term_months, monthly_payment, status, created_at, updated_at, repayment_method, vehicle_price, vehicle_currency_code, exchange_rate, contract_number, dealer_notification_status, dealer_notification_attempts, dealer_notification_error, dealer_notification_next_attempt_at, dealer_notified_at, product_id, origination_claimed_until
+`
+
+type UpdateApplicationStatusParams struct {
+	ID     int64                 `json:"id"`
+	Status NullApplicationStatus `json:"status"`
+}
+
+func (q *Queries) UpdateApplicationStatus(ctx context.Context, arg UpdateApplicationStatusParams) (LoanApplication, error) {
+	row := q.db.QueryRow(ctx, updateApplicationStatus, arg.ID, arg.Status)
+	var i LoanApplication
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Type,
+		&i.VehicleVin,
+		&i.VehicleName,
+		&i.CurrencyCode,
+		&i.Price,
+		&i.DownPayment,
+		&i.NetPrice,
+		&i.MarginRate,
+		&i.TermMonths,
+		&i.MonthlyPayment,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RepaymentMethod,
+		&i.VehiclePrice,
+		&i.VehicleCurrencyCode,
+		&i.ExchangeRate,
+		&i.ContractNumber,
+		&i.DealerNotificationStatus,
+		&i.DealerNotificationAttempts,
+		&i.DealerNotificationError,
+		&i.DealerNotificationNextAttemptAt,
+		&i.DealerNotifiedAt,
+		&i.ProductID,
+		&i.OriginationClaimedUntil,
+	)
+	return i, err
+}
@@ -7,12 +7,15 @@ package repository
 
 import (
 	"context"
+	"time"
+
+	"loan_service/pkg/money"
 )
 
 const countLoansByUser = `-- name: CountLoansByUser :one
 select count(*)
 from loans
-where user_id = $1 and status = 'ACTIVE'
+where user_id = $1 and status in ('ACTIVE', 'OVERDUE')
 `
 
 func (q *Queries) CountLoansByUser(ctx context.Context, userID int64) (int64, error) {
@@ -22,8 +25,79 @@ func (q *Queries) CountLoansByUser(ctx context.Context, userID int64) (int64, er
 	return count, err
 }
 
+const createLoan = `-- name: CreateLoan :one
+INSERT INTO loans(
+  application_id,
+  user_id,
+  vehicle_vin,
+  currency_code,
+  amount,
+  term_months,
+  monthly_payment,
+  remaining_balance,
+  status,
+  margin_rate,
+  repayment_method,
+  contract_number
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+) RETURNING id, application_id, user_id, vehicle_vin, currency_code, amount, term_months, monthly_payment, remaining_balance, status, created_at, margin_rate, repayment_method, days_past_due, contract_number, ownership_transferred_at
+`
+
+type CreateLoanParams struct {
+	ApplicationID    int64           `json:"application_id"`
+	UserID           int64           `json:"user_id"`
+	VehicleVin       *string         `json:"vehicle_vin"`
+	CurrencyCode     string          `json:"currency_code"`
+	Amount           *money.Amount   `json:"amount"`
+	TermMonths       *int64          `json:"term_months"`
+	MonthlyPayment   *money.Amount   `json:"monthly_payment"`
+	RemainingBalance *money.Amount   `json:"remaining_balance"`
+	Status           NullLoanStatus  `json:"status"`
+	MarginRate       *float64        `json:"margin_rate"`
+	RepaymentMethod  RepaymentMethod `json:"repayment_method"`
+	ContractNumber   *string         `json:"contract_number"`
+}
+
+func (q *Queries) CreateLoan(ctx context.Context, arg CreateLoanParams) (Loan, error) {
+	row := q.db.QueryRow(ctx, createLoan,
+		arg.ApplicationID,
+		arg.UserID,
+		arg.VehicleVin,
+		arg.CurrencyCode,
+		arg.Amount,
+		arg.TermMonths,
+		arg.MonthlyPayment,
+		arg.RemainingBalance,
+		arg.Status,
+		arg.MarginRate,
+		arg.RepaymentMethod,
+		arg.ContractNumber,
+	)
+	var i Loan
+	err := row.Scan(
+		&i.ID,
+		&i.ApplicationID,
+		&i.UserID,
+		&i.VehicleVin,
+		&i.CurrencyCode,
+		&i.Amount,
+		&i.TermMonths,
+		&i.MonthlyPayment,
+		&i.RemainingBalance,
+		&i.Status,
+		&i.CreatedAt,
+		&i.MarginRate,
+		&i.RepaymentMethod,
+		&i.DaysPastDue,
+		&i.ContractNumber,
+		&i.OwnershipTransferredAt,
+	)
+	return i, err
+}
+
 const getLoan = `-- name: GetLoan :one
-select id, application_id, user_id, vehicle_vin, currency_code, amount, term_months, monthly_payment, remaining_balance, status, created_at
+select id, application_id, user_id, vehicle_vin, currency_code, amount, term_months, monthly_payment, remaining_balance, status, created_at, margin_rate, repayment_method, days_past_due, contract_number, ownership_transferred_at
 from loans
 where id = $1
 `
@@ -43,14 +117,50 @@ func (q *Queries) GetLoan(ctx context.Context, id int64) (Loan, error) {
 		&i.RemainingBalance,
 		&i.Status,
 		&i.CreatedAt,
+		&i.MarginRate,
+		&i.RepaymentMethod,
+		&i.DaysPastDue,
+		&i.ContractNumber,
+		&i.OwnershipTransferredAt,
+	)
+	return i, err
+}
+
+const getLoanForUpdate = `-- name: GetLoanForUpdate :one
+select id, application_id, user_id, vehicle_vin, currency_code, amount, term_months, monthly_payment, remaining_balance, status, created_at, margin_rate, repayment_method, days_past_due, contract_number, ownership_transferred_at
+from loans
+where id = $1
+for update
+`
+
+func (q *Queries) GetLoanForUpdate(ctx context.Context, id int64) (Loan, error) {
+	row := q.db.QueryRow(ctx, getLoanForUpdate, id)
+	var i Loan
+	err := row.Scan(
+		&i.ID,
+		&i.ApplicationID,
+		&i.UserID,
+		&i.VehicleVin,
+		&i.CurrencyCode,
+		&i.Amount,
+		&i.TermMonths,
+		&i.MonthlyPayment,
+		&i.RemainingBalance,
+		&i.Status,
+		&i.CreatedAt,
+		&i.MarginRate,
+		&i.RepaymentMethod,
+		&i.DaysPastDue,
+		&i.ContractNumber,
+		&i.OwnershipTransferredAt,
 	)
 	return i, err
 }
 
 const listLoansByUser = `-- name: ListLoansByUser :many
-select id, application_id, user_id, vehicle_vin, currency_code, amount, term_months, monthly_payment, remaining_balance, status, created_at
+select id, application_id, user_id, vehicle_vin, currency_code, amount, term_months, monthly_payment, remaining_balance, status, created_at, margin_rate, repayment_method, days_past_due, contract_number, ownership_transferred_at
 from loans
-where user_id = $1 and status = 'ACTIVE'
+where user_id = $1 and status in ('ACTIVE', 'OVERDUE')
 order by id desc
 limit $2
 offset $3
@@ -83,6 +193,156 @@ func (q *Queries) ListLoansByUser(ctx context.Context, arg ListLoansByUserParams
 			&i.RemainingBalance,
 			&i.Status,
 			&i.CreatedAt,
+			&i.MarginRate,
+			&i.RepaymentMethod,
+			&i.DaysPastDue,
+			&i.ContractNumber,
+			&i.OwnershipTransferredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLoansForReconciliation = `-- name: ListLoansForReconciliation :many
+select id, application_id, user_id, vehicle_vin, currency_code, amount, term_months, monthly_payment, remaining_balance, status, created_at, margin_rate, repayment_method, days_past_due, contract_number, ownership_transferred_at
+from loans
+where contract_number is not null and id > $1
+order by id
+limit $2
+`
+
+type ListLoansForReconciliationParams struct {
+	AfterID   int64 `json:"after_id"`
+	BatchSize int32 `json:"batch_size"`
+}
+
+func (q *Queries) ListLoansForReconciliation(ctx context.Context, arg ListLoansForReconciliationParams) ([]Loan, error) {
+	rows, err := q.db.Query(ctx, listLoansForReconciliation, arg.AfterID, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Loan
+	for rows.Next() {
+		var i Loan
+		if err := rows.Scan(
+			&i.ID,
+			&i.ApplicationID,
+			&i.UserID,
+			&i.VehicleVin,
+			&i.CurrencyCode,
+			&i.Amount,
+			&i.TermMonths,
+			&i.MonthlyPayment,
+			&i.RemainingBalance,
+			&i.Status,
+			&i.CreatedAt,
+			&i.MarginRate,
+			&i.RepaymentMethod,
+			&i.DaysPastDue,
+			&i.ContractNumber,
+			&i.OwnershipTransferredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOverdueLoans = `-- name: ListOverdueLoans :many
+select id, application_id, user_id, vehicle_vin, currency_code, amount, term_months, monthly_payment, remaining_balance, status, created_at, margin_rate, repayment_method, days_past_due, contract_number, ownership_transferred_at
+from loans
+where status = 'OVERDUE'
+order by id
+`
+
+func (q *Queries) ListOverdueLoans(ctx context.Context) ([]Loan, error) {
+	rows, err := q.db.Query(ctx, listOverdueLoans)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Loan
+	for rows.Next() {
+		var i Loan
+		if err := rows.Scan(
+			&i.ID,
+			&i.ApplicationID,
+			&i.UserID,
+			&i.VehicleVin,
+			&i.CurrencyCode,
+			&i.Amount,
+			&i.TermMonths,
+			&i.MonthlyPayment,
+			&i.RemainingBalance,
+			&i.Status,
+			&i.CreatedAt,
+			&i.MarginRate,
+			&i.RepaymentMethod,
+			&i.DaysPastDue,
+			&i.ContractNumber,
+			&i.OwnershipTransferredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markLoansOverdue = `-- name: MarkLoansOverdue :many
+update loans
+set status = 'OVERDUE',
+    days_past_due = arrears.days_past_due
+from (
+  select loan_id, ($1::timestamp::date - min(due_date)::date) as days_past_due
+  from installments
+  where status <> 'PAID' and due_date < date_trunc('day', $1::timestamp)
+  group by loan_id
+) arrears
+where loans.id = arrears.loan_id and loans.status = 'ACTIVE'
+returning loans.id, loans.application_id, loans.user_id, loans.vehicle_vin, loans.currency_code, loans.amount, loans.term_months, loans.monthly_payment, loans.remaining_balance, loans.status, loans.created_at, loans.margin_rate, loans.repayment_method, loans.days_past_due, loans.contract_number, loans.ownership_transferred_at
+`
+
+func (q *Queries) MarkLoansOverdue(ctx context.Context, asOf time.Time) ([]Loan, error) {
+	rows, err := q.db.Query(ctx, markLoansOverdue, asOf)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Loan
+	for rows.Next() {
+		var i Loan
+		if err := rows.Scan(
+			&i.ID,
+			&i.ApplicationID,
+			&i.UserID,
+			&i.VehicleVin,
+			&i.CurrencyCode,
+			&i.Amount,
+			&i.TermMonths,
+			&i.MonthlyPayment,
+			&i.RemainingBalance,
+			&i.Status,
+			&i.CreatedAt,
+			&i.MarginRate,
+			&i.RepaymentMethod,
+			&i.DaysPastDue,
+			&i.ContractNumber,
+			&i.OwnershipTransferredAt,
 		); err != nil {
 			return nil, err
 		}
@@ -93,3 +353,197 @@ func (q *Queries) ListLoansByUser(ctx context.Context, arg ListLoansByUserParams
 	}
 	return items, nil
 }
+
+const restoreActiveLoans = `-- name: RestoreActiveLoans :many
+update loans
+set status = 'ACTIVE',
+    days_past_due = 0
+where status = 'OVERDUE'
+  and not exists (
+    select 1
+    from installments
+    where installments.loan_id = loans.id
+      and installments.status <> 'PAID'
+      and installments.due_date < date_trunc('day', $1::timestamp)
+  )
+returning id, application_id, user_id, vehicle_vin, currency_code, amount, term_months, monthly_payment, remaining_balance, status, created_at, margin_rate, repayment_method, days_past_due, contract_number, ownership_transferred_at
+`
+
+func (q *Queries) RestoreActiveLoans(ctx context.Context, asOf time.Time) ([]Loan, error) {
+	rows, err := q.db.Query(ctx, restoreActiveLoans, asOf)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Loan
+	for rows.Next() {
+		var i Loan
+		if err := rows.Scan(
+			&i.ID,
+			&i.ApplicationID,
+			&i.UserID,
+			&i.VehicleVin,
+			&i.CurrencyCode,
+			&i.Amount,
+			&i.TermMonths,
+			&i.MonthlyPayment,
+			&i.RemainingBalance,
+			&i.Status,
+			&i.CreatedAt,
+			&i.MarginRate,
+			&i.RepaymentMethod,
+			&i.DaysPastDue,
+			&i.ContractNumber,
+			&i.OwnershipTransferredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const transferLoanOwnership = `-- name: TransferLoanOwnership :one
+update loans
+set ownership_transferred_at = $2
+where id = $1
+returning id, application_id, user_id, vehicle_vin, currency_code, amount, term_months, monthly_payment, remaining_balance, status, created_at, margin_rate, repayment_method, days_past_due, contract_number, ownership_transferred_at
+`
+
+type TransferLoanOwnershipParams struct {
+	ID                     int64      `json:"id"`
+	OwnershipTransferredAt *time.Time `json:"ownership_transferred_at"`
+}
+
+func (q *Queries) TransferLoanOwnership(ctx context.Context, arg TransferLoanOwnershipParams) (Loan, error) {
+	row := q.db.QueryRow(ctx, transferLoanOwnership, arg.ID, arg.OwnershipTransferredAt)
+	var i Loan
+	err := row.Scan(
+		&i.ID,
+		&i.ApplicationID,
+		&i.UserID,
+		&i.VehicleVin,
+		&i.CurrencyCode,
+		&i.Amount,
+		&i.TermMonths,
+		&i.MonthlyPayment,
+		&i.RemainingBalance,
+		&i.Status,
+		&i.CreatedAt,
+		&i.MarginRate,
+		&i.RepaymentMethod,
+		&i.DaysPastDue,
+		&i.ContractNumber,
+		&i.OwnershipTransferredAt,
+	)
+	return i, err
+}
+
+const updateLoanBalance = `-- name: UpdateLoanBalance :one
+update loans
+set remaining_balance = $2,
+    status = $3
+where id = $1
+returning id, application_id, user_id, vehicle_vin, currency_code, amount, term_months, monthly_payment, remaining_balance, status, created_at, margin_rate, repayment_method, days_past_due, contract_number, ownership_transferred_at
+`
+
+type UpdateLoanBalanceParams struct {
+	ID               int64          `json:"id"`
+	RemainingBalance *money.Amount  `json:"remaining_balance"`
+	Status           NullLoanStatus `json:"status"`
+}
+
+func (q *Queries) UpdateLoanBalance(ctx context.Context, arg UpdateLoanBalanceParams) (Loan, error) {
+	row := q.db.QueryRow(ctx, updateLoanBalance, arg.ID, arg.RemainingBalance, arg.Status)
+	var i Loan
+	err := row.Scan(
+		&i.ID,
+		&i.ApplicationID,
+		&i.UserID,
+		&i.VehicleVin,
+		&i.CurrencyCode,
+		&i.Amount,
+		&i.TermMonths,
+		&i.MonthlyPayment,
+		&i.RemainingBalance,
+		&i.Status,
+		&i.CreatedAt,
+		&i.MarginRate,
+		&i.RepaymentMethod,
+		&i.DaysPastDue,
+		&i.ContractNumber,
+		&i.OwnershipTransferredAt,
+	)
+	return i, err
+}
+
+const updateLoanSchedule = `-- name: UpdateLoanSchedule :one
+update loans
+set term_months = $2,
+    monthly_payment = $3,
+    remaining_balance = $4,
+    status = $5
+where id = $1
+returning id, application_id, user_id, vehicle_vin, currency_code, amount, term_months, monthly_payment, remaining_balance, status, created_at, margin_rate, repayment_method, days_past_due, contract_number, ownership_transferred_at
+`
+
+type UpdateLoanScheduleParams struct {
+	ID               int64          `json:"id"`
+	TermMonths       *int64         `json:"term_months"`
+	MonthlyPayment   *money.Amount  `json:"monthly_payment"`
+	RemainingBalance *money.Amount  `json:"remaining_balance"`
+	Status           NullLoanStatus `json:"status"`
+}
+
+func (q *Queries) UpdateLoanSchedule(ctx context.Context, arg UpdateLoanScheduleParams) (Loan, error) {
+	row := q.db.QueryRow(ctx, updateLoanSchedule,
+		arg.ID,
+		arg.TermMonths,
+		arg.MonthlyPayment,
+		arg.RemainingBalance,
+		arg.Status,
+	)
+	var i Loan
+	err := row.Scan(
+		&i.ID,
+		&i.ApplicationID,
+		&i.UserID,
+		&i.VehicleVin,
+		&i.CurrencyCode,
+		&i.Amount,
+		&i.TermMonths,
+		&i.MonthlyPayment,
+		&i.RemainingBalance,
+		&i.Status,
+		&i.CreatedAt,
+		&i.MarginRate,
+		&i.RepaymentMethod,
+		&i.DaysPastDue,
+		&i.ContractNumber,
+		&i.OwnershipTransferredAt,
+	)
+	return i, err
+}
+
+const updateLoansDaysPastDue = `-- name: UpdateLoansDaysPastDue :execrows
+update loans
+set days_past_due = arrears.days_past_due
+from (
+  select loan_id, ($1::timestamp::date - min(due_date)::date) as days_past_due
+  from installments
+  where status <> 'PAID' and due_date < date_trunc('day', $1::timestamp)
+  group by loan_id
+) arrears
+where loans.id = arrears.loan_id and loans.status = 'OVERDUE'
+`
+
+func (q *Queries) UpdateLoansDaysPastDue(ctx context.Context, asOf time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, updateLoansDaysPastDue, asOf)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
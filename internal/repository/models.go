@@ -8,6 +8,8 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"time"
+
+	"loan_service/pkg/money"
 )
 
 type ApplicationStatus string
@@ -17,6 +19,7 @@ const (
 	ApplicationStatusREVIEW   ApplicationStatus = "REVIEW"
 	ApplicationStatusAPPROVED ApplicationStatus = "APPROVED"
 	ApplicationStatusREJECTED ApplicationStatus = "REJECTED"
+	ApplicationStatusISSUED   ApplicationStatus = "ISSUED"
 )
 
 func (e *ApplicationStatus) Scan(src interface{}) error {
@@ -96,6 +99,92 @@ func (ns NullApplicationType) Value() (driver.Value, error) {
 	return string(ns.ApplicationType), nil
 }
 
+type ChargeType string
+
+const (
+	ChargeTypeLATEFEE ChargeType = "LATE_FEE"
+	ChargeTypePENALTY ChargeType = "PENALTY"
+)
+
+func (e *ChargeType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ChargeType(s)
+	case string:
+		*e = ChargeType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ChargeType: %T", src)
+	}
+	return nil
+}
+
+type NullChargeType struct {
+	ChargeType ChargeType `json:"charge_type"`
+	Valid      bool       `json:"valid"` // Valid is true if ChargeType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullChargeType) Scan(value interface{}) error {
+	if value == nil {
+		ns.ChargeType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ChargeType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullChargeType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ChargeType), nil
+}
+
+type InstallmentStatus string
+
+const (
+	InstallmentStatusPENDING InstallmentStatus = "PENDING"
+	InstallmentStatusPARTIAL InstallmentStatus = "PARTIAL"
+	InstallmentStatusPAID    InstallmentStatus = "PAID"
+	InstallmentStatusOVERDUE InstallmentStatus = "OVERDUE"
+)
+
+func (e *InstallmentStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InstallmentStatus(s)
+	case string:
+		*e = InstallmentStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InstallmentStatus: %T", src)
+	}
+	return nil
+}
+
+type NullInstallmentStatus struct {
+	InstallmentStatus InstallmentStatus `json:"installment_status"`
+	Valid             bool              `json:"valid"` // Valid is true if InstallmentStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullInstallmentStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.InstallmentStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.InstallmentStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullInstallmentStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.InstallmentStatus), nil
+}
+
 type LoanStatus string
 
 const (
@@ -139,34 +228,380 @@ func (ns NullLoanStatus) Value() (driver.Value, error) {
 	return string(ns.LoanStatus), nil
 }
 
+type ReconciliationIssueKind string
+
+const (
+	ReconciliationIssueKindBALANCEMISMATCH  ReconciliationIssueKind = "BALANCE_MISMATCH"
+	ReconciliationIssueKindSTATUSMISMATCH   ReconciliationIssueKind = "STATUS_MISMATCH"
+	ReconciliationIssueKindCONTRACTNOTFOUND ReconciliationIssueKind = "CONTRACT_NOT_FOUND"
+)
+
+func (e *ReconciliationIssueKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReconciliationIssueKind(s)
+	case string:
+		*e = ReconciliationIssueKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReconciliationIssueKind: %T", src)
+	}
+	return nil
+}
+
+type NullReconciliationIssueKind struct {
+	ReconciliationIssueKind ReconciliationIssueKind `json:"reconciliation_issue_kind"`
+	Valid                   bool                    `json:"valid"` // Valid is true if ReconciliationIssueKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReconciliationIssueKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReconciliationIssueKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReconciliationIssueKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReconciliationIssueKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReconciliationIssueKind), nil
+}
+
+type ReconciliationIssueStatus string
+
+const (
+	ReconciliationIssueStatusOPEN      ReconciliationIssueStatus = "OPEN"
+	ReconciliationIssueStatusCORRECTED ReconciliationIssueStatus = "CORRECTED"
+	ReconciliationIssueStatusRESOLVED  ReconciliationIssueStatus = "RESOLVED"
+)
+
+func (e *ReconciliationIssueStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReconciliationIssueStatus(s)
+	case string:
+		*e = ReconciliationIssueStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReconciliationIssueStatus: %T", src)
+	}
+	return nil
+}
+
+type NullReconciliationIssueStatus struct {
+	ReconciliationIssueStatus ReconciliationIssueStatus `json:"reconciliation_issue_status"`
+	Valid                     bool                      `json:"valid"` // Valid is true if ReconciliationIssueStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReconciliationIssueStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReconciliationIssueStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReconciliationIssueStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReconciliationIssueStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReconciliationIssueStatus), nil
+}
+
+type RepaymentMethod string
+
+const (
+	RepaymentMethodFLAT           RepaymentMethod = "FLAT"
+	RepaymentMethodANNUITY        RepaymentMethod = "ANNUITY"
+	RepaymentMethodDIFFERENTIATED RepaymentMethod = "DIFFERENTIATED"
+	RepaymentMethodMURABAHA       RepaymentMethod = "MURABAHA"
+	RepaymentMethodIJARA          RepaymentMethod = "IJARA"
+)
+
+func (e *RepaymentMethod) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RepaymentMethod(s)
+	case string:
+		*e = RepaymentMethod(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RepaymentMethod: %T", src)
+	}
+	return nil
+}
+
+type NullRepaymentMethod struct {
+	RepaymentMethod RepaymentMethod `json:"repayment_method"`
+	Valid           bool            `json:"valid"` // Valid is true if RepaymentMethod is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRepaymentMethod) Scan(value interface{}) error {
+	if value == nil {
+		ns.RepaymentMethod, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RepaymentMethod.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRepaymentMethod) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RepaymentMethod), nil
+}
+
+type VehicleHoldStatus string
+
+const (
+	VehicleHoldStatusACTIVE   VehicleHoldStatus = "ACTIVE"
+	VehicleHoldStatusRELEASED VehicleHoldStatus = "RELEASED"
+	VehicleHoldStatusEXPIRED  VehicleHoldStatus = "EXPIRED"
+	VehicleHoldStatusSOLD     VehicleHoldStatus = "SOLD"
+)
+
+func (e *VehicleHoldStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = VehicleHoldStatus(s)
+	case string:
+		*e = VehicleHoldStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for VehicleHoldStatus: %T", src)
+	}
+	return nil
+}
+
+type NullVehicleHoldStatus struct {
+	VehicleHoldStatus VehicleHoldStatus `json:"vehicle_hold_status"`
+	Valid             bool              `json:"valid"` // Valid is true if VehicleHoldStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullVehicleHoldStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.VehicleHoldStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.VehicleHoldStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullVehicleHoldStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.VehicleHoldStatus), nil
+}
+
+type ApplicationStatusHistory struct {
+	ID            int64             `json:"id"`
+	ApplicationID int64             `json:"application_id"`
+	FromStatus    ApplicationStatus `json:"from_status"`
+	ToStatus      ApplicationStatus `json:"to_status"`
+	Reason        *string           `json:"reason"`
+	Actor         string            `json:"actor"`
+	CreatedAt     *time.Time        `json:"created_at"`
+}
+
+type ExchangeRate struct {
+	ID            int64      `json:"id"`
+	BaseCurrency  string     `json:"base_currency"`
+	QuoteCurrency string     `json:"quote_currency"`
+	Rate          float64    `json:"rate"`
+	EffectiveAt   time.Time  `json:"effective_at"`
+	CreatedAt     *time.Time `json:"created_at"`
+}
+
+type Installment struct {
+	ID            int64             `json:"id"`
+	LoanID        int64             `json:"loan_id"`
+	Number        int64             `json:"number"`
+	DueDate       time.Time         `json:"due_date"`
+	PrincipalDue  money.Amount      `json:"principal_due"`
+	MarginDue     money.Amount      `json:"margin_due"`
+	PrincipalPaid money.Amount      `json:"principal_paid"`
+	MarginPaid    money.Amount      `json:"margin_paid"`
+	Status        InstallmentStatus `json:"status"`
+	PaidAt        *time.Time        `json:"paid_at"`
+	CreatedAt     *time.Time        `json:"created_at"`
+	UpdatedAt     *time.Time        `json:"updated_at"`
+}
+
 type Loan struct {
-	ID               int64          `json:"id"`
-	ApplicationID    int64          `json:"application_id"`
-	UserID           int64          `json:"user_id"`
-	VehicleVin       *string        `json:"vehicle_vin"`
-	CurrencyCode     string         `json:"currency_code"`
-	Amount           *float64       `json:"amount"`
-	TermMonths       *int64         `json:"term_months"`
-	MonthlyPayment   *float64       `json:"monthly_payment"`
-	RemainingBalance *float64       `json:"remaining_balance"`
-	Status           NullLoanStatus `json:"status"`
-	CreatedAt        *time.Time     `json:"created_at"`
+	ID                     int64           `json:"id"`
+	ApplicationID          int64           `json:"application_id"`
+	UserID                 int64           `json:"user_id"`
+	VehicleVin             *string         `json:"vehicle_vin"`
+	CurrencyCode           string          `json:"currency_code"`
+	Amount                 *money.Amount   `json:"amount"`
+	TermMonths             *int64          `json:"term_months"`
+	MonthlyPayment         *money.Amount   `json:"monthly_payment"`
+	RemainingBalance       *money.Amount   `json:"remaining_balance"`
+	Status                 NullLoanStatus  `json:"status"`
+	CreatedAt              *time.Time      `json:"created_at"`
+	MarginRate             *float64        `json:"margin_rate"`
+	RepaymentMethod        RepaymentMethod `json:"repayment_method"`
+	DaysPastDue            int64           `json:"days_past_due"`
+	ContractNumber         *string         `json:"contract_number"`
+	OwnershipTransferredAt *time.Time      `json:"ownership_transferred_at"`
+}
+
+type LoanCharge struct {
+	ID            int64        `json:"id"`
+	LoanID        int64        `json:"loan_id"`
+	InstallmentID *int64       `json:"installment_id"`
+	Type          ChargeType   `json:"type"`
+	Amount        money.Amount `json:"amount"`
+	PaidAmount    money.Amount `json:"paid_amount"`
+	AccruedOn     time.Time    `json:"accrued_on"`
+	CreatedAt     *time.Time   `json:"created_at"`
+	UpdatedAt     *time.Time   `json:"updated_at"`
+	Charity       bool         `json:"charity"`
 }
 
 type LoanApplication struct {
-	ID             int64                 `json:"id"`
-	UserID         int64                 `json:"user_id"`
-	Type           ApplicationType       `json:"type"`
-	VehicleVin     *string               `json:"vehicle_vin"`
-	VehicleName    *string               `json:"vehicle_name"`
-	CurrencyCode   string                `json:"currency_code"`
-	Price          *float64              `json:"price"`
-	DownPayment    *float64              `json:"down_payment"`
-	NetPrice       *float64              `json:"net_price"`
-	MarginRate     *float64              `json:"margin_rate"`
-	TermMonths     *int64                `json:"term_months"`
-	MonthlyPayment *float64              `json:"monthly_payment"`
-	Status         NullApplicationStatus `json:"status"`
-	CreatedAt      *time.Time            `json:"created_at"`
-	UpdatedAt      *time.Time            `json:"updated_at"`
+	ID                              int64                 `json:"id"`
+	UserID                          int64                 `json:"user_id"`
+	Type                            ApplicationType       `json:"type"`
+	VehicleVin                      *string               `json:"vehicle_vin"`
+	VehicleName                     *string               `json:"vehicle_name"`
+	CurrencyCode                    string                `json:"currency_code"`
+	Price                           *money.Amount         `json:"price"`
+	DownPayment                     *money.Amount         `json:"down_payment"`
+	NetPrice                        *money.Amount         `json:"net_price"`
+	MarginRate                      *float64              `json:"margin_rate"`
+	TermMonths                      *int64                `json:"term_months"`
+	MonthlyPayment                  *money.Amount         `json:"monthly_payment"`
+	Status                          NullApplicationStatus `json:"status"`
+	CreatedAt                       *time.Time            `json:"created_at"`
+	UpdatedAt                       *time.Time            `json:"updated_at"`
+	RepaymentMethod                 RepaymentMethod       `json:"repayment_method"`
+	VehiclePrice                    *money.Amount         `json:"vehicle_price"`
+	VehicleCurrencyCode             *string               `json:"vehicle_currency_code"`
+	ExchangeRate                    *float64              `json:"exchange_rate"`
+	ContractNumber                  *string               `json:"contract_number"`
+	DealerNotificationStatus        string                `json:"dealer_notification_status"`
+	DealerNotificationAttempts      int64                 `json:"dealer_notification_attempts"`
+	DealerNotificationError         *string               `json:"dealer_notification_error"`
+	DealerNotificationNextAttemptAt time.Time             `json:"dealer_notification_next_attempt_at"`
+	DealerNotifiedAt                *time.Time            `json:"dealer_notified_at"`
+	ProductID                       *int64                `json:"product_id"`
+	OriginationClaimedUntil         *time.Time            `json:"origination_claimed_until"`
+}
+
+type LoanProduct struct {
+	ID                    int64           `json:"id"`
+	Code                  string          `json:"code"`
+	Name                  string          `json:"name"`
+	ApplicationType       ApplicationType `json:"application_type"`
+	CurrencyCode          string          `json:"currency_code"`
+	RepaymentMethod       RepaymentMethod `json:"repayment_method"`
+	TermMonths            []int64         `json:"term_months"`
+	MinAmount             money.Amount    `json:"min_amount"`
+	MaxAmount             money.Amount    `json:"max_amount"`
+	MinDownPaymentPercent float64         `json:"min_down_payment_percent"`
+	Active                bool            `json:"active"`
+	CreatedAt             time.Time       `json:"created_at"`
+	UpdatedAt             time.Time       `json:"updated_at"`
+}
+
+type LoanProductRate struct {
+	ID                    int64   `json:"id"`
+	ProductID             int64   `json:"product_id"`
+	MaxTermMonths         int64   `json:"max_term_months"`
+	MinDownPaymentPercent float64 `json:"min_down_payment_percent"`
+	MarginRate            float64 `json:"margin_rate"`
+}
+
+type Outbox struct {
+	ID            int64      `json:"id"`
+	EventID       string     `json:"event_id"`
+	EventType     string     `json:"event_type"`
+	AggregateType string     `json:"aggregate_type"`
+	AggregateID   int64      `json:"aggregate_id"`
+	Payload       []byte     `json:"payload"`
+	Attempts      int64      `json:"attempts"`
+	LastError     *string    `json:"last_error"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	PublishedAt   *time.Time `json:"published_at"`
+	CreatedAt     *time.Time `json:"created_at"`
+	EventVersion  int64      `json:"event_version"`
+	CorrelationID *string    `json:"correlation_id"`
+}
+
+type Payment struct {
+	ID            int64         `json:"id"`
+	LoanID        int64         `json:"loan_id"`
+	PaymentDate   *time.Time    `json:"payment_date"`
+	Amount        *money.Amount `json:"amount"`
+	CurrencyCode  string        `json:"currency_code"`
+	Method        *string       `json:"method"`
+	Status        *string       `json:"status"`
+	TransactionID *string       `json:"transaction_id"`
+	CreatedAt     *time.Time    `json:"created_at"`
+}
+
+type PayoffQuote struct {
+	ID               int64        `json:"id"`
+	LoanID           int64        `json:"loan_id"`
+	Principal        money.Amount `json:"principal"`
+	Margin           money.Amount `json:"margin"`
+	MarginWaived     money.Amount `json:"margin_waived"`
+	Charges          money.Amount `json:"charges"`
+	SettlementFee    money.Amount `json:"settlement_fee"`
+	Total            money.Amount `json:"total"`
+	RemainingBalance money.Amount `json:"remaining_balance"`
+	ValidUntil       time.Time    `json:"valid_until"`
+	Status           string       `json:"status"`
+	PaymentID        *int64       `json:"payment_id"`
+	CreatedAt        *time.Time   `json:"created_at"`
+	SettledAt        *time.Time   `json:"settled_at"`
+}
+
+type ReconciliationIssue struct {
+	ID             int64                     `json:"id"`
+	RunID          int64                     `json:"run_id"`
+	LoanID         int64                     `json:"loan_id"`
+	ContractNumber string                    `json:"contract_number"`
+	CurrencyCode   string                    `json:"currency_code"`
+	Kind           ReconciliationIssueKind   `json:"kind"`
+	Status         ReconciliationIssueStatus `json:"status"`
+	LocalBalance   money.Amount              `json:"local_balance"`
+	RemoteBalance  *money.Amount             `json:"remote_balance"`
+	LocalStatus    string                    `json:"local_status"`
+	RemoteStatus   *string                   `json:"remote_status"`
+	DetectedAt     time.Time                 `json:"detected_at"`
+	ResolvedAt     *time.Time                `json:"resolved_at"`
+}
+
+type ReconciliationRun struct {
+	ID           int64      `json:"id"`
+	StartedAt    time.Time  `json:"started_at"`
+	FinishedAt   *time.Time `json:"finished_at"`
+	LoansChecked int64      `json:"loans_checked"`
+	IssuesFound  int64      `json:"issues_found"`
+	Corrected    int64      `json:"corrected"`
+	Failed       int64      `json:"failed"`
+	Error        *string    `json:"error"`
+}
+
+type VehicleHold struct {
+	ID            int64             `json:"id"`
+	ApplicationID int64             `json:"application_id"`
+	VehicleVin    string            `json:"vehicle_vin"`
+	KoinotHoldID  string            `json:"koinot_hold_id"`
+	Status        VehicleHoldStatus `json:"status"`
+	ExpiresAt     time.Time         `json:"expires_at"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
 }
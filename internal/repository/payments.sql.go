@@ -8,6 +8,8 @@ package repository
 import (
 	"context"
 	"time"
+
+	"loan_service/pkg/money"
 )
 
 const countPayments = `-- name: CountPayments :one
@@ -33,17 +35,18 @@ INSERT INTO payments(
   status,
   transaction_id
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $6
+  $1, $2, $3, $4, $5, $6, $7
 ) RETURNING id, loan_id, payment_date, amount, currency_code, method, status, transaction_id, created_at
 `
 
 type CreatePaymentParams struct {
-	LoanID       int64      `json:"loan_id"`
-	CurrencyCode string     `json:"currency_code"`
-	PaymentDate  *time.Time `json:"payment_date"`
-	Amount       *float64   `json:"amount"`
-	Method       *string    `json:"method"`
-	Status       *string    `json:"status"`
+	LoanID        int64         `json:"loan_id"`
+	CurrencyCode  string        `json:"currency_code"`
+	PaymentDate   *time.Time    `json:"payment_date"`
+	Amount        *money.Amount `json:"amount"`
+	Method        *string       `json:"method"`
+	Status        *string       `json:"status"`
+	TransactionID *string       `json:"transaction_id"`
 }
 
 func (q *Queries) CreatePayment(ctx context.Context, arg CreatePaymentParams) (Payment, error) {
@@ -54,7 +57,54 @@ func (q *Queries) CreatePayment(ctx context.Context, arg CreatePaymentParams) (P
 		arg.Amount,
 		arg.Method,
 		arg.Status,
+		arg.TransactionID,
+	)
+	var i Payment
+	err := row.Scan(
+		&i.ID,
+		&i.LoanID,
+		&i.PaymentDate,
+		&i.Amount,
+		&i.CurrencyCode,
+		&i.Method,
+		&i.Status,
+		&i.TransactionID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPayment = `-- name: GetPayment :one
+select id, loan_id, payment_date, amount, currency_code, method, status, transaction_id, created_at
+from payments
+where id = $1
+`
+
+func (q *Queries) GetPayment(ctx context.Context, id int64) (Payment, error) {
+	row := q.db.QueryRow(ctx, getPayment, id)
+	var i Payment
+	err := row.Scan(
+		&i.ID,
+		&i.LoanID,
+		&i.PaymentDate,
+		&i.Amount,
+		&i.CurrencyCode,
+		&i.Method,
+		&i.Status,
+		&i.TransactionID,
+		&i.CreatedAt,
 	)
+	return i, err
+}
+
+const getPaymentByTransactionID = `-- name: GetPaymentByTransactionID :one
+select id, loan_id, payment_date, amount, currency_code, method, status, transaction_id, created_at
+from payments
+where transaction_id = $1
+`
+
+func (q *Queries) GetPaymentByTransactionID(ctx context.Context, transactionID *string) (Payment, error) {
+	row := q.db.QueryRow(ctx, getPaymentByTransactionID, transactionID)
 	var i Payment
 	err := row.Scan(
 		&i.ID,
@@ -114,3 +164,34 @@ func (q *Queries) ListPaymentsByLoan(ctx context.Context, arg ListPaymentsByLoan
 	}
 	return items, nil
 }
+
+const updatePayment = `-- name: UpdatePayment :one
+update payments
+set status = $2,
+    payment_date = $3
+where id = $1
+returning id, loan_id, payment_date, amount, currency_code, method, status, transaction_id, created_at
+`
+
+type UpdatePaymentParams struct {
+	ID          int64      `json:"id"`
+	Status      *string    `json:"status"`
+	PaymentDate *time.Time `json:"payment_date"`
+}
+
+func (q *Queries) UpdatePayment(ctx context.Context, arg UpdatePaymentParams) (Payment, error) {
+	row := q.db.QueryRow(ctx, updatePayment, arg.ID, arg.Status, arg.PaymentDate)
+	var i Payment
+	err := row.Scan(
+		&i.ID,
+		&i.LoanID,
+		&i.PaymentDate,
+		&i.Amount,
+		&i.CurrencyCode,
+		&i.Method,
+		&i.Status,
+		&i.TransactionID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"loan_service/internal/repository"
+	"loan_service/pkg/money"
+	"slices"
+	"time"
+)
+
+type allocationComponent string
+
+const (
+	componentPenalties allocationComponent = "penalties"
+	componentMargin    allocationComponent = "margin"
+	componentPrincipal allocationComponent = "principal"
+)
+
+var defaultAllocationOrder = []allocationComponent{componentPenalties, componentMargin, componentPrincipal}
+
+// parseAllocationOrder checks that order lists every component exactly once.
+// An empty order selects penalties → margin → principal.
+func parseAllocationOrder(order []string) ([]allocationComponent, error) {
+	if len(order) == 0 {
+		return defaultAllocationOrder, nil
+	}
+
+	result := make([]allocationComponent, len(order))
+	for index, component := range order {
+		result[index] = allocationComponent(component)
+	}
+
+	if len(result) != len(defaultAllocationOrder) {
+		return nil, fmt.Errorf("allocation order must list %v exactly once, got %v", defaultAllocationOrder, order)
+	}
+	for _, component := range defaultAllocationOrder {
+		if !slices.Contains(result, component) {
+			return nil, fmt.Errorf("allocation order must list %v exactly once, got %v", defaultAllocationOrder, order)
+		}
+	}
+
+	return result, nil
+}
+
+// allocatePayment spreads amount over the open installments of a loan, oldest
+// first, settling the components of each installment in the configured order.
+// Penalties stand for all unpaid charges of the loan, oldest first; whatever
+// is left once every installment is covered also goes to the charges.
+// It returns the part of amount that went to charges and, of that, the part
+// owed to charity.
+func (uc *LoanUsecase) allocatePayment(ctx context.Context, qtx *repository.Queries, loanId int64, amount money.Amount, paidAt time.Time) (money.Amount, money.Amount, error) {
+	charges, err := qtx.ListOpenLoanChargesForUpdate(ctx, loanId)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get open charges from db: %w", err)
+	}
+
+	installments, err := qtx.ListOpenInstallmentsForUpdate(ctx, loanId)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get open installments from db: %w", err)
+	}
+
+	var toCharges, toCharity money.Amount
+	payCharges := func() error {
+		for index := range charges {
+			if amount == 0 {
+				return nil
+			}
+
+			charge := &charges[index]
+			take := min(amount, charge.Amount-charge.PaidAmount)
+			if take == 0 {
+				continue
+			}
+
+			charge.PaidAmount += take
+			amount -= take
+			toCharges += take
+			if charge.Charity {
+				toCharity += take
+			}
+
+			err := qtx.UpdateLoanChargePayment(ctx, repository.UpdateLoanChargePaymentParams{
+				ID:         charge.ID,
+				PaidAmount: charge.PaidAmount,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update loan charge in db: %w", err)
+			}
+		}
+
+		return nil
+	}
+
+	for _, installment := range installments {
+		if amount == 0 {
+			break
+		}
+
+		marginPaid := installment.MarginPaid
+		principalPaid := installment.PrincipalPaid
+
+		for _, component := range uc.allocationOrder {
+			switch component {
+			case componentPenalties:
+				if err := payCharges(); err != nil {
+					return 0, 0, err
+				}
+			case componentMargin:
+				take := min(amount, installment.MarginDue-marginPaid)
+				marginPaid += take
+				amount -= take
+			case componentPrincipal:
+				take := min(amount, installment.PrincipalDue-principalPaid)
+				principalPaid += take
+				amount -= take
+			}
+		}
+
+		if marginPaid == installment.MarginPaid && principalPaid == installment.PrincipalPaid {
+			continue
+		}
+
+		status := installment.Status
+		paidAtPtr := installment.PaidAt
+		switch {
+		case marginPaid == installment.MarginDue && principalPaid == installment.PrincipalDue:
+			status = repository.InstallmentStatusPAID
+			paidAtPtr = &paidAt
+		case status == repository.InstallmentStatusPENDING:
+			// An overdue installment stays OVERDUE until it is paid in full.
+			status = repository.InstallmentStatusPARTIAL
+		}
+
+		_, err := qtx.UpdateInstallmentPayment(ctx, repository.UpdateInstallmentPaymentParams{
+			ID:            installment.ID,
+			PrincipalPaid: principalPaid,
+			MarginPaid:    marginPaid,
+			Status:        status,
+			PaidAt:        paidAtPtr,
+		})
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to update installment in db: %w", err)
+		}
+	}
+
+	if err := payCharges(); err != nil {
+		return 0, 0, err
+	}
+
+	return toCharges, toCharity, nil
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseAllocationOrder(t *testing.T) {
+	order, err := parseAllocationOrder(nil)
+	if err != nil || !slices.Equal(order, defaultAllocationOrder) {
+		t.Errorf("parseAllocationOrder(nil) = %v, %v, want %v", order, err, defaultAllocationOrder)
+	}
+
+	order, err = parseAllocationOrder([]string{"principal", "margin", "penalties"})
+	if err != nil {
+		t.Fatalf("parseAllocationOrder: %v", err)
+	}
+	if want := []allocationComponent{componentPrincipal, componentMargin, componentPenalties}; !slices.Equal(order, want) {
+		t.Errorf("parseAllocationOrder = %v, want %v", order, want)
+	}
+
+	rejected := [][]string{
+		{"penalties", "margin"},
+		{"penalties", "margin", "margin"},
+		{"penalties", "margin", "principal", "principal"},
+		{"penalties", "margin", "interest"},
+	}
+	for _, order := range rejected {
+		if _, err := parseAllocationOrder(order); err == nil {
+			t.Errorf("parseAllocationOrder(%v) succeeded", order)
+		}
+	}
+}
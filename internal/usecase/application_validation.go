@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"loan_service/internal/calculator"
+	"loan_service/internal/dto"
+	"loan_service/pkg/money"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidApplication = errors.New("invalid application")
+	ErrInvalidQuote       = errors.New("invalid quote")
+)
+
+// percentEpsilon absorbs float noise when a margin rate or a down payment
+// percentage is compared with the product's.
+const percentEpsilon = 1e-9
+
+// ValidationError lists every field of a request that failed validation, so
+// the client can fix them all at once.
+type ValidationError struct {
+	Err    error
+	Fields []dto.FieldError
+}
+
+func (e *ValidationError) Error() string {
+	descriptions := make([]string, len(e.Fields))
+	for index, field := range e.Fields {
+		descriptions[index] = field.Field + ": " + field.Description
+	}
+
+	return fmt.Sprintf("%s: %s", e.Err, strings.Join(descriptions, "; "))
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// fieldErrors collects the field errors of a request as it is validated.
+type fieldErrors []dto.FieldError
+
+func (f *fieldErrors) add(field, format string, args ...any) {
+	*f = append(*f, dto.FieldError{Field: field, Description: fmt.Sprintf(format, args...)})
+}
+
+// err returns a ValidationError wrapping sentinel, or nil if no field failed.
+func (f fieldErrors) err(sentinel error) error {
+	if len(f) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Err: sentinel, Fields: f}
+}
+
+// productFor loads the product a quote or an application is financed under.
+// Without a product id the product <type>_<currency> is used, e.g. AUTO_TJS,
+// as requests made before products existed carry no product. Only an active
+// product finances anything, and only in its own currency. Field errors are
+// reported under sentinel.
+func (uc *LoanUsecase) productFor(ctx context.Context, productId int64, applicationType, currencyCode string, sentinel error) (*dto.LoanProduct, error) {
+	var fields fieldErrors
+
+	var product *dto.LoanProduct
+	var err error
+	if productId != 0 {
+		product, err = uc.GetProduct(ctx, productId)
+	} else {
+		if applicationType == "" {
+			fields.add("type", "is required without a product_id")
+			return nil, fields.err(sentinel)
+		}
+		product, err = uc.defaultProduct(ctx, applicationType, currencyCode)
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			if productId != 0 {
+				fields.add("product_id", "no product %d", productId)
+			} else {
+				fields.add("product_id", "is required, there is no default %s product in %s", applicationType, currencyCode)
+			}
+			return nil, fields.err(sentinel)
+		}
+		return nil, err
+	}
+
+	switch {
+	case !product.Active:
+		fields.add("product_id", "product %s is no longer offered", product.Code)
+	case currencyCode != product.CurrencyCode:
+		fields.add("currency_code", "must be %s for product %s", product.CurrencyCode, product.Code)
+	}
+
+	if len(fields) > 0 {
+		return nil, fields.err(sentinel)
+	}
+
+	return product, nil
+}
+
+// defaultProduct returns the product applications of a type in a currency are
+// financed on when they name none.
+func (uc *LoanUsecase) defaultProduct(ctx context.Context, applicationType, currencyCode string) (*dto.LoanProduct, error) {
+	product, err := uc.queries.GetLoanProductByCode(ctx, applicationType+"_"+currencyCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan product from db: %w", err)
+	}
+
+	rates, err := uc.queries.ListLoanProductRates(ctx, []int64{product.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan product rates from db: %w", err)
+	}
+
+	return productFromRow(product, rates), nil
+}
+
+// financing is what a product is asked to finance: a price in the currency of
+// the product, less a down payment, over a term.
+type financing struct {
+	repaymentMethod string
+	price           money.Amount
+	downPayment     money.Amount
+	termMonths      int32
+	marginRate      float64
+}
+
+// checkFinancing checks f against the terms of product. A repayment method or
+// margin rate left out is taken from the product and its rate grid.
+func checkFinancing(product *dto.LoanProduct, f *financing) fieldErrors {
+	var fields fieldErrors
+
+	if f.repaymentMethod == "" {
+		f.repaymentMethod = product.RepaymentMethod
+	}
+	if f.repaymentMethod != product.RepaymentMethod {
+		fields.add("repayment_method", "must be %s for product %s", product.RepaymentMethod, product.Code)
+	}
+
+	currencyCode := product.CurrencyCode
+	price := money.New(f.price, currencyCode)
+	minDownPayment := money.New(money.Lookup(currencyCode).Round(float64(f.price)*product.MinDownPaymentPercent/100), currencyCode)
+
+	switch {
+	case f.price <= 0:
+		fields.add("price", "must be positive")
+	case f.downPayment < 0:
+		fields.add("down_payment", "must not be negative")
+	case f.downPayment >= f.price:
+		fields.add("down_payment", "must be below the price %s", price)
+	case f.downPayment < minDownPayment.Amount:
+		fields.add("down_payment", "must be at least %s, %g%% of the price %s", minDownPayment, product.MinDownPaymentPercent, price)
+	}
+
+	if !slices.Contains(product.TermMonths, f.termMonths) {
+		fields.add("term_months", "must be one of %v months for product %s", product.TermMonths, product.Code)
+	}
+
+	// The rate can only be looked up for valid terms.
+	if len(fields) > 0 {
+		return fields
+	}
+
+	netPrice := f.price - f.downPayment
+	if netPrice < product.MinAmount || netPrice > product.MaxAmount {
+		fields.add("net_price", "must be from %s to %s for product %s", money.New(product.MinAmount, currencyCode), money.New(product.MaxAmount, currencyCode), product.Code)
+	}
+
+	downPaymentPercent := float64(f.downPayment) / float64(f.price) * 100
+	rate, ok := productRate(product, f.termMonths, downPaymentPercent)
+	if !ok {
+		fields.add("term_months", "product %s has no rate for %d months with a %.2f%% down payment", product.Code, f.termMonths, downPaymentPercent)
+		return fields
+	}
+
+	if f.marginRate == 0 {
+		f.marginRate = rate
+	}
+	if math.Abs(f.marginRate-rate) > percentEpsilon {
+		fields.add("margin_rate", "must be %g%% for %d months with a %.2f%% down payment", rate, f.termMonths, downPaymentPercent)
+	}
+
+	return fields
+}
+
+// validateApplicationFigures checks an application against the terms of its
+// product and recomputes its figures at the rate the product grid gives it,
+// checking them against the figures the client submitted. A type, repayment
+// method or margin rate left out is taken from the product. On success the
+// application carries the service's figures.
+func (uc *LoanUsecase) validateApplicationFigures(loanApp *dto.LoanApplication, product *dto.LoanProduct) error {
+	var fields fieldErrors
+
+	if loanApp.Type == "" {
+		loanApp.Type = product.ApplicationType
+	}
+	if loanApp.Type != product.ApplicationType {
+		fields.add("type", "must be %s for product %s", product.ApplicationType, product.Code)
+	}
+
+	f := financing{
+		repaymentMethod: loanApp.RepaymentMethod,
+		price:           loanApp.Price,
+		downPayment:     loanApp.DownPayment,
+		termMonths:      loanApp.TermMonths,
+		marginRate:      loanApp.MarginRate,
+	}
+	fields = append(fields, checkFinancing(product, &f)...)
+	loanApp.RepaymentMethod = f.repaymentMethod
+	loanApp.MarginRate = f.marginRate
+
+	// The figures can only be recomputed from valid terms.
+	if len(fields) > 0 {
+		return fields.err(ErrInvalidApplication)
+	}
+
+	schedule, err := uc.CalculateSchedule(loanApp.RepaymentMethod, loanApp.CurrencyCode, loanApp.Price, loanApp.DownPayment, loanApp.TermMonths, loanApp.MarginRate, time.Now())
+	if err != nil {
+		return err
+	}
+	monthlyPayment, _ := calculator.Quote(schedule)
+	netPrice := loanApp.Price - loanApp.DownPayment
+
+	if !withinTolerance(loanApp.NetPrice, netPrice, uc.figureTolerance) {
+		fields.add("net_price", "must be %s, the price %s less the down payment %s", money.New(netPrice, loanApp.CurrencyCode), money.New(loanApp.Price, loanApp.CurrencyCode), money.New(loanApp.DownPayment, loanApp.CurrencyCode))
+	}
+
+	if !withinTolerance(loanApp.MonthlyPayment, monthlyPayment, uc.figureTolerance) {
+		fields.add("monthly_payment", "must be %s for %s repayment", money.New(monthlyPayment, loanApp.CurrencyCode), loanApp.RepaymentMethod)
+	}
+
+	if len(fields) > 0 {
+		return fields.err(ErrInvalidApplication)
+	}
+
+	loanApp.NetPrice = netPrice
+	loanApp.MonthlyPayment = monthlyPayment
+
+	return nil
+}
+
+func withinTolerance(submitted, expected, tolerance money.Amount) bool {
+	difference := submitted - expected
+	return max(difference, -difference) <= tolerance
+}
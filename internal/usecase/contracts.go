@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"loan_service/internal/calculator"
+	"loan_service/internal/dto"
+	"loan_service/internal/repository"
+	"loan_service/pkg/utils"
+	"time"
+)
+
+var ErrNoContract = errors.New("loan has no ASR Leasing contract")
+
+// submitApplication registers an approved application with ASR Leasing and
+// stores the contract number on it. An application that already has a
+// contract is not submitted again. ASR Leasing keys applications by their id,
+// so submitting again after the contract number failed to be stored returns
+// the same contract.
+func (uc *LoanUsecase) submitApplication(ctx context.Context, loanApp repository.LoanApplication) (repository.LoanApplication, error) {
+	if loanApp.ContractNumber != nil {
+		return loanApp, nil
+	}
+
+	schedule, err := uc.CalculateSchedule(
+		string(loanApp.RepaymentMethod),
+		loanApp.CurrencyCode,
+		utils.NilToValueType(loanApp.Price),
+		utils.NilToValueType(loanApp.DownPayment),
+		int32(utils.NilToValueType(loanApp.TermMonths)),
+		utils.NilToValueType(loanApp.MarginRate),
+		time.Now(),
+	)
+	if err != nil {
+		return repository.LoanApplication{}, err
+	}
+	_, total := calculator.Quote(schedule)
+
+	contract, err := uc.asrLeasingClient.SubmitApplication(ctx, applicationFromRow(loanApp), total)
+	if err != nil {
+		return repository.LoanApplication{}, fmt.Errorf("failed to submit loan application %d to asr leasing: %w", loanApp.ID, err)
+	}
+
+	if contract.ContractNumber == "" {
+		return repository.LoanApplication{}, fmt.Errorf("asr leasing returned no contract number for loan application %d", loanApp.ID)
+	}
+
+	updatedApp, err := uc.queries.UpdateApplicationContractNumber(ctx, repository.UpdateApplicationContractNumberParams{
+		ID:             loanApp.ID,
+		ContractNumber: &contract.ContractNumber,
+	})
+	if err != nil {
+		return repository.LoanApplication{}, fmt.Errorf("failed to update loan application contract number in db: %w", err)
+	}
+
+	return updatedApp, nil
+}
+
+// GetLoanContract returns the loan's contract as ASR Leasing currently holds
+// it, including its status and remaining balance.
+func (uc *LoanUsecase) GetLoanContract(ctx context.Context, loanId int64) (*dto.LeasingContract, error) {
+	loan, err := uc.queries.GetLoan(ctx, loanId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan from db: %w", err)
+	}
+
+	contractNumber := utils.NilToValueType(loan.ContractNumber)
+	if contractNumber == "" {
+		return nil, fmt.Errorf("%w: loan %d", ErrNoContract, loan.ID)
+	}
+
+	contract, err := uc.asrLeasingClient.GetContract(ctx, contractNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contract %s from asr leasing: %w", contractNumber, err)
+	}
+
+	return contract, nil
+}
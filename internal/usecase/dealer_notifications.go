@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"loan_service/internal/dto"
+	"loan_service/internal/repository"
+	"time"
+)
+
+const (
+	dealerNotificationPending = "PENDING"
+	dealerNotificationFailed  = "FAILED"
+)
+
+// dealerNotificationClaim is how long NotifyDealers holds the applications
+// of a batch while it sends them. It outlasts a batch of sends, so a claim
+// only runs out if the instance holding it is gone; its applications are
+// then sent again.
+const dealerNotificationClaim = 10 * time.Minute
+
+// NotifyDealers sends up to batchSize applications that are due for delivery
+// to the Koinot Auto dealer. A failed delivery is retried after retryDelay,
+// doubled for every failed attempt up to maxRetryDelay, and marked FAILED
+// once it has been tried maxAttempts times. The batch is claimed in one
+// statement that pushes its next attempt past dealerNotificationClaim, with
+// SKIP LOCKED, so several instances can notify at once; the applications are
+// then sent with no transaction open and each outcome recorded on its own.
+// It returns the number of applications sent and the number that failed.
+func (uc *LoanUsecase) NotifyDealers(ctx context.Context, batchSize, maxAttempts int32, retryDelay, maxRetryDelay time.Duration) (int, int, error) {
+	claimed, err := uc.queries.ClaimPendingDealerNotifications(ctx, repository.ClaimPendingDealerNotificationsParams{
+		ClaimedUntil: time.Now().Add(dealerNotificationClaim),
+		BatchSize:    batchSize,
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to claim pending dealer notifications in db: %w", err)
+	}
+
+	var sent, failed int
+	for _, row := range claimed {
+		sendErr := uc.koinotAutoClient.SendLoanApplication(ctx, applicationFromRow(row))
+		if sendErr == nil {
+			if err := uc.queries.MarkDealerNotificationSent(ctx, row.ID); err != nil {
+				return sent, failed, fmt.Errorf("failed to update loan application in db: %w", err)
+			}
+			sent++
+			continue
+		}
+
+		status := dealerNotificationPending
+		if row.DealerNotificationAttempts+1 >= int64(maxAttempts) {
+			status = dealerNotificationFailed
+		}
+
+		lastError := sendErr.Error()
+		err := uc.queries.MarkDealerNotificationFailed(ctx, repository.MarkDealerNotificationFailedParams{
+			ID:                              row.ID,
+			DealerNotificationStatus:        status,
+			DealerNotificationError:         &lastError,
+			DealerNotificationNextAttemptAt: time.Now().Add(backoffDelay(row.DealerNotificationAttempts, retryDelay, maxRetryDelay)),
+		})
+		if err != nil {
+			return sent, failed, fmt.Errorf("failed to update loan application in db: %w", err)
+		}
+		failed++
+	}
+
+	return sent, failed, nil
+}
+
+// ListDealerNotifications returns applications whose dealer notification is
+// in status, oldest first.
+func (uc *LoanUsecase) ListDealerNotifications(ctx context.Context, status string, limit, offset int32) ([]*dto.LoanApplication, error) {
+	loanApps, err := uc.queries.ListApplicationsByDealerNotificationStatus(ctx, repository.ListApplicationsByDealerNotificationStatusParams{
+		DealerNotificationStatus: status,
+		Limit:                    limit,
+		Offset:                   offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan applications from db: %w", err)
+	}
+
+	result := make([]*dto.LoanApplication, len(loanApps))
+	for index, loanApp := range loanApps {
+		result[index] = applicationFromRow(loanApp)
+	}
+
+	return result, nil
+}
+
+func (uc *LoanUsecase) CountDealerNotifications(ctx context.Context, status string) (*int64, error) {
+	countApps, err := uc.queries.CountApplicationsByDealerNotificationStatus(ctx, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count loan applications from db: %w", err)
+	}
+
+	return &countApps, nil
+}
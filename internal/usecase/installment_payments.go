@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"loan_service/internal/clients"
+	"loan_service/internal/dto"
+	"loan_service/internal/repository"
+	"loan_service/pkg/money"
+	"loan_service/pkg/utils"
+	"log"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrNothingDue       = errors.New("nothing is due on the loan")
+	ErrPaymentCompleted = errors.New("payment is already completed")
+)
+
+// InitiateInstallmentPayment creates a PENDING payment towards a loan and asks
+// the payment service to charge the customer's card or wallet for it. A zero
+// amount pays what is due: the unpaid part of the installments due by today
+// plus outstanding charges, or of the next installment when nothing is due
+// yet. The payment is completed by RecordPayment once the payment service
+// confirms the charge, and marked FAILED if the charge is rejected, at once
+// or later through FailPayment. An amount without a currency is taken to be
+// in the loan's currency.
+func (uc *LoanUsecase) InitiateInstallmentPayment(ctx context.Context, loanId int64, amount money.Money, method, paymentSourceId string) (*dto.Payment, error) {
+	payment, userId, err := uc.createPendingPayment(ctx, loanId, amount, method)
+	if err != nil {
+		return nil, err
+	}
+
+	charge, err := uc.paymentServiceClient.CreateCharge(ctx, &dto.Charge{
+		TransactionId:   payment.TransactionId,
+		UserId:          userId,
+		Amount:          payment.Amount,
+		CurrencyCode:    payment.CurrencyCode,
+		Method:          method,
+		PaymentSourceId: paymentSourceId,
+		Description:     fmt.Sprintf("Loan %d payment", loanId),
+		Reference:       strconv.FormatInt(loanId, 10),
+	})
+	if err != nil {
+		if errors.Is(err, clients.ErrChargeRejected) {
+			return nil, uc.failPendingPayment(ctx, payment, err)
+		}
+
+		// The charge may still have gone through; its confirmation
+		// completes the payment.
+		return nil, fmt.Errorf("failed to create charge for payment %d: %w", payment.Id, err)
+	}
+
+	if charge.Status == paymentStatusFailed {
+		return nil, uc.failPendingPayment(ctx, payment, fmt.Errorf("%w: %s", clients.ErrChargeRejected, charge.FailureReason))
+	}
+
+	return payment, nil
+}
+
+func (uc *LoanUsecase) createPendingPayment(ctx context.Context, loanId int64, requested money.Money, method string) (*dto.Payment, int64, error) {
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	loan, err := qtx.GetLoanForUpdate(ctx, loanId)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get loan from db: %w", err)
+	}
+
+	if loan.Status.LoanStatus == repository.LoanStatusPAID {
+		return nil, 0, ErrLoanClosed
+	}
+
+	chargesOutstanding, err := loanChargesOutstanding(ctx, qtx, loan.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	amount, err := loanAmount(loan, requested)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	remainingBalance := utils.NilToValueType(loan.RemainingBalance)
+	if amount == 0 {
+		installments, err := qtx.ListInstallmentsByLoan(ctx, loan.ID)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get installments from db: %w", err)
+		}
+
+		amount = amountDue(installments, time.Now()) + chargesOutstanding
+		if amount == 0 {
+			return nil, 0, ErrNothingDue
+		}
+	}
+
+	if amount > remainingBalance+chargesOutstanding {
+		return nil, 0, fmt.Errorf("%w: remaining balance is %s and outstanding charges are %s", ErrPaymentExceedsBalance, remainingBalance, chargesOutstanding)
+	}
+
+	transactionId, err := newTransactionId(loan.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	now := time.Now()
+	status := paymentStatusPending
+	createdPayment, err := qtx.CreatePayment(ctx, repository.CreatePaymentParams{
+		LoanID:        loan.ID,
+		CurrencyCode:  loan.CurrencyCode,
+		PaymentDate:   &now,
+		Amount:        &amount,
+		Method:        &method,
+		Status:        &status,
+		TransactionID: &transactionId,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create payment in db: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return paymentFromRow(createdPayment), loan.UserID, nil
+}
+
+// failPendingPayment marks a payment whose charge was rejected as FAILED and
+// returns the rejection.
+func (uc *LoanUsecase) failPendingPayment(ctx context.Context, payment *dto.Payment, chargeErr error) error {
+	status := paymentStatusFailed
+	_, err := uc.queries.UpdatePayment(ctx, repository.UpdatePaymentParams{
+		ID:          payment.Id,
+		Status:      &status,
+		PaymentDate: &payment.PaymentDate,
+	})
+	if err != nil {
+		log.Printf("Failed to mark payment %d as failed: %s", payment.Id, err)
+	}
+
+	return fmt.Errorf("payment %d: %w", payment.Id, chargeErr)
+}
+
+// FailPayment marks the PENDING payment of a charge that the payment service
+// accepted and then failed as FAILED. A payment that is FAILED already is
+// returned as it is; one that is COMPLETED stays so and is rejected with
+// ErrPaymentCompleted, as the confirmation of its charge came first.
+func (uc *LoanUsecase) FailPayment(ctx context.Context, transactionId string) (*dto.Payment, error) {
+	payment, err := uc.queries.GetPaymentByTransactionID(ctx, &transactionId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment from db: %w", err)
+	}
+
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	// The loan lock serializes this with RecordPayment completing the
+	// payment, so the status read next is final.
+	if _, err := qtx.GetLoanForUpdate(ctx, payment.LoanID); err != nil {
+		return nil, fmt.Errorf("failed to get loan from db: %w", err)
+	}
+
+	payment, err = qtx.GetPaymentByTransactionID(ctx, &transactionId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment from db: %w", err)
+	}
+
+	switch utils.NilToValueType(payment.Status) {
+	case paymentStatusFailed:
+		return paymentFromRow(payment), nil
+	case paymentStatusCompleted:
+		return nil, fmt.Errorf("%w: payment %d", ErrPaymentCompleted, payment.ID)
+	}
+
+	status := paymentStatusFailed
+	failed, err := qtx.UpdatePayment(ctx, repository.UpdatePaymentParams{
+		ID:          payment.ID,
+		Status:      &status,
+		PaymentDate: payment.PaymentDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update payment in db: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return paymentFromRow(failed), nil
+}
+
+// amountDue is the unpaid part of the installments due by date or, when none
+// is due, of the next open installment.
+func amountDue(installments []repository.Installment, date time.Time) money.Amount {
+	var due money.Amount
+	var next money.Amount
+	for _, installment := range installments {
+		if installment.Status == repository.InstallmentStatusPAID {
+			continue
+		}
+
+		outstanding := installment.PrincipalDue + installment.MarginDue - installment.PrincipalPaid - installment.MarginPaid
+		if !installment.DueDate.After(date) {
+			due += outstanding
+		} else if next == 0 {
+			next = outstanding
+		}
+	}
+
+	if due == 0 {
+		return next
+	}
+
+	return due
+}
+
+// newTransactionId returns the id a payment initiated by the service is known
+// by in the payment service.
+func newTransactionId(loanId int64) (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate transaction id: %w", err)
+	}
+
+	return fmt.Sprintf("loan-%d-%x", loanId, b), nil
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"loan_service/internal/dto"
+	"loan_service/internal/repository"
+	"loan_service/pkg/utils"
+)
+
+func (uc *LoanUsecase) ListInstallments(ctx context.Context, loanId int64) ([]*dto.Installment, error) {
+	loan, err := uc.queries.GetLoan(ctx, loanId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan from db: %w", err)
+	}
+
+	installments, err := uc.queries.ListInstallmentsByLoan(ctx, loanId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get installments from db: %w", err)
+	}
+
+	result := make([]*dto.Installment, len(installments))
+	for index, installment := range installments {
+		result[index] = installmentFromRow(installment, loan.CurrencyCode)
+	}
+
+	return result, nil
+}
+
+// createInstallments persists the schedule of a freshly originated loan.
+func createInstallments(ctx context.Context, qtx *repository.Queries, loanId int64, schedule []dto.RepaymentInstallment) error {
+	for _, installment := range schedule {
+		_, err := qtx.CreateInstallment(ctx, repository.CreateInstallmentParams{
+			LoanID:       loanId,
+			Number:       int64(installment.Number),
+			DueDate:      installment.DueDate,
+			PrincipalDue: installment.Principal,
+			MarginDue:    installment.Margin,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create installment in db: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func installmentFromRow(installment repository.Installment, currencyCode string) *dto.Installment {
+	return &dto.Installment{
+		Id:            installment.ID,
+		LoanId:        installment.LoanID,
+		Number:        int32(installment.Number),
+		DueDate:       installment.DueDate,
+		CurrencyCode:  currencyCode,
+		PrincipalDue:  installment.PrincipalDue,
+		MarginDue:     installment.MarginDue,
+		PrincipalPaid: installment.PrincipalPaid,
+		MarginPaid:    installment.MarginPaid,
+		Status:        string(installment.Status),
+		PaidAt:        utils.NilToValueType(installment.PaidAt),
+	}
+}
@@ -2,40 +2,141 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"loan_service/internal/dto"
+	"loan_service/internal/events"
+	eventspb "loan_service/internal/proto/events"
 	"loan_service/internal/repository"
+	"loan_service/pkg/money"
 	"loan_service/pkg/utils"
+	"slices"
 )
 
+var ErrInvalidStatusTransition = errors.New("invalid application status transition")
+
+// applicationTransitions lists the statuses an application may move to from
+// its current status. APPROVED only moves to ISSUED when a loan is originated
+// from it; ISSUED and REJECTED are terminal.
+var applicationTransitions = map[repository.ApplicationStatus][]repository.ApplicationStatus{
+	repository.ApplicationStatusNEW:      {repository.ApplicationStatusREVIEW},
+	repository.ApplicationStatusREVIEW:   {repository.ApplicationStatusAPPROVED, repository.ApplicationStatusREJECTED},
+	repository.ApplicationStatusAPPROVED: {repository.ApplicationStatusISSUED},
+}
+
 func (uc *LoanUsecase) CreateApplication(ctx context.Context, loanApp *dto.LoanApplication) (*dto.LoanApplication, error) {
+	if err := money.Validate(loanApp.CurrencyCode); err != nil {
+		return nil, err
+	}
+
+	product, err := uc.productFor(ctx, loanApp.ProductId, loanApp.Type, loanApp.CurrencyCode, ErrInvalidApplication)
+	if err != nil {
+		return nil, err
+	}
+	loanApp.ProductId = product.Id
+
+	// The vehicle may be priced in another currency; the application keeps
+	// that price and the rate it was converted into the loan currency at.
+	if loanApp.VehicleCurrencyCode == "" {
+		loanApp.VehicleCurrencyCode = loanApp.CurrencyCode
+	}
+
+	price, rate, err := uc.convertPrice(ctx, money.New(loanApp.VehiclePrice, loanApp.VehicleCurrencyCode), loanApp.CurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+	loanApp.Price = price
+	loanApp.ExchangeRate = rate
+
+	if err := uc.validateApplicationFigures(loanApp, product); err != nil {
+		return nil, err
+	}
+
+	// An AUTO application holds its vehicle while it is reviewed. The hold is
+	// given back unless the application is saved.
+	var hold *dto.VehicleHold
+	created := false
+	if loanApp.Type == string(repository.ApplicationTypeAUTO) {
+		if err := uc.verifyVehicle(ctx, loanApp); err != nil {
+			return nil, err
+		}
+
+		hold, err = uc.reserveVehicle(ctx, loanApp.VehicleVin)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if !created {
+				uc.releaseVehicle(ctx, hold.Vin, hold.HoldId)
+			}
+		}()
+	}
 
-	createdLoanApp, err := uc.queries.CreateApplication(ctx, repository.CreateApplicationParams{
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	createdLoanApp, err := qtx.CreateApplication(ctx, repository.CreateApplicationParams{
 		UserID:         loanApp.UserId,
 		Type:           repository.ApplicationType(loanApp.Type),
 		VehicleVin:     &loanApp.VehicleVin,
 		VehicleName:    &loanApp.VehicleName,
 		CurrencyCode:   loanApp.CurrencyCode,
-		Price:          utils.PtrNumeric[int64, float64](loanApp.Price),
-		DownPayment:    utils.PtrNumeric[int64, float64](loanApp.DownPayment),
-		NetPrice:       utils.PtrNumeric[int64, float64](loanApp.NetPrice),
+		Price:          &loanApp.Price,
+		DownPayment:    &loanApp.DownPayment,
+		NetPrice:       &loanApp.NetPrice,
 		MarginRate:     &loanApp.MarginRate,
 		TermMonths:     utils.PtrNumeric[int32, int64](loanApp.TermMonths),
-		MonthlyPayment: utils.PtrNumeric[int64, float64](loanApp.MonthlyPayment),
+		MonthlyPayment: &loanApp.MonthlyPayment,
 		Status: repository.NullApplicationStatus{
 			ApplicationStatus: "NEW",
 			Valid:             true,
 		},
+		RepaymentMethod:     repository.RepaymentMethod(loanApp.RepaymentMethod),
+		VehiclePrice:        &loanApp.VehiclePrice,
+		VehicleCurrencyCode: &loanApp.VehicleCurrencyCode,
+		ExchangeRate:        &loanApp.ExchangeRate,
+		ProductID:           &loanApp.ProductId,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create loan application in db: %w", err)
 	}
 
-	loanApp.Id = createdLoanApp.ID
-	if err := uc.koinotAutoClient.SendLoanApplication(ctx, loanApp); err != nil {
+	if hold != nil {
+		if err := createVehicleHold(ctx, qtx, createdLoanApp.ID, hold); err != nil {
+			return nil, err
+		}
+	}
+
+	err = recordEvent(ctx, qtx, events.TypeApplicationCreated, events.AggregateApplication, createdLoanApp.ID, &eventspb.ApplicationCreatedV1{
+		ApplicationId:   createdLoanApp.ID,
+		UserId:          loanApp.UserId,
+		Type:            loanApp.Type,
+		VehicleVin:      loanApp.VehicleVin,
+		Price:           events.Money(loanApp.Price, loanApp.CurrencyCode),
+		NetPrice:        events.Money(loanApp.NetPrice, loanApp.CurrencyCode),
+		TermMonths:      loanApp.TermMonths,
+		RepaymentMethod: loanApp.RepaymentMethod,
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	created = true
+
+	// The dealer is notified by the dealer notifier, so a Koinot Auto
+	// outage does not fail the application.
+	loanApp.Id = createdLoanApp.ID
+	loanApp.DealerNotificationStatus = createdLoanApp.DealerNotificationStatus
+
 	return loanApp, nil
 }
 
@@ -45,23 +146,7 @@ func (uc *LoanUsecase) GetApplication(ctx context.Context, id int64) (*dto.LoanA
 		return nil, fmt.Errorf("failed to get loan application from db: %w", err)
 	}
 
-	return &dto.LoanApplication{
-		Id:             applicationResult.ID,
-		UserId:         applicationResult.UserID,
-		Type:           string(applicationResult.Type),
-		VehicleVin:     utils.NilToValueType(applicationResult.VehicleVin),
-		VehicleName:    utils.NilToValueType(applicationResult.VehicleName),
-		CurrencyCode:   applicationResult.CurrencyCode,
-		Price:          int64(utils.NilToValueType(applicationResult.Price)),
-		DownPayment:    int64(utils.NilToValueType(applicationResult.DownPayment)),
-		NetPrice:       int64(utils.NilToValueType(applicationResult.NetPrice)),
-		MarginRate:     utils.NilToValueType(applicationResult.MarginRate),
-		TermMonths:     int32(utils.NilToValueType(applicationResult.TermMonths)),
-		MonthlyPayment: int64(utils.NilToValueType(applicationResult.MonthlyPayment)),
-		Status:         string(applicationResult.Status.ApplicationStatus),
-		CreatedAt:      utils.NilToValueType(applicationResult.CreatedAt),
-		UpdatedAt:      utils.NilToValueType(applicationResult.UpdatedAt),
-	}, nil
+	return applicationFromRow(applicationResult), nil
 }
 
 func (uc *LoanUsecase) ListApplications(ctx context.Context, userId int64, limit, offset int32) ([]*dto.LoanApplication, error) {
@@ -76,23 +161,7 @@ func (uc *LoanUsecase) ListApplications(ctx context.Context, userId int64, limit
 
 	result := make([]*dto.LoanApplication, len(loanApps))
 	for index, loanApp := range loanApps {
-		result[index] = &dto.LoanApplication{
-			Id:             loanApp.ID,
-			UserId:         loanApp.UserID,
-			Type:           string(loanApp.Type),
-			VehicleVin:     utils.NilToValueType(loanApp.VehicleVin),
-			VehicleName:    utils.NilToValueType(loanApp.VehicleName),
-			CurrencyCode:   loanApp.CurrencyCode,
-			Price:          int64(utils.NilToValueType(loanApp.Price)),
-			DownPayment:    int64(utils.NilToValueType(loanApp.DownPayment)),
-			NetPrice:       int64(utils.NilToValueType(loanApp.NetPrice)),
-			MarginRate:     utils.NilToValueType(loanApp.MarginRate),
-			TermMonths:     int32(utils.NilToValueType(loanApp.TermMonths)),
-			MonthlyPayment: int64(utils.NilToValueType(loanApp.MonthlyPayment)),
-			Status:         string(loanApp.Status.ApplicationStatus),
-			CreatedAt:      utils.NilToValueType(loanApp.CreatedAt),
-			UpdatedAt:      utils.NilToValueType(loanApp.UpdatedAt),
-		}
+		result[index] = applicationFromRow(loanApp)
 	}
 
 	return result, nil
@@ -106,3 +175,144 @@ func (uc *LoanUsecase) CountApplications(ctx context.Context, userId int64) (*in
 
 	return &loanAppCount, nil
 }
+
+func (uc *LoanUsecase) ReviewApplication(ctx context.Context, id int64, actor, reason string) (*dto.LoanApplication, error) {
+	return uc.changeApplicationStatus(ctx, id, repository.ApplicationStatusREVIEW, actor, reason)
+}
+
+func (uc *LoanUsecase) ApproveApplication(ctx context.Context, id int64, actor, reason string) (*dto.LoanApplication, error) {
+	return uc.changeApplicationStatus(ctx, id, repository.ApplicationStatusAPPROVED, actor, reason)
+}
+
+func (uc *LoanUsecase) RejectApplication(ctx context.Context, id int64, actor, reason string) (*dto.LoanApplication, error) {
+	return uc.changeApplicationStatus(ctx, id, repository.ApplicationStatusREJECTED, actor, reason)
+}
+
+func (uc *LoanUsecase) changeApplicationStatus(ctx context.Context, id int64, to repository.ApplicationStatus, actor, reason string) (*dto.LoanApplication, error) {
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	loanApp, err := qtx.GetApplicationForUpdate(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan application from db: %w", err)
+	}
+
+	updatedLoanApp, err := transitionApplication(ctx, qtx, loanApp, to, actor, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	// A rejected application gives its vehicle back.
+	var released *repository.VehicleHold
+	if to == repository.ApplicationStatusREJECTED {
+		hold, err := qtx.ReleaseVehicleHold(ctx, loanApp.ID)
+		switch {
+		case err == nil:
+			released = &hold
+		case !errors.Is(err, sql.ErrNoRows):
+			return nil, fmt.Errorf("failed to release vehicle hold in db: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if released != nil {
+		uc.releaseVehicle(ctx, released.VehicleVin, released.KoinotHoldID)
+	}
+
+	return applicationFromRow(updatedLoanApp), nil
+}
+
+// transitionApplication moves a locked application row to the given status and
+// records the change in its history. It must be called within a transaction.
+func transitionApplication(
+	ctx context.Context,
+	qtx *repository.Queries,
+	loanApp repository.LoanApplication,
+	to repository.ApplicationStatus,
+	actor, reason string,
+) (repository.LoanApplication, error) {
+	from := loanApp.Status.ApplicationStatus
+	if !slices.Contains(applicationTransitions[from], to) {
+		return repository.LoanApplication{}, fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, from, to)
+	}
+
+	updatedLoanApp, err := qtx.UpdateApplicationStatus(ctx, repository.UpdateApplicationStatusParams{
+		ID: loanApp.ID,
+		Status: repository.NullApplicationStatus{
+			ApplicationStatus: to,
+			Valid:             true,
+		},
+	})
+	if err != nil {
+		return repository.LoanApplication{}, fmt.Errorf("failed to update loan application status in db: %w", err)
+	}
+
+	var reasonPtr *string
+	if reason != "" {
+		reasonPtr = &reason
+	}
+
+	_, err = qtx.CreateApplicationStatusChange(ctx, repository.CreateApplicationStatusChangeParams{
+		ApplicationID: loanApp.ID,
+		FromStatus:    from,
+		ToStatus:      to,
+		Reason:        reasonPtr,
+		Actor:         actor,
+	})
+	if err != nil {
+		return repository.LoanApplication{}, fmt.Errorf("failed to record loan application status change in db: %w", err)
+	}
+
+	err = recordEvent(ctx, qtx, events.TypeApplicationStatusChanged, events.AggregateApplication, loanApp.ID, &eventspb.ApplicationStatusChangedV1{
+		ApplicationId: loanApp.ID,
+		UserId:        loanApp.UserID,
+		FromStatus:    string(from),
+		ToStatus:      string(to),
+		Actor:         actor,
+		Reason:        reason,
+	})
+	if err != nil {
+		return repository.LoanApplication{}, err
+	}
+
+	return updatedLoanApp, nil
+}
+
+func applicationFromRow(loanApp repository.LoanApplication) *dto.LoanApplication {
+	return &dto.LoanApplication{
+		Id:                  loanApp.ID,
+		UserId:              loanApp.UserID,
+		Type:                string(loanApp.Type),
+		VehicleVin:          utils.NilToValueType(loanApp.VehicleVin),
+		VehicleName:         utils.NilToValueType(loanApp.VehicleName),
+		CurrencyCode:        loanApp.CurrencyCode,
+		Price:               utils.NilToValueType(loanApp.Price),
+		DownPayment:         utils.NilToValueType(loanApp.DownPayment),
+		NetPrice:            utils.NilToValueType(loanApp.NetPrice),
+		MarginRate:          utils.NilToValueType(loanApp.MarginRate),
+		TermMonths:          int32(utils.NilToValueType(loanApp.TermMonths)),
+		MonthlyPayment:      utils.NilToValueType(loanApp.MonthlyPayment),
+		RepaymentMethod:     string(loanApp.RepaymentMethod),
+		Status:              string(loanApp.Status.ApplicationStatus),
+		VehiclePrice:        utils.NilToValueType(loanApp.VehiclePrice),
+		VehicleCurrencyCode: utils.NilToValueType(loanApp.VehicleCurrencyCode),
+		ExchangeRate:        utils.NilToValueType(loanApp.ExchangeRate),
+		ContractNumber:      utils.NilToValueType(loanApp.ContractNumber),
+		ProductId:           utils.NilToValueType(loanApp.ProductID),
+		CreatedAt:           utils.NilToValueType(loanApp.CreatedAt),
+		UpdatedAt:           utils.NilToValueType(loanApp.UpdatedAt),
+
+		DealerNotificationStatus:   loanApp.DealerNotificationStatus,
+		DealerNotificationAttempts: int32(loanApp.DealerNotificationAttempts),
+		DealerNotificationError:    utils.NilToValueType(loanApp.DealerNotificationError),
+		DealerNotifiedAt:           utils.NilToValueType(loanApp.DealerNotifiedAt),
+	}
+}
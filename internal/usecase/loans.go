@@ -2,33 +2,276 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"loan_service/internal/calculator"
 	"loan_service/internal/dto"
+	"loan_service/internal/events"
+	eventspb "loan_service/internal/proto/events"
 	"loan_service/internal/repository"
+	"loan_service/pkg/money"
 	"loan_service/pkg/utils"
+	"log"
+	"time"
 )
 
+var (
+	ErrApplicationNotApproved = errors.New("loan application is not approved")
+	ErrOriginationInProgress  = errors.New("loan application is being originated")
+)
+
+// originationClaimTTL is how long CreateLoan holds an application while it
+// calls ASR Leasing and Koinot Auto. It outlasts both clients' timeouts, so a
+// claim only runs out if the instance holding it is gone.
+const originationClaimTTL = 5 * time.Minute
+
+// CreateLoan originates a loan from an APPROVED application. The application
+// is first claimed in a short transaction of its own: concurrent originations
+// of one application are rejected with ErrOriginationInProgress until the
+// claim is released or runs out. It is then submitted to ASR Leasing for a
+// contract number and its vehicle is sold in Koinot Auto, outside of any
+// transaction. Finally the loan row and its installments are inserted, the
+// vehicle hold is marked SOLD and the application is moved to ISSUED in one
+// transaction, so an application can be originated only once.
+//
+// If any step fails the claim is released and nothing is recorded beyond the
+// contract number. Both external calls are keyed by the application: ASR
+// Leasing returns the contract it already issued for it, and Koinot Auto
+// confirms the sale of a hold it has already sold. An origination that fails
+// after them can be retried without a second contract or sale.
+func (uc *LoanUsecase) CreateLoan(ctx context.Context, applicationId int64, actor string) (*dto.Loan, error) {
+	loanApp, err := uc.claimOrigination(ctx, applicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	loan, err := uc.originateLoan(ctx, loanApp, actor)
+	if err != nil {
+		uc.releaseOrigination(ctx, applicationId)
+		return nil, err
+	}
+
+	return loan, nil
+}
+
+// claimOrigination checks that an application is APPROVED and not being
+// originated already, and claims it for originationClaimTTL.
+func (uc *LoanUsecase) claimOrigination(ctx context.Context, applicationId int64) (repository.LoanApplication, error) {
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		return repository.LoanApplication{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	loanApp, err := qtx.GetApplicationForUpdate(ctx, applicationId)
+	if err != nil {
+		return repository.LoanApplication{}, fmt.Errorf("failed to get loan application from db: %w", err)
+	}
+
+	if loanApp.Status.ApplicationStatus != repository.ApplicationStatusAPPROVED {
+		return repository.LoanApplication{}, fmt.Errorf("%w: status is %s", ErrApplicationNotApproved, loanApp.Status.ApplicationStatus)
+	}
+
+	now := time.Now()
+	if loanApp.OriginationClaimedUntil != nil && loanApp.OriginationClaimedUntil.After(now) {
+		return repository.LoanApplication{}, fmt.Errorf("%w: application %d", ErrOriginationInProgress, loanApp.ID)
+	}
+
+	claimedUntil := now.Add(originationClaimTTL)
+	err = qtx.ClaimApplicationOrigination(ctx, repository.ClaimApplicationOriginationParams{
+		ID:                      loanApp.ID,
+		OriginationClaimedUntil: &claimedUntil,
+	})
+	if err != nil {
+		return repository.LoanApplication{}, fmt.Errorf("failed to claim loan application in db: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return repository.LoanApplication{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return loanApp, nil
+}
+
+// releaseOrigination gives up the claim on an application whose origination
+// failed, so it can be retried at once. It is best effort: a claim that
+// cannot be released runs out on its own.
+func (uc *LoanUsecase) releaseOrigination(ctx context.Context, applicationId int64) {
+	if err := uc.queries.ReleaseApplicationOrigination(context.WithoutCancel(ctx), applicationId); err != nil {
+		log.Printf("Failed to release origination claim on loan application %d: %s", applicationId, err)
+	}
+}
+
+// originateLoan makes the external calls for a claimed application and then
+// records the loan.
+func (uc *LoanUsecase) originateLoan(ctx context.Context, loanApp repository.LoanApplication, actor string) (*dto.Loan, error) {
+	loanApp, err := uc.submitApplication(ctx, loanApp)
+	if err != nil {
+		return nil, err
+	}
+
+	sold, err := uc.sellVehicle(ctx, loanApp.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	loanApp, err = qtx.GetApplicationForUpdate(ctx, loanApp.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan application from db: %w", err)
+	}
+
+	if loanApp.Status.ApplicationStatus != repository.ApplicationStatusAPPROVED {
+		return nil, fmt.Errorf("%w: status is %s", ErrApplicationNotApproved, loanApp.Status.ApplicationStatus)
+	}
+
+	if sold {
+		if _, err := qtx.MarkVehicleHoldSold(ctx, loanApp.ID); err != nil {
+			return nil, fmt.Errorf("failed to mark vehicle hold as sold in db: %w", err)
+		}
+	}
+
+	schedule, err := uc.CalculateSchedule(
+		string(loanApp.RepaymentMethod),
+		loanApp.CurrencyCode,
+		utils.NilToValueType(loanApp.Price),
+		utils.NilToValueType(loanApp.DownPayment),
+		int32(utils.NilToValueType(loanApp.TermMonths)),
+		utils.NilToValueType(loanApp.MarginRate),
+		time.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	_, total := calculator.Quote(schedule)
+
+	createdLoan, err := qtx.CreateLoan(ctx, repository.CreateLoanParams{
+		ApplicationID:    loanApp.ID,
+		UserID:           loanApp.UserID,
+		VehicleVin:       loanApp.VehicleVin,
+		CurrencyCode:     loanApp.CurrencyCode,
+		Amount:           loanApp.NetPrice,
+		TermMonths:       loanApp.TermMonths,
+		MonthlyPayment:   loanApp.MonthlyPayment,
+		RemainingBalance: &total,
+		Status: repository.NullLoanStatus{
+			LoanStatus: repository.LoanStatusACTIVE,
+			Valid:      true,
+		},
+		MarginRate:      loanApp.MarginRate,
+		RepaymentMethod: loanApp.RepaymentMethod,
+		ContractNumber:  loanApp.ContractNumber,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create loan in db: %w", err)
+	}
+
+	if err := createInstallments(ctx, qtx, createdLoan.ID, schedule); err != nil {
+		return nil, err
+	}
+
+	err = recordEvent(ctx, qtx, events.TypeLoanOriginated, events.AggregateLoan, createdLoan.ID, &eventspb.LoanOriginatedV1{
+		LoanId:           createdLoan.ID,
+		ApplicationId:    createdLoan.ApplicationID,
+		UserId:           createdLoan.UserID,
+		Amount:           events.Money(utils.NilToValueType(createdLoan.Amount), createdLoan.CurrencyCode),
+		TermMonths:       int32(utils.NilToValueType(createdLoan.TermMonths)),
+		MonthlyPayment:   events.Money(utils.NilToValueType(createdLoan.MonthlyPayment), createdLoan.CurrencyCode),
+		RemainingBalance: events.Money(utils.NilToValueType(createdLoan.RemainingBalance), createdLoan.CurrencyCode),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := transitionApplication(ctx, qtx, loanApp, repository.ApplicationStatusISSUED, actor, fmt.Sprintf("loan %d originated", createdLoan.ID)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return loanFromRow(createdLoan), nil
+}
+
 func (uc *LoanUsecase) GetLoan(ctx context.Context, id int64) (*dto.Loan, error) {
 	loan, err := uc.queries.GetLoan(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get loan from db: %w", err)
 	}
 
-	return &dto.Loan{
-		Id:               loan.ID,
-		ApplicationId:    loan.ApplicationID,
-		UserId:           loan.UserID,
-		CurrencyCode:     loan.CurrencyCode,
-		VehicleVin:       utils.NilToValueType(loan.VehicleVin),
-		Amount:           int64(utils.NilToValueType(loan.Amount)),
-		TermMonths:       int32(utils.NilToValueType(loan.TermMonths)),
-		MonthlyPayment:   int64(utils.NilToValueType(loan.MonthlyPayment)),
-		RemainingBalance: int64(utils.NilToValueType(loan.RemainingBalance)),
-		Status:           string(loan.Status.LoanStatus),
-		CreatedAt:        utils.NilToValueType(loan.CreatedAt),
-	}, nil
+	chargesOutstanding, err := loanChargesOutstanding(ctx, uc.queries, loan.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := loanFromRow(loan)
+	result.ChargesOutstanding = chargesOutstanding
+
+	return result, nil
+}
+
+// GetRepaymentSchedule returns the month-by-month schedule of a loan from its
+// installments, so prepayments are reflected. Loans originated before
+// installments were stored get the schedule rebuilt from the amount, rate and
+// repayment method they were originated with.
+func (uc *LoanUsecase) GetRepaymentSchedule(ctx context.Context, loanId int64) ([]dto.RepaymentInstallment, error) {
+	loan, err := uc.queries.GetLoan(ctx, loanId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan from db: %w", err)
+	}
+
+	installments, err := uc.queries.ListInstallmentsByLoan(ctx, loan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get installments from db: %w", err)
+	}
+
+	if len(installments) > 0 {
+		var outstanding money.Amount
+		for _, installment := range installments {
+			outstanding += installment.PrincipalDue
+		}
+
+		schedule := make([]dto.RepaymentInstallment, len(installments))
+		for index, installment := range installments {
+			outstanding -= installment.PrincipalDue
+			schedule[index] = dto.RepaymentInstallment{
+				Number:             int32(installment.Number),
+				DueDate:            installment.DueDate,
+				CurrencyCode:       loan.CurrencyCode,
+				Payment:            installment.PrincipalDue + installment.MarginDue,
+				Principal:          installment.PrincipalDue,
+				Margin:             installment.MarginDue,
+				OutstandingBalance: outstanding,
+				OwnershipTransfer:  loan.RepaymentMethod == repository.RepaymentMethodIJARA && index == len(installments)-1,
+			}
+		}
+
+		return schedule, nil
+	}
+
+	return uc.CalculateSchedule(
+		string(loan.RepaymentMethod),
+		loan.CurrencyCode,
+		utils.NilToValueType(loan.Amount),
+		0,
+		int32(utils.NilToValueType(loan.TermMonths)),
+		utils.NilToValueType(loan.MarginRate),
+		utils.NilToValueType(loan.CreatedAt),
+	)
 }
 
+// ListLoans returns a page of a user's loans with their outstanding charges,
+// summed the same way GetLoan does it.
 func (uc *LoanUsecase) ListLoans(ctx context.Context, userId int64, limit, offset int32) ([]*dto.Loan, error) {
 	loans, err := uc.queries.ListLoansByUser(ctx, repository.ListLoansByUserParams{
 		UserID: userId,
@@ -39,22 +282,26 @@ func (uc *LoanUsecase) ListLoans(ctx context.Context, userId int64, limit, offse
 		return nil, fmt.Errorf("failed to get loans from db: %w", err)
 	}
 
+	loanIds := make([]int64, len(loans))
+	for index, loan := range loans {
+		loanIds[index] = loan.ID
+	}
+
+	totals, err := uc.queries.ListLoanChargeTotals(ctx, loanIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan charge totals from db: %w", err)
+	}
+
+	chargesOutstanding := make(map[int64]money.Amount, len(totals))
+	for _, total := range totals {
+		chargesOutstanding[total.LoanID] = total.Charged - total.Paid
+	}
+
 	result := make([]*dto.Loan, len(loans))
 
 	for index, loan := range loans {
-		result[index] = &dto.Loan{
-			Id:               loan.UserID,
-			ApplicationId:    loan.ApplicationID,
-			UserId:           loan.UserID,
-			CurrencyCode:     loan.CurrencyCode,
-			VehicleVin:       utils.NilToValueType(loan.VehicleVin),
-			Amount:           int64(utils.NilToValueType(loan.Amount)),
-			TermMonths:       int32(utils.NilToValueType(loan.TermMonths)),
-			MonthlyPayment:   int64(utils.NilToValueType(loan.MonthlyPayment)),
-			RemainingBalance: int64(utils.NilToValueType(loan.RemainingBalance)),
-			Status:           string(loan.Status.LoanStatus),
-			CreatedAt:        utils.NilToValueType(loan.CreatedAt),
-		}
+		result[index] = loanFromRow(loan)
+		result[index].ChargesOutstanding = chargesOutstanding[loan.ID]
 	}
 
 	return result, nil
@@ -68,3 +315,45 @@ func (uc *LoanUsecase) CountLoans(ctx context.Context, userId int64) (*int64, er
 
 	return &countLoans, nil
 }
+
+func loanFromRow(loan repository.Loan) *dto.Loan {
+	return &dto.Loan{
+		Id:               loan.ID,
+		ApplicationId:    loan.ApplicationID,
+		UserId:           loan.UserID,
+		CurrencyCode:     loan.CurrencyCode,
+		VehicleVin:       utils.NilToValueType(loan.VehicleVin),
+		Amount:           utils.NilToValueType(loan.Amount),
+		TermMonths:       int32(utils.NilToValueType(loan.TermMonths)),
+		MonthlyPayment:   utils.NilToValueType(loan.MonthlyPayment),
+		RemainingBalance: utils.NilToValueType(loan.RemainingBalance),
+		MarginRate:       utils.NilToValueType(loan.MarginRate),
+		RepaymentMethod:  string(loan.RepaymentMethod),
+		Status:           string(loan.Status.LoanStatus),
+		DaysPastDue:      int32(loan.DaysPastDue),
+		ContractNumber:   utils.NilToValueType(loan.ContractNumber),
+		CreatedAt:        utils.NilToValueType(loan.CreatedAt),
+
+		OwnershipTransferredAt: utils.NilToValueType(loan.OwnershipTransferredAt),
+	}
+}
+
+// transferOwnership passes the leased asset of an IJARA loan to the customer
+// once the loan is paid off, as of at. Other loans are returned unchanged.
+func transferOwnership(ctx context.Context, qtx *repository.Queries, loan repository.Loan, at time.Time) (repository.Loan, error) {
+	if loan.RepaymentMethod != repository.RepaymentMethodIJARA ||
+		loan.Status.LoanStatus != repository.LoanStatusPAID ||
+		loan.OwnershipTransferredAt != nil {
+		return loan, nil
+	}
+
+	updatedLoan, err := qtx.TransferLoanOwnership(ctx, repository.TransferLoanOwnershipParams{
+		ID:                     loan.ID,
+		OwnershipTransferredAt: &at,
+	})
+	if err != nil {
+		return repository.Loan{}, fmt.Errorf("failed to transfer loan ownership in db: %w", err)
+	}
+
+	return updatedLoan, nil
+}
@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"loan_service/internal/dto"
+	"loan_service/internal/events"
+	"loan_service/internal/repository"
+	"loan_service/pkg/utils"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// EventPublisher delivers an outbox event to the message broker. It returns
+// only once the broker has confirmed the event.
+type EventPublisher interface {
+	Publish(ctx context.Context, event *dto.OutboxEvent) error
+}
+
+// recordEvent stores an event in the outbox. It must be called within the
+// transaction that makes the change the event describes, so the event is
+// published if and only if the change is committed.
+func recordEvent(ctx context.Context, qtx *repository.Queries, eventType, aggregateType string, aggregateId int64, payload proto.Message) error {
+	version := events.Version(payload)
+	if version == 0 {
+		return fmt.Errorf("%w: %s payload %s", events.ErrUnknownSchema, eventType, payload.ProtoReflect().Descriptor().FullName())
+	}
+
+	data, err := events.MarshalPayload(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", eventType, err)
+	}
+
+	var correlationId *string
+	if id := events.CorrelationId(ctx); id != "" {
+		correlationId = &id
+	}
+
+	err = qtx.CreateOutboxEvent(ctx, repository.CreateOutboxEventParams{
+		EventType:     eventType,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateId,
+		Payload:       data,
+		EventVersion:  int64(version),
+		CorrelationID: correlationId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create outbox event in db: %w", err)
+	}
+
+	return nil
+}
+
+// RelayOutbox publishes up to batchSize pending outbox events, oldest first.
+// An event the publisher fails on is retried after retryDelay, doubled for
+// every failed attempt up to maxRetryDelay, and the rest of the batch waits
+// for the next pass. Rows are locked with SKIP LOCKED, so several instances
+// can relay at once. It returns the number of events published.
+func (uc *LoanUsecase) RelayOutbox(ctx context.Context, publisher EventPublisher, batchSize int32, retryDelay, maxRetryDelay time.Duration) (int, error) {
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	pending, err := qtx.ListPendingOutboxEventsForUpdate(ctx, batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pending outbox events from db: %w", err)
+	}
+
+	var published int
+	var publishErr error
+	for _, row := range pending {
+		event := outboxEventFromRow(row)
+		if publishErr = publisher.Publish(ctx, event); publishErr != nil {
+			lastError := publishErr.Error()
+			err := qtx.MarkOutboxEventFailed(ctx, repository.MarkOutboxEventFailedParams{
+				ID:            row.ID,
+				LastError:     &lastError,
+				NextAttemptAt: time.Now().Add(backoffDelay(row.Attempts, retryDelay, maxRetryDelay)),
+			})
+			if err != nil {
+				return 0, fmt.Errorf("failed to update outbox event in db: %w", err)
+			}
+			break
+		}
+
+		if err := qtx.MarkOutboxEventPublished(ctx, row.ID); err != nil {
+			return 0, fmt.Errorf("failed to update outbox event in db: %w", err)
+		}
+		published++
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if publishErr != nil {
+		return published, fmt.Errorf("failed to publish outbox event: %w", publishErr)
+	}
+
+	return published, nil
+}
+
+// backoffDelay is how long to wait before the next attempt of a delivery that
+// has failed attempts times before this failure.
+func backoffDelay(attempts int64, retryDelay, maxRetryDelay time.Duration) time.Duration {
+	delay := retryDelay
+	for range attempts {
+		if delay >= maxRetryDelay {
+			break
+		}
+		delay *= 2
+	}
+
+	return min(delay, maxRetryDelay)
+}
+
+func outboxEventFromRow(event repository.Outbox) *dto.OutboxEvent {
+	return &dto.OutboxEvent{
+		Id:            event.ID,
+		EventId:       event.EventID,
+		Type:          event.EventType,
+		Version:       int32(event.EventVersion),
+		AggregateType: event.AggregateType,
+		AggregateId:   event.AggregateID,
+		CorrelationId: utils.NilToValueType(event.CorrelationID),
+		Payload:       event.Payload,
+		Attempts:      int32(event.Attempts),
+		CreatedAt:     utils.NilToValueType(event.CreatedAt),
+	}
+}
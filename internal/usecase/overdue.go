@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"loan_service/internal/dto"
+	"loan_service/internal/events"
+	eventspb "loan_service/internal/proto/events"
+	"loan_service/pkg/utils"
+	"time"
+)
+
+// RefreshOverdueLoans brings loan delinquency up to date as of asOf. Installments
+// past their due date are marked OVERDUE, loans with arrears are moved to OVERDUE
+// with their days past due counted from the oldest unpaid installment, and
+// overdue loans whose arrears have been cleared are restored to ACTIVE.
+// It returns the loans that became overdue and the loans that were restored.
+func (uc *LoanUsecase) RefreshOverdueLoans(ctx context.Context, asOf time.Time) ([]*dto.Loan, []*dto.Loan, error) {
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	if _, err := qtx.MarkInstallmentsOverdue(ctx, asOf); err != nil {
+		return nil, nil, fmt.Errorf("failed to mark overdue installments in db: %w", err)
+	}
+
+	restoredLoans, err := qtx.RestoreActiveLoans(ctx, asOf)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to restore active loans in db: %w", err)
+	}
+
+	if _, err := qtx.UpdateLoansDaysPastDue(ctx, asOf); err != nil {
+		return nil, nil, fmt.Errorf("failed to update days past due in db: %w", err)
+	}
+
+	overdueLoans, err := qtx.MarkLoansOverdue(ctx, asOf)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to mark overdue loans in db: %w", err)
+	}
+
+	for _, loan := range overdueLoans {
+		err := recordEvent(ctx, qtx, events.TypeLoanOverdue, events.AggregateLoan, loan.ID, &eventspb.LoanOverdueV1{
+			LoanId:           loan.ID,
+			UserId:           loan.UserID,
+			DaysPastDue:      int32(loan.DaysPastDue),
+			RemainingBalance: events.Money(utils.NilToValueType(loan.RemainingBalance), loan.CurrencyCode),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	overdue := make([]*dto.Loan, len(overdueLoans))
+	for index, loan := range overdueLoans {
+		overdue[index] = loanFromRow(loan)
+	}
+
+	restored := make([]*dto.Loan, len(restoredLoans))
+	for index, loan := range restoredLoans {
+		restored[index] = loanFromRow(loan)
+	}
+
+	return overdue, restored, nil
+}
@@ -0,0 +1,256 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"loan_service/internal/dto"
+	"loan_service/internal/events"
+	eventspb "loan_service/internal/proto/events"
+	"loan_service/internal/repository"
+	"loan_service/pkg/money"
+	"loan_service/pkg/utils"
+	"time"
+)
+
+var (
+	ErrLoanClosed            = errors.New("loan is already paid off")
+	ErrCurrencyMismatch      = errors.New("payment currency does not match loan currency")
+	ErrPaymentExceedsBalance = errors.New("payment amount exceeds remaining balance")
+	ErrDuplicateTransaction  = errors.New("payment with this transaction id is already recorded")
+	ErrPaymentMismatch       = errors.New("payment does not match the initiated payment")
+	ErrInvalidAmount         = errors.New("invalid amount")
+)
+
+const (
+	paymentStatusPending   = "PENDING"
+	paymentStatusCompleted = "COMPLETED"
+	paymentStatusFailed    = "FAILED"
+)
+
+// RecordPayment stores a payment, allocates it to the loan's charges and
+// oldest open installments in the configured order and decreases the loan's
+// remaining balance in one transaction. The loan is flipped to PAID once both
+// the balance and the outstanding charges reach zero. A payment whose
+// transaction id is already recorded is rejected with ErrDuplicateTransaction,
+// so redelivered payment confirmations are applied once; a payment created
+// by InitiateInstallmentPayment is completed instead of recorded again.
+func (uc *LoanUsecase) RecordPayment(ctx context.Context, payment *dto.Payment) (*dto.Payment, *dto.Loan, error) {
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	loan, err := qtx.GetLoanForUpdate(ctx, payment.LoanId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get loan from db: %w", err)
+	}
+
+	// The loan lock serializes payments to the loan, so a concurrent
+	// duplicate is seen here once the first one commits.
+	var transactionId *string
+	var initiated *repository.Payment
+	if payment.TransactionId != "" {
+		transactionId = &payment.TransactionId
+
+		existing, err := qtx.GetPaymentByTransactionID(ctx, transactionId)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+		case err != nil:
+			return nil, nil, fmt.Errorf("failed to get payment from db: %w", err)
+		case utils.NilToValueType(existing.Status) == paymentStatusCompleted:
+			return nil, nil, fmt.Errorf("%w: %s", ErrDuplicateTransaction, payment.TransactionId)
+		case existing.LoanID != loan.ID || utils.NilToValueType(existing.Amount) != payment.Amount:
+			return nil, nil, fmt.Errorf("%w: transaction %s was initiated for %s on loan %d", ErrPaymentMismatch, payment.TransactionId, utils.NilToValueType(existing.Amount), existing.LoanID)
+		default:
+			initiated = &existing
+		}
+	}
+
+	if loan.Status.LoanStatus == repository.LoanStatusPAID {
+		return nil, nil, ErrLoanClosed
+	}
+
+	if payment.CurrencyCode != loan.CurrencyCode {
+		return nil, nil, fmt.Errorf("%w: expected %s, got %s", ErrCurrencyMismatch, loan.CurrencyCode, payment.CurrencyCode)
+	}
+
+	chargesOutstanding, err := loanChargesOutstanding(ctx, qtx, loan.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	remainingBalance := utils.NilToValueType(loan.RemainingBalance)
+	if payment.Amount > remainingBalance+chargesOutstanding {
+		return nil, nil, fmt.Errorf("%w: remaining balance is %s and outstanding charges are %s", ErrPaymentExceedsBalance, remainingBalance, chargesOutstanding)
+	}
+
+	if payment.PaymentDate.IsZero() {
+		payment.PaymentDate = time.Now()
+	}
+
+	toCharges, toCharity, err := uc.allocatePayment(ctx, qtx, loan.ID, payment.Amount, payment.PaymentDate)
+	if err != nil {
+		return nil, nil, err
+	}
+	remainingBalance -= payment.Amount - toCharges
+	chargesOutstanding -= toCharges
+
+	loanStatus := loan.Status
+	if remainingBalance == 0 && chargesOutstanding == 0 {
+		loanStatus = repository.NullLoanStatus{
+			LoanStatus: repository.LoanStatusPAID,
+			Valid:      true,
+		}
+	}
+
+	status := paymentStatusCompleted
+	var createdPayment repository.Payment
+	if initiated != nil {
+		createdPayment, err = qtx.UpdatePayment(ctx, repository.UpdatePaymentParams{
+			ID:          initiated.ID,
+			Status:      &status,
+			PaymentDate: &payment.PaymentDate,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to update payment in db: %w", err)
+		}
+	} else {
+		createdPayment, err = qtx.CreatePayment(ctx, repository.CreatePaymentParams{
+			LoanID:        loan.ID,
+			CurrencyCode:  payment.CurrencyCode,
+			PaymentDate:   &payment.PaymentDate,
+			Amount:        &payment.Amount,
+			Method:        &payment.Method,
+			Status:        &status,
+			TransactionID: transactionId,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create payment in db: %w", err)
+		}
+	}
+
+	updatedLoan, err := qtx.UpdateLoanBalance(ctx, repository.UpdateLoanBalanceParams{
+		ID:               loan.ID,
+		RemainingBalance: &remainingBalance,
+		Status:           loanStatus,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update loan balance in db: %w", err)
+	}
+
+	updatedLoan, err = transferOwnership(ctx, qtx, updatedLoan, payment.PaymentDate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := uc.recordPaymentReceived(ctx, qtx, createdPayment, updatedLoan, toCharity); err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	resultLoan := loanFromRow(updatedLoan)
+	resultLoan.ChargesOutstanding = chargesOutstanding
+
+	return paymentFromRow(createdPayment), resultLoan, nil
+}
+
+func (uc *LoanUsecase) GetPayment(ctx context.Context, id int64) (*dto.Payment, error) {
+	payment, err := uc.queries.GetPayment(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment from db: %w", err)
+	}
+
+	return paymentFromRow(payment), nil
+}
+
+func (uc *LoanUsecase) ListPayments(ctx context.Context, loanId int64, limit, offset int32) ([]*dto.Payment, error) {
+	payments, err := uc.queries.ListPaymentsByLoan(ctx, repository.ListPaymentsByLoanParams{
+		LoanID: loanId,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payments from db: %w", err)
+	}
+
+	result := make([]*dto.Payment, len(payments))
+	for index, payment := range payments {
+		result[index] = paymentFromRow(payment)
+	}
+
+	return result, nil
+}
+
+func (uc *LoanUsecase) CountPayments(ctx context.Context, loanId int64) (*int64, error) {
+	countPayments, err := uc.queries.CountPayments(ctx, loanId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count payments from db: %w", err)
+	}
+
+	return &countPayments, nil
+}
+
+// recordPaymentReceived adds the PaymentReceived event of a payment applied
+// to loan, which is the loan as updated by the payment. charity is the part of
+// the payment that went to charges owed to charity; the event routes it to
+// the charity account.
+func (uc *LoanUsecase) recordPaymentReceived(ctx context.Context, qtx *repository.Queries, payment repository.Payment, loan repository.Loan, charity money.Amount) error {
+	event := &eventspb.PaymentReceivedV1{
+		PaymentId:        payment.ID,
+		LoanId:           loan.ID,
+		UserId:           loan.UserID,
+		Amount:           events.Money(utils.NilToValueType(payment.Amount), payment.CurrencyCode),
+		PaymentDate:      events.Timestamp(utils.NilToValueType(payment.PaymentDate)),
+		TransactionId:    utils.NilToValueType(payment.TransactionID),
+		RemainingBalance: events.Money(utils.NilToValueType(loan.RemainingBalance), loan.CurrencyCode),
+		LoanStatus:       string(loan.Status.LoanStatus),
+	}
+
+	if charity > 0 {
+		event.CharityAmount = events.Money(charity, loan.CurrencyCode)
+		event.CharityAccount = uc.penaltyRules.CharityAccount
+	}
+
+	if loan.OwnershipTransferredAt != nil {
+		event.OwnershipTransferredAt = events.Timestamp(*loan.OwnershipTransferredAt)
+	}
+
+	return recordEvent(ctx, qtx, events.TypePaymentReceived, events.AggregateLoan, loan.ID, event)
+}
+
+func paymentFromRow(payment repository.Payment) *dto.Payment {
+	return &dto.Payment{
+		Id:            payment.ID,
+		LoanId:        payment.LoanID,
+		CurrencyCode:  payment.CurrencyCode,
+		PaymentDate:   utils.NilToValueType(payment.PaymentDate),
+		Amount:        utils.NilToValueType(payment.Amount),
+		Method:        utils.NilToValueType(payment.Method),
+		Status:        utils.NilToValueType(payment.Status),
+		TransactionId: utils.NilToValueType(payment.TransactionID),
+		CreatedAt:     utils.NilToValueType(payment.CreatedAt),
+	}
+}
+
+// loanAmount returns requested as an amount in the currency of loan. An
+// amount without a currency is taken to be in the loan's currency; one in
+// another currency is refused rather than converted.
+func loanAmount(loan repository.Loan, requested money.Money) (money.Amount, error) {
+	if requested.Currency != "" && requested.Currency != loan.CurrencyCode {
+		return 0, fmt.Errorf("%w: expected %s, got %s", ErrCurrencyMismatch, loan.CurrencyCode, requested.Currency)
+	}
+
+	if currency := money.Lookup(loan.CurrencyCode); currency.Round(float64(requested.Amount)) != requested.Amount {
+		return 0, fmt.Errorf("%w: %s has more than %d decimal places for %s", ErrInvalidAmount, requested.Amount, currency.MinorUnits, loan.CurrencyCode)
+	}
+
+	return requested.Amount, nil
+}
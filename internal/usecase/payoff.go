@@ -0,0 +1,298 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"loan_service/internal/calculator"
+	"loan_service/internal/dto"
+	"loan_service/internal/repository"
+	"loan_service/pkg/money"
+	"loan_service/pkg/utils"
+	"time"
+)
+
+const payoffQuoteStatusIssued = "ISSUED"
+
+var (
+	ErrPayoffQuoteExpired = errors.New("payoff quote has expired")
+	ErrPayoffQuoteUsed    = errors.New("payoff quote has already been settled")
+	ErrPayoffQuoteStale   = errors.New("loan has changed since the payoff quote was issued")
+)
+
+// GetPayoffQuote computes and stores the amount that closes a loan if paid by
+// the end of the quote's validity. It consists of the unpaid principal, the
+// margin earned up to the validity date, unpaid charges and the
+// early-settlement fee; margin of later periods is waived. The markup of a
+// MURABAHA or IJARA loan was fixed with the contract, so it is owed in full
+// less the rebate (ibra) of payoff.ibra_percent of the markup not yet due.
+func (uc *LoanUsecase) GetPayoffQuote(ctx context.Context, loanId int64) (*dto.PayoffQuote, error) {
+	loan, err := uc.queries.GetLoan(ctx, loanId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan from db: %w", err)
+	}
+
+	if loan.Status.LoanStatus == repository.LoanStatusPAID {
+		return nil, ErrLoanClosed
+	}
+
+	installments, err := uc.queries.ListInstallmentsByLoan(ctx, loan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get installments from db: %w", err)
+	}
+
+	charges, err := loanChargesOutstanding(ctx, uc.queries, loan.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	validUntil := time.Date(now.Year(), now.Month(), now.Day()+uc.payoffCfg.ValidityDays, 23, 59, 59, 0, now.Location())
+
+	currency := money.Lookup(loan.CurrencyCode)
+	principal, margin, marginWaived := payoffAmounts(currency, loan, installments, validUntil, uc.payoffCfg.IbraPercent)
+	settlementFee := currency.Round(float64(principal) * uc.payoffCfg.SettlementFeeRate / 100)
+
+	quote, err := uc.queries.CreatePayoffQuote(ctx, repository.CreatePayoffQuoteParams{
+		LoanID:           loan.ID,
+		Principal:        principal,
+		Margin:           margin,
+		MarginWaived:     marginWaived,
+		Charges:          charges,
+		SettlementFee:    settlementFee,
+		Total:            principal + margin + charges + settlementFee,
+		RemainingBalance: utils.NilToValueType(loan.RemainingBalance),
+		ValidUntil:       validUntil,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payoff quote in db: %w", err)
+	}
+
+	return payoffQuoteFromRow(quote, loan.CurrencyCode), nil
+}
+
+// SettleLoan closes a loan as PAID with a payment of the quote's total. The
+// quote has to be unused and still valid, both now and on the payment date,
+// and the loan must not have changed since it was issued. A payment whose
+// transaction id is already recorded is rejected with ErrDuplicateTransaction.
+func (uc *LoanUsecase) SettleLoan(ctx context.Context, quoteId int64, payment *dto.Payment) (*dto.PayoffQuote, *dto.Loan, *dto.Payment, error) {
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	quote, err := qtx.GetPayoffQuoteForUpdate(ctx, quoteId)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get payoff quote from db: %w", err)
+	}
+
+	if quote.Status != payoffQuoteStatusIssued {
+		return nil, nil, nil, ErrPayoffQuoteUsed
+	}
+
+	if payment.PaymentDate.IsZero() {
+		payment.PaymentDate = time.Now()
+	}
+
+	if time.Now().After(quote.ValidUntil) || payment.PaymentDate.After(quote.ValidUntil) {
+		return nil, nil, nil, fmt.Errorf("%w: valid until %s", ErrPayoffQuoteExpired, quote.ValidUntil.Format(time.RFC3339))
+	}
+
+	loan, err := qtx.GetLoanForUpdate(ctx, quote.LoanID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get loan from db: %w", err)
+	}
+
+	if loan.Status.LoanStatus == repository.LoanStatusPAID {
+		return nil, nil, nil, ErrLoanClosed
+	}
+
+	if payment.CurrencyCode != loan.CurrencyCode {
+		return nil, nil, nil, fmt.Errorf("%w: expected %s, got %s", ErrCurrencyMismatch, loan.CurrencyCode, payment.CurrencyCode)
+	}
+
+	charges, err := qtx.ListOpenLoanChargesForUpdate(ctx, loan.ID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get open charges from db: %w", err)
+	}
+
+	var chargesOutstanding, chargesToCharity money.Amount
+	for _, charge := range charges {
+		chargesOutstanding += charge.Amount - charge.PaidAmount
+		if charge.Charity {
+			chargesToCharity += charge.Amount - charge.PaidAmount
+		}
+	}
+
+	if utils.NilToValueType(loan.RemainingBalance) != quote.RemainingBalance || chargesOutstanding != quote.Charges {
+		return nil, nil, nil, ErrPayoffQuoteStale
+	}
+
+	installments, err := qtx.ListOpenInstallmentsForUpdate(ctx, loan.ID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get open installments from db: %w", err)
+	}
+
+	// The quoted margin goes to the oldest installments; whatever margin is
+	// left unpaid after that is waived.
+	margin := quote.Margin
+	for _, installment := range installments {
+		toMargin := min(margin, installment.MarginDue-installment.MarginPaid)
+		margin -= toMargin
+
+		_, err := qtx.UpdateInstallmentPayment(ctx, repository.UpdateInstallmentPaymentParams{
+			ID:            installment.ID,
+			PrincipalPaid: installment.PrincipalDue,
+			MarginPaid:    installment.MarginPaid + toMargin,
+			Status:        repository.InstallmentStatusPAID,
+			PaidAt:        &payment.PaymentDate,
+		})
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to update installment in db: %w", err)
+		}
+	}
+
+	for _, charge := range charges {
+		err := qtx.UpdateLoanChargePayment(ctx, repository.UpdateLoanChargePaymentParams{
+			ID:         charge.ID,
+			PaidAmount: charge.Amount,
+		})
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to update loan charge in db: %w", err)
+		}
+	}
+
+	var transactionId *string
+	if payment.TransactionId != "" {
+		transactionId = &payment.TransactionId
+	}
+
+	var remainingBalance money.Amount
+	status := paymentStatusCompleted
+	createdPayment, err := qtx.CreatePayment(ctx, repository.CreatePaymentParams{
+		LoanID:        loan.ID,
+		CurrencyCode:  payment.CurrencyCode,
+		PaymentDate:   &payment.PaymentDate,
+		Amount:        &quote.Total,
+		Method:        &payment.Method,
+		Status:        &status,
+		TransactionID: transactionId,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, nil, nil, fmt.Errorf("%w: %s", ErrDuplicateTransaction, payment.TransactionId)
+		}
+		return nil, nil, nil, fmt.Errorf("failed to create payment in db: %w", err)
+	}
+
+	updatedLoan, err := qtx.UpdateLoanBalance(ctx, repository.UpdateLoanBalanceParams{
+		ID:               loan.ID,
+		RemainingBalance: &remainingBalance,
+		Status: repository.NullLoanStatus{
+			LoanStatus: repository.LoanStatusPAID,
+			Valid:      true,
+		},
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to update loan balance in db: %w", err)
+	}
+
+	updatedLoan, err = transferOwnership(ctx, qtx, updatedLoan, payment.PaymentDate)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := uc.recordPaymentReceived(ctx, qtx, createdPayment, updatedLoan, chargesToCharity); err != nil {
+		return nil, nil, nil, err
+	}
+
+	settledQuote, err := qtx.MarkPayoffQuoteSettled(ctx, repository.MarkPayoffQuoteSettledParams{
+		ID:        quote.ID,
+		PaymentID: &createdPayment.ID,
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to settle payoff quote in db: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return payoffQuoteFromRow(settledQuote, loan.CurrencyCode), loanFromRow(updatedLoan), paymentFromRow(createdPayment), nil
+}
+
+// payoffAmounts splits what is left on a loan as of date into unpaid principal,
+// margin earned by then and margin to be waived. Margin of the period running
+// on date is earned in proportion to the days elapsed in it. A MURABAHA or
+// IJARA loan owes all of its unpaid markup instead, less the ibra of
+// ibraPercent of the markup of installments due after date, as if their cost
+// were prepaid. Loans without stored installments are quoted at their
+// remaining balance.
+func payoffAmounts(currency money.Currency, loan repository.Loan, installments []repository.Installment, date time.Time, ibraPercent float64) (money.Amount, money.Amount, money.Amount) {
+	if len(installments) == 0 {
+		return utils.NilToValueType(loan.RemainingBalance), 0, 0
+	}
+
+	if calculator.ShariaCompliant(string(loan.RepaymentMethod)) {
+		var principal, margin, futurePrincipal, futureMargin money.Amount
+		for _, installment := range installments {
+			unpaidPrincipal := installment.PrincipalDue - installment.PrincipalPaid
+			unpaidMargin := installment.MarginDue - installment.MarginPaid
+			principal += unpaidPrincipal
+			margin += unpaidMargin
+
+			if installment.DueDate.After(date) {
+				futurePrincipal += unpaidPrincipal
+				futureMargin += unpaidMargin
+			}
+		}
+
+		rebate := ibra(currency, futurePrincipal, futureMargin, futurePrincipal, ibraPercent)
+		return principal, margin - rebate, rebate
+	}
+
+	var principal, margin, marginWaived money.Amount
+	periodStart := utils.NilToValueType(loan.CreatedAt)
+	for _, installment := range installments {
+		unpaidMargin := installment.MarginDue - installment.MarginPaid
+		principal += installment.PrincipalDue - installment.PrincipalPaid
+
+		switch {
+		case !installment.DueDate.After(date):
+			margin += unpaidMargin
+		case periodStart.Before(date):
+			elapsed := date.Sub(periodStart).Hours()
+			period := installment.DueDate.Sub(periodStart).Hours()
+			earned := currency.Round(float64(installment.MarginDue) * elapsed / period)
+			earnedUnpaid := max(0, min(unpaidMargin, earned-installment.MarginPaid))
+			margin += earnedUnpaid
+			marginWaived += unpaidMargin - earnedUnpaid
+		default:
+			marginWaived += unpaidMargin
+		}
+
+		periodStart = installment.DueDate
+	}
+
+	return principal, margin, marginWaived
+}
+
+func payoffQuoteFromRow(quote repository.PayoffQuote, currencyCode string) *dto.PayoffQuote {
+	return &dto.PayoffQuote{
+		Id:            quote.ID,
+		LoanId:        quote.LoanID,
+		CurrencyCode:  currencyCode,
+		Principal:     quote.Principal,
+		Margin:        quote.Margin,
+		MarginWaived:  quote.MarginWaived,
+		Charges:       quote.Charges,
+		SettlementFee: quote.SettlementFee,
+		Total:         quote.Total,
+		ValidUntil:    quote.ValidUntil,
+		Status:        quote.Status,
+		CreatedAt:     utils.NilToValueType(quote.CreatedAt),
+	}
+}
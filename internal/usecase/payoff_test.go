@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"fmt"
+	"loan_service/internal/repository"
+	"loan_service/pkg/money"
+	"testing"
+)
+
+// TestPayoffAmountsSharia checks that a MURABAHA or IJARA payoff owes the
+// contracted markup, less the ibra on the markup not yet due, whatever the
+// day in the period.
+func TestPayoffAmountsSharia(t *testing.T) {
+	tjs := money.Lookup("TJS")
+
+	for _, method := range []repository.RepaymentMethod{repository.RepaymentMethodMURABAHA, repository.RepaymentMethodIJARA} {
+		for _, ibraPercent := range []float64{0, 50, 100} {
+			t.Run(fmt.Sprintf("%s/%g%%", method, ibraPercent), func(t *testing.T) {
+				loan, installments := testLoan(t, method, money.New(20000000, "TJS"), 36, 18, 6)
+				_, pendingPrincipal, pendingMargin := pendingTotals(installments)
+				// Halfway through the period of the 8th installment, the 7th unpaid.
+				date := installments[6].DueDate.AddDate(0, 0, 15)
+
+				var futurePrincipal, futureMargin money.Amount
+				for _, installment := range installments[7:] {
+					futurePrincipal += installment.PrincipalDue
+					futureMargin += installment.MarginDue
+				}
+				rebate := ibra(tjs, futurePrincipal, futureMargin, futurePrincipal, ibraPercent)
+
+				principal, margin, marginWaived := payoffAmounts(tjs, loan, installments, date, ibraPercent)
+				if principal != pendingPrincipal {
+					t.Errorf("principal = %s, want %s", principal, pendingPrincipal)
+				}
+				if margin != pendingMargin-rebate || marginWaived != rebate {
+					t.Errorf("margin = %s, waived %s; want %s less the ibra %s", margin, marginWaived, pendingMargin, rebate)
+				}
+				if ibraPercent == 0 && marginWaived != 0 {
+					t.Errorf("waived %s with no ibra", marginWaived)
+				}
+			})
+		}
+	}
+}
+
+// TestPayoffAmountsEarned checks that a conventional payoff owes the margin of
+// the periods that are due and waives the rest.
+func TestPayoffAmountsEarned(t *testing.T) {
+	tjs := money.Lookup("TJS")
+	loan, installments := testLoan(t, repository.RepaymentMethodANNUITY, money.New(20000000, "TJS"), 36, 18, 6)
+	_, pendingPrincipal, pendingMargin := pendingTotals(installments)
+
+	principal, margin, marginWaived := payoffAmounts(tjs, loan, installments, installments[6].DueDate, 100)
+	if principal != pendingPrincipal {
+		t.Errorf("principal = %s, want %s", principal, pendingPrincipal)
+	}
+	if margin != installments[6].MarginDue {
+		t.Errorf("margin = %s, want the %s of the installment due", margin, installments[6].MarginDue)
+	}
+	if margin+marginWaived != pendingMargin {
+		t.Errorf("margin %s and waived %s do not add up to %s", margin, marginWaived, pendingMargin)
+	}
+}
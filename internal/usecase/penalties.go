@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"loan_service/internal/calculator"
+	"loan_service/internal/repository"
+	"loan_service/pkg/money"
+	"loan_service/pkg/utils"
+	"time"
+)
+
+// AccruePenalties charges every OVERDUE loan for being late as of asOf: a fixed
+// fee for each overdue installment not charged yet and the daily penalty on the
+// overdue amount for every day up to asOf that has none yet, all trimmed to the
+// cap. Days missed since the last daily penalty, e.g. while the worker was
+// down, are caught up, each on what was overdue that day. Charges are unique
+// per installment and per day, so repeated runs on the same day accrue nothing
+// new. Charges of MURABAHA and IJARA loans are marked for charity.
+// It returns the number of charges created.
+func (uc *LoanUsecase) AccruePenalties(ctx context.Context, asOf time.Time) (int, error) {
+	loans, err := uc.queries.ListOverdueLoans(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get overdue loans from db: %w", err)
+	}
+
+	var accrued int
+	for _, loan := range loans {
+		count, err := uc.accrueLoanPenalties(ctx, loan.ID, asOf)
+		if err != nil {
+			return accrued, fmt.Errorf("failed to accrue penalties for loan %d: %w", loan.ID, err)
+		}
+		accrued += count
+	}
+
+	return accrued, nil
+}
+
+func (uc *LoanUsecase) accrueLoanPenalties(ctx context.Context, loanId int64, asOf time.Time) (int, error) {
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	loan, err := qtx.GetLoanForUpdate(ctx, loanId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get loan from db: %w", err)
+	}
+
+	if loan.Status.LoanStatus != repository.LoanStatusOVERDUE {
+		return 0, nil
+	}
+
+	totals, err := qtx.GetLoanChargeTotals(ctx, loan.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get loan charge totals from db: %w", err)
+	}
+
+	installments, err := qtx.ListInstallmentsByLoan(ctx, loan.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get installments from db: %w", err)
+	}
+
+	currency := money.Lookup(loan.CurrencyCode)
+	loanAmount := utils.NilToValueType(loan.Amount)
+	charity := calculator.ShariaCompliant(string(loan.RepaymentMethod))
+	charged := totals.Charged
+	var accrued int
+
+	addCharge := func(chargeType repository.ChargeType, installmentId *int64, amount money.Amount, accruedOn time.Time) error {
+		amount = uc.penaltyRules.Limit(currency, amount, charged, loanAmount)
+		if amount == 0 {
+			return nil
+		}
+
+		created, err := qtx.CreateLoanCharge(ctx, repository.CreateLoanChargeParams{
+			LoanID:        loan.ID,
+			InstallmentID: installmentId,
+			Type:          chargeType,
+			Amount:        amount,
+			AccruedOn:     accruedOn,
+			Charity:       charity,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create loan charge in db: %w", err)
+		}
+
+		if created > 0 {
+			charged += amount
+			accrued++
+		}
+		return nil
+	}
+
+	var overdue []repository.Installment
+	for _, installment := range installments {
+		if installment.Status != repository.InstallmentStatusOVERDUE {
+			continue
+		}
+		overdue = append(overdue, installment)
+
+		if err := addCharge(repository.ChargeTypeLATEFEE, &installment.ID, uc.penaltyRules.FixedFee, asOf); err != nil {
+			return 0, err
+		}
+	}
+
+	days, err := penaltyDays(ctx, qtx, loan.ID, overdue, asOf)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, day := range days {
+		var overdueAmount money.Amount
+		for _, installment := range overdue {
+			if installment.DueDate.Before(day) {
+				overdueAmount += installment.PrincipalDue + installment.MarginDue -
+					installment.PrincipalPaid - installment.MarginPaid
+			}
+		}
+
+		if err := addCharge(repository.ChargeTypePENALTY, nil, uc.penaltyRules.DailyPenalty(currency, overdueAmount), day); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return accrued, nil
+}
+
+// penaltyDays returns the days up to asOf a loan owes a daily penalty for and
+// has not been charged one: those after its last daily penalty or, if it has
+// none yet, after the due date of its oldest overdue installment.
+func penaltyDays(ctx context.Context, qtx *repository.Queries, loanId int64, overdue []repository.Installment, asOf time.Time) ([]time.Time, error) {
+	if len(overdue) == 0 {
+		return nil, nil
+	}
+
+	var from time.Time
+	lastAccruedOn, err := qtx.GetLastPenaltyAccruedOn(ctx, loanId)
+	switch {
+	case err == nil:
+		from = lastAccruedOn
+	case errors.Is(err, sql.ErrNoRows):
+		from = overdue[0].DueDate
+		for _, installment := range overdue[1:] {
+			if installment.DueDate.Before(from) {
+				from = installment.DueDate
+			}
+		}
+	default:
+		return nil, fmt.Errorf("failed to get last penalty from db: %w", err)
+	}
+
+	year, month, day := asOf.Date()
+	until := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	var days []time.Time
+	for d := from.AddDate(0, 0, 1); !d.After(until); d = d.AddDate(0, 0, 1) {
+		days = append(days, d)
+	}
+
+	return days, nil
+}
+
+// loanChargesOutstanding returns the unpaid part of a loan's charges.
+func loanChargesOutstanding(ctx context.Context, q *repository.Queries, loanId int64) (money.Amount, error) {
+	totals, err := q.GetLoanChargeTotals(ctx, loanId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get loan charge totals from db: %w", err)
+	}
+
+	return totals.Charged - totals.Paid, nil
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Postgres error codes of the constraint violations the usecases expect.
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+func isUniqueViolation(err error) bool {
+	return hasPgErrorCode(err, uniqueViolation)
+}
+
+func isForeignKeyViolation(err error) bool {
+	return hasPgErrorCode(err, foreignKeyViolation)
+}
+
+func hasPgErrorCode(err error, code string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == code
+}
@@ -0,0 +1,363 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"loan_service/internal/calculator"
+	"loan_service/internal/dto"
+	"loan_service/internal/repository"
+	"loan_service/pkg/money"
+	"loan_service/pkg/utils"
+	"slices"
+	"time"
+)
+
+const (
+	PrepaymentReduceTerm    = "REDUCE_TERM"
+	PrepaymentReducePayment = "REDUCE_PAYMENT"
+)
+
+var (
+	ErrUnknownPrepaymentMode      = errors.New("unknown prepayment mode")
+	ErrPrepaymentNotAllowed       = errors.New("loan has arrears or unpaid charges")
+	ErrPrepaymentExceedsPrincipal = errors.New("prepayment amount exceeds outstanding principal")
+)
+
+// QuotePrepayment shows the effect of prepaying requested on a loan without
+// changing anything. requested must be in the loan's currency; one without a
+// currency is taken to be.
+func (uc *LoanUsecase) QuotePrepayment(ctx context.Context, loanId int64, requested money.Money, mode string) (*dto.PrepaymentQuote, error) {
+	loan, err := uc.queries.GetLoan(ctx, loanId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan from db: %w", err)
+	}
+
+	amount, err := loanAmount(loan, requested)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkPrepaymentAllowed(ctx, uc.queries, loan); err != nil {
+		return nil, err
+	}
+
+	installments, err := uc.queries.ListInstallmentsByLoan(ctx, loan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get installments from db: %w", err)
+	}
+
+	return planPrepayment(loan, installments, amount, mode, uc.payoffCfg.IbraPercent)
+}
+
+// ApplyPrepayment records amount as an early repayment of principal and
+// replaces the loan's pending installments with the recalculated ones in one
+// transaction. Installments already paid or partially paid stay as they are.
+// A payment without a currency is taken to be in the loan's currency. A payment
+// whose transaction id is already recorded is rejected with
+// ErrDuplicateTransaction.
+func (uc *LoanUsecase) ApplyPrepayment(ctx context.Context, payment *dto.Payment, mode string) (*dto.PrepaymentQuote, *dto.Loan, *dto.Payment, error) {
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	loan, err := qtx.GetLoanForUpdate(ctx, payment.LoanId)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get loan from db: %w", err)
+	}
+
+	if err := checkPrepaymentAllowed(ctx, qtx, loan); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if _, err := loanAmount(loan, money.New(payment.Amount, payment.CurrencyCode)); err != nil {
+		return nil, nil, nil, err
+	}
+	payment.CurrencyCode = loan.CurrencyCode
+
+	installments, err := qtx.ListInstallmentsByLoan(ctx, loan.ID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get installments from db: %w", err)
+	}
+
+	quote, err := planPrepayment(loan, installments, payment.Amount, mode, uc.payoffCfg.IbraPercent)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := qtx.DeletePendingInstallments(ctx, loan.ID); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to delete pending installments from db: %w", err)
+	}
+
+	if err := createInstallments(ctx, qtx, loan.ID, quote.Schedule); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if payment.PaymentDate.IsZero() {
+		payment.PaymentDate = time.Now()
+	}
+
+	var transactionId *string
+	if payment.TransactionId != "" {
+		transactionId = &payment.TransactionId
+	}
+
+	status := paymentStatusCompleted
+	createdPayment, err := qtx.CreatePayment(ctx, repository.CreatePaymentParams{
+		LoanID:        loan.ID,
+		CurrencyCode:  payment.CurrencyCode,
+		PaymentDate:   &payment.PaymentDate,
+		Amount:        &payment.Amount,
+		Method:        &payment.Method,
+		Status:        &status,
+		TransactionID: transactionId,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, nil, nil, fmt.Errorf("%w: %s", ErrDuplicateTransaction, payment.TransactionId)
+		}
+		return nil, nil, nil, fmt.Errorf("failed to create payment in db: %w", err)
+	}
+
+	monthlyPayment := loan.MonthlyPayment
+	if len(quote.Schedule) > 0 {
+		monthlyPayment = &quote.MonthlyPayment
+	}
+
+	loanStatus := loan.Status
+	if quote.RemainingBalance == 0 {
+		loanStatus = repository.NullLoanStatus{
+			LoanStatus: repository.LoanStatusPAID,
+			Valid:      true,
+		}
+	}
+
+	updatedLoan, err := qtx.UpdateLoanSchedule(ctx, repository.UpdateLoanScheduleParams{
+		ID:               loan.ID,
+		TermMonths:       utils.PtrNumeric[int32, int64](quote.TermMonths),
+		MonthlyPayment:   monthlyPayment,
+		RemainingBalance: &quote.RemainingBalance,
+		Status:           loanStatus,
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to update loan schedule in db: %w", err)
+	}
+
+	updatedLoan, err = transferOwnership(ctx, qtx, updatedLoan, payment.PaymentDate)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Prepayments are only taken with no charges outstanding.
+	if err := uc.recordPaymentReceived(ctx, qtx, createdPayment, updatedLoan, 0); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return quote, loanFromRow(updatedLoan), paymentFromRow(createdPayment), nil
+}
+
+// checkPrepaymentAllowed only lets current loans prepay: the loan has to be
+// ACTIVE with all charges settled.
+func checkPrepaymentAllowed(ctx context.Context, q *repository.Queries, loan repository.Loan) error {
+	switch loan.Status.LoanStatus {
+	case repository.LoanStatusPAID:
+		return ErrLoanClosed
+	case repository.LoanStatusOVERDUE:
+		return ErrPrepaymentNotAllowed
+	}
+
+	chargesOutstanding, err := loanChargesOutstanding(ctx, q, loan.ID)
+	if err != nil {
+		return err
+	}
+	if chargesOutstanding > 0 {
+		return fmt.Errorf("%w: outstanding charges are %s", ErrPrepaymentNotAllowed, chargesOutstanding)
+	}
+
+	return nil
+}
+
+// planPrepayment takes amount off the principal of the loan's pending
+// installments and rebuilds them with the loan's repayment method. With
+// REDUCE_PAYMENT the number of installments stays and the payment drops; with
+// REDUCE_TERM the payment stays at most the current one and the term shrinks.
+// Rebuilt installments keep the numbers and due dates of the ones they replace.
+//
+// The markup of a MURABAHA or IJARA loan was fixed with the contract, so it is
+// not repriced: the pending installments keep it, less the rebate (ibra) of
+// ibraPercent of the markup on the cost prepaid.
+func planPrepayment(loan repository.Loan, installments []repository.Installment, amount money.Amount, mode string, ibraPercent float64) (*dto.PrepaymentQuote, error) {
+	if mode != PrepaymentReduceTerm && mode != PrepaymentReducePayment {
+		return nil, fmt.Errorf("%w %q", ErrUnknownPrepaymentMode, mode)
+	}
+
+	calc, err := calculator.ForMethod(string(loan.RepaymentMethod))
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []repository.Installment
+	var pendingPrincipal, pendingMargin money.Amount
+	for _, installment := range installments {
+		if installment.Status != repository.InstallmentStatusPENDING {
+			continue
+		}
+
+		pending = append(pending, installment)
+		pendingPrincipal += installment.PrincipalDue
+		pendingMargin += installment.MarginDue
+	}
+	pendingTotal := pendingPrincipal + pendingMargin
+
+	if amount > pendingPrincipal {
+		return nil, fmt.Errorf("%w: outstanding principal is %s", ErrPrepaymentExceedsPrincipal, pendingPrincipal)
+	}
+
+	principal := money.New(pendingPrincipal-amount, loan.CurrencyCode)
+	marginRate := utils.NilToValueType(loan.MarginRate)
+
+	var schedule []dto.RepaymentInstallment
+	switch {
+	case calculator.ShariaCompliant(string(loan.RepaymentMethod)):
+		rebate := ibra(money.Lookup(loan.CurrencyCode), pendingPrincipal, pendingMargin, amount, ibraPercent)
+		schedule = contractedSchedule(loan, pending, principal.Amount, pendingMargin-rebate, mode)
+	case principal.Amount > 0:
+		switch mode {
+		case PrepaymentReducePayment:
+			schedule = calc.Schedule(principal, int32(len(pending)), marginRate, time.Time{})
+		case PrepaymentReduceTerm:
+			currentPayment := pending[0].PrincipalDue + pending[0].MarginDue
+			for term := int32(1); term <= int32(len(pending)); term++ {
+				schedule = calc.Schedule(principal, term, marginRate, time.Time{})
+				if monthly, _ := calculator.Quote(schedule); monthly <= currentPayment {
+					break
+				}
+			}
+		}
+	}
+
+	for index := range schedule {
+		schedule[index].Number = int32(pending[index].Number)
+		schedule[index].DueDate = pending[index].DueDate
+	}
+
+	monthly, total := calculator.Quote(schedule)
+
+	return &dto.PrepaymentQuote{
+		LoanId:           loan.ID,
+		CurrencyCode:     loan.CurrencyCode,
+		Amount:           amount,
+		Mode:             mode,
+		TermMonths:       int32(len(installments)-len(pending)) + int32(len(schedule)),
+		MonthlyPayment:   monthly,
+		RemainingBalance: utils.NilToValueType(loan.RemainingBalance) - pendingTotal + total,
+		MarginSaved:      pendingTotal - total - amount,
+		Schedule:         schedule,
+	}, nil
+}
+
+// ibra is the rebate of markup on a prepayment of amount towards a MURABAHA or
+// IJARA loan: ibraPercent of the markup of the pending installments that falls
+// on the cost prepaid.
+func ibra(currency money.Currency, pendingPrincipal, pendingMargin, amount money.Amount, ibraPercent float64) money.Amount {
+	if pendingPrincipal == 0 {
+		return 0
+	}
+
+	return currency.Round(float64(pendingMargin) * float64(amount) / float64(pendingPrincipal) * ibraPercent / 100)
+}
+
+// contractedSchedule rebuilds the pending installments of a MURABAHA or IJARA
+// loan from the principal and markup left on them. Both are spread in
+// proportion to what the installments carried, so with REDUCE_PAYMENT every
+// installment keeps its markup unless a rebate lowers it. With REDUCE_TERM the
+// fewest leading installments whose payments stay at most the current one
+// take them. The last installment of an IJARA loan transfers ownership.
+func contractedSchedule(loan repository.Loan, pending []repository.Installment, principal, margin money.Amount, mode string) []dto.RepaymentInstallment {
+	if principal+margin == 0 {
+		return nil
+	}
+
+	currency := money.Lookup(loan.CurrencyCode)
+	build := func(count int) []dto.RepaymentInstallment {
+		principalWeights := make([]money.Amount, count)
+		marginWeights := make([]money.Amount, count)
+		for index, installment := range pending[:count] {
+			principalWeights[index] = installment.PrincipalDue
+			marginWeights[index] = installment.MarginDue
+		}
+
+		principals := spreadLike(currency, principal, principalWeights)
+		margins := spreadLike(currency, margin, marginWeights)
+
+		schedule := make([]dto.RepaymentInstallment, count)
+		outstanding := principal
+		for index := range schedule {
+			outstanding -= principals[index]
+			schedule[index] = dto.RepaymentInstallment{
+				CurrencyCode:       loan.CurrencyCode,
+				Payment:            principals[index] + margins[index],
+				Principal:          principals[index],
+				Margin:             margins[index],
+				OutstandingBalance: outstanding,
+			}
+		}
+		schedule[count-1].OwnershipTransfer = loan.RepaymentMethod == repository.RepaymentMethodIJARA
+
+		return schedule
+	}
+
+	if mode == PrepaymentReducePayment {
+		return build(len(pending))
+	}
+
+	currentPayment := pending[0].PrincipalDue + pending[0].MarginDue
+	for count := 1; count < len(pending); count++ {
+		schedule := build(count)
+		if !slices.ContainsFunc(schedule, func(installment dto.RepaymentInstallment) bool {
+			return installment.Payment > currentPayment
+		}) {
+			return schedule
+		}
+	}
+
+	return build(len(pending))
+}
+
+// spreadLike splits total into parts in proportion to weights, or evenly if
+// the weights are all zero. The parts are on the currency's grid, never
+// negative for a non-negative total, and add up to total exactly.
+func spreadLike(currency money.Currency, total money.Amount, weights []money.Amount) []money.Amount {
+	var weightTotal money.Amount
+	for _, weight := range weights {
+		weightTotal += weight
+	}
+
+	parts := make([]money.Amount, len(weights))
+	var cumulativeWeight, spread money.Amount
+	for index, weight := range weights {
+		cumulativeWeight += weight
+
+		share := total
+		switch {
+		case index == len(weights)-1:
+		case weightTotal == 0:
+			share = currency.Round(float64(total) * float64(index+1) / float64(len(weights)))
+		default:
+			share = currency.Round(float64(total) * float64(cumulativeWeight) / float64(weightTotal))
+		}
+
+		parts[index] = share - spread
+		spread = share
+	}
+
+	return parts
+}
@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"loan_service/internal/calculator"
+	"loan_service/internal/dto"
+	"loan_service/internal/repository"
+	"loan_service/pkg/money"
+	"testing"
+	"time"
+)
+
+var testStart = time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)
+
+// testLoan originates a loan of net over termMonths and marks its first paid
+// installments PAID.
+func testLoan(t *testing.T, method repository.RepaymentMethod, net money.Money, termMonths int32, marginRate float64, paid int) (repository.Loan, []repository.Installment) {
+	t.Helper()
+
+	calc, err := calculator.ForMethod(string(method))
+	if err != nil {
+		t.Fatalf("ForMethod(%s): %v", method, err)
+	}
+	schedule := calc.Schedule(net, termMonths, marginRate, testStart)
+
+	var remaining money.Amount
+	installments := make([]repository.Installment, len(schedule))
+	for index, installment := range schedule {
+		installments[index] = repository.Installment{
+			ID:           int64(index + 1),
+			LoanID:       1,
+			Number:       int64(installment.Number),
+			DueDate:      installment.DueDate,
+			PrincipalDue: installment.Principal,
+			MarginDue:    installment.Margin,
+			Status:       repository.InstallmentStatusPENDING,
+		}
+		if index < paid {
+			installments[index].PrincipalPaid = installment.Principal
+			installments[index].MarginPaid = installment.Margin
+			installments[index].Status = repository.InstallmentStatusPAID
+		} else {
+			remaining += installment.Payment
+		}
+	}
+
+	term := int64(termMonths)
+	return repository.Loan{
+		ID:               1,
+		CurrencyCode:     net.Currency,
+		Amount:           &net.Amount,
+		TermMonths:       &term,
+		RemainingBalance: &remaining,
+		MarginRate:       &marginRate,
+		RepaymentMethod:  method,
+		Status:           repository.NullLoanStatus{LoanStatus: repository.LoanStatusACTIVE, Valid: true},
+	}, installments
+}
+
+func pendingTotals(installments []repository.Installment) (pending []repository.Installment, principal, margin money.Amount) {
+	for _, installment := range installments {
+		if installment.Status == repository.InstallmentStatusPENDING {
+			pending = append(pending, installment)
+			principal += installment.PrincipalDue
+			margin += installment.MarginDue
+		}
+	}
+	return pending, principal, margin
+}
+
+func scheduleTotals(schedule []dto.RepaymentInstallment) (principal, margin money.Amount) {
+	for _, installment := range schedule {
+		principal += installment.Principal
+		margin += installment.Margin
+	}
+	return principal, margin
+}
+
+// TestPlanPrepaymentInvariants checks what holds for every method and mode:
+// the rebuilt installments carry exactly the principal left after the
+// prepayment, take over the numbers and due dates of the pending ones in
+// order, are never negative, and the quote's balance, term and savings agree
+// with them.
+func TestPlanPrepaymentInvariants(t *testing.T) {
+	methods := []repository.RepaymentMethod{
+		repository.RepaymentMethodFLAT,
+		repository.RepaymentMethodANNUITY,
+		repository.RepaymentMethodDIFFERENTIATED,
+		repository.RepaymentMethodMURABAHA,
+		repository.RepaymentMethodIJARA,
+	}
+	amounts := []money.Amount{1, 500000, 5000000, 12000000}
+
+	for _, method := range methods {
+		for _, mode := range []string{PrepaymentReducePayment, PrepaymentReduceTerm} {
+			for _, amount := range amounts {
+				t.Run(fmt.Sprintf("%s/%s/%s", method, mode, amount), func(t *testing.T) {
+					loan, installments := testLoan(t, method, money.New(20000000, "TJS"), 36, 18, 12)
+					pending, pendingPrincipal, pendingMargin := pendingTotals(installments)
+
+					quote, err := planPrepayment(loan, installments, amount, mode, 50)
+					if err != nil {
+						t.Fatalf("planPrepayment: %v", err)
+					}
+
+					principal, margin := scheduleTotals(quote.Schedule)
+					if principal != pendingPrincipal-amount {
+						t.Errorf("principal left %s, want %s", principal, pendingPrincipal-amount)
+					}
+					if len(quote.Schedule) > len(pending) {
+						t.Fatalf("%d installments, more than the %d pending", len(quote.Schedule), len(pending))
+					}
+					if mode == PrepaymentReducePayment && len(quote.Schedule) != len(pending) {
+						t.Errorf("REDUCE_PAYMENT left %d installments, want %d", len(quote.Schedule), len(pending))
+					}
+
+					// Rounding makes pending payments differ by a cent, and a
+					// recalculated schedule may round a cent the other way.
+					var currentPayment money.Amount
+					for _, installment := range pending {
+						currentPayment = max(currentPayment, installment.PrincipalDue+installment.MarginDue)
+					}
+					outstanding := principal
+					for index, installment := range quote.Schedule {
+						if installment.Number != int32(pending[index].Number) || !installment.DueDate.Equal(pending[index].DueDate) {
+							t.Errorf("installment %d is number %d due %s, want %d due %s", index, installment.Number, installment.DueDate.Format(time.DateOnly), pending[index].Number, pending[index].DueDate.Format(time.DateOnly))
+						}
+						if installment.Principal < 0 || installment.Margin < 0 {
+							t.Errorf("installment %d has a negative component: %+v", installment.Number, installment)
+						}
+						if installment.Payment != installment.Principal+installment.Margin {
+							t.Errorf("installment %d: payment %s != principal %s + margin %s", installment.Number, installment.Payment, installment.Principal, installment.Margin)
+						}
+						if mode == PrepaymentReduceTerm && installment.Payment > currentPayment+1 {
+							t.Errorf("REDUCE_TERM installment %d pays %s, more than the current %s", installment.Number, installment.Payment, currentPayment)
+						}
+						outstanding -= installment.Principal
+						if installment.OutstandingBalance != outstanding {
+							t.Errorf("installment %d: outstanding balance %s, want %s", installment.Number, installment.OutstandingBalance, outstanding)
+						}
+					}
+
+					if got := quote.TermMonths; got != int32(len(installments)-len(pending)+len(quote.Schedule)) {
+						t.Errorf("TermMonths = %d, want %d", got, len(installments)-len(pending)+len(quote.Schedule))
+					}
+					if want := principal + margin; quote.RemainingBalance != want {
+						t.Errorf("RemainingBalance = %s, want %s", quote.RemainingBalance, want)
+					}
+					if want := pendingMargin - margin; quote.MarginSaved != want {
+						t.Errorf("MarginSaved = %s, want %s", quote.MarginSaved, want)
+					}
+					if quote.MarginSaved < 0 {
+						t.Errorf("MarginSaved = %s, a prepayment must not cost margin", quote.MarginSaved)
+					}
+				})
+			}
+		}
+	}
+}
+
+// TestPlanPrepaymentSharia checks that MURABAHA and IJARA keep the contracted
+// markup and lower it only by the ibra rebate.
+func TestPlanPrepaymentSharia(t *testing.T) {
+	for _, method := range []repository.RepaymentMethod{repository.RepaymentMethodMURABAHA, repository.RepaymentMethodIJARA} {
+		for _, ibraPercent := range []float64{0, 50, 100} {
+			for _, mode := range []string{PrepaymentReducePayment, PrepaymentReduceTerm} {
+				t.Run(fmt.Sprintf("%s/%g%%/%s", method, ibraPercent, mode), func(t *testing.T) {
+					loan, installments := testLoan(t, method, money.New(20000000, "TJS"), 36, 18, 6)
+					_, pendingPrincipal, pendingMargin := pendingTotals(installments)
+					amount := money.Amount(5000000)
+
+					quote, err := planPrepayment(loan, installments, amount, mode, ibraPercent)
+					if err != nil {
+						t.Fatalf("planPrepayment: %v", err)
+					}
+
+					rebate := ibra(money.Lookup("TJS"), pendingPrincipal, pendingMargin, amount, ibraPercent)
+					if _, margin := scheduleTotals(quote.Schedule); margin != pendingMargin-rebate {
+						t.Errorf("markup left %s, want %s less the ibra %s", margin, pendingMargin, rebate)
+					}
+					if quote.MarginSaved != rebate {
+						t.Errorf("MarginSaved = %s, want the ibra %s", quote.MarginSaved, rebate)
+					}
+
+					last := quote.Schedule[len(quote.Schedule)-1]
+					if want := method == repository.RepaymentMethodIJARA; last.OwnershipTransfer != want {
+						t.Errorf("last installment transfers ownership: %t, want %t", last.OwnershipTransfer, want)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestPlanPrepaymentReducePaymentKeepsSchedule(t *testing.T) {
+	loan, installments := testLoan(t, repository.RepaymentMethodANNUITY, money.New(20000000, "TJS"), 36, 18, 12)
+
+	quote, err := planPrepayment(loan, installments, 5000000, PrepaymentReducePayment, 100)
+	if err != nil {
+		t.Fatalf("planPrepayment: %v", err)
+	}
+
+	if quote.MonthlyPayment >= installments[12].PrincipalDue+installments[12].MarginDue {
+		t.Errorf("monthly payment %s did not drop", quote.MonthlyPayment)
+	}
+}
+
+func TestPlanPrepaymentFullPrincipal(t *testing.T) {
+	loan, installments := testLoan(t, repository.RepaymentMethodANNUITY, money.New(20000000, "TJS"), 36, 18, 12)
+	_, pendingPrincipal, _ := pendingTotals(installments)
+
+	quote, err := planPrepayment(loan, installments, pendingPrincipal, PrepaymentReduceTerm, 100)
+	if err != nil {
+		t.Fatalf("planPrepayment: %v", err)
+	}
+
+	if len(quote.Schedule) != 0 || quote.RemainingBalance != 0 {
+		t.Errorf("prepaying all principal left %d installments and %s", len(quote.Schedule), quote.RemainingBalance)
+	}
+	if quote.TermMonths != 12 {
+		t.Errorf("TermMonths = %d, want the 12 paid", quote.TermMonths)
+	}
+}
+
+func TestPlanPrepaymentRejects(t *testing.T) {
+	loan, installments := testLoan(t, repository.RepaymentMethodFLAT, money.New(20000000, "TJS"), 36, 18, 12)
+	_, pendingPrincipal, _ := pendingTotals(installments)
+
+	if _, err := planPrepayment(loan, installments, 100, "REDUCE_BOTH", 100); !errors.Is(err, ErrUnknownPrepaymentMode) {
+		t.Errorf("unknown mode: error = %v, want %v", err, ErrUnknownPrepaymentMode)
+	}
+
+	if _, err := planPrepayment(loan, installments, pendingPrincipal+1, PrepaymentReduceTerm, 100); !errors.Is(err, ErrPrepaymentExceedsPrincipal) {
+		t.Errorf("amount over principal: error = %v, want %v", err, ErrPrepaymentExceedsPrincipal)
+	}
+}
+
+func TestSpreadLike(t *testing.T) {
+	tjs := money.Lookup("TJS")
+	tests := []struct {
+		total   money.Amount
+		weights []money.Amount
+		want    []money.Amount
+	}{
+		{1000, []money.Amount{1, 1, 2}, []money.Amount{250, 250, 500}},
+		{1000, []money.Amount{0, 0, 0}, []money.Amount{333, 334, 333}},
+		{1, []money.Amount{5, 5}, []money.Amount{1, 0}},
+		{0, []money.Amount{3, 7}, []money.Amount{0, 0}},
+		{999, []money.Amount{7}, []money.Amount{999}},
+	}
+
+	for _, tt := range tests {
+		got := spreadLike(tjs, tt.total, tt.weights)
+
+		var sum money.Amount
+		for index, part := range got {
+			sum += part
+			if part != tt.want[index] {
+				t.Errorf("spreadLike(%s, %v) = %v, want %v", tt.total, tt.weights, got, tt.want)
+				break
+			}
+		}
+		if sum != tt.total {
+			t.Errorf("spreadLike(%s, %v) adds up to %s", tt.total, tt.weights, sum)
+		}
+	}
+}
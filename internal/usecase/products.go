@@ -0,0 +1,348 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"loan_service/internal/calculator"
+	"loan_service/internal/dto"
+	"loan_service/internal/repository"
+	"loan_service/pkg/money"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrInvalidProduct = errors.New("invalid loan product")
+	ErrProductInUse   = errors.New("loan product is referenced by applications")
+)
+
+func (uc *LoanUsecase) ListProducts(ctx context.Context, query dto.ProductQuery, limit, offset int32) ([]*dto.LoanProduct, error) {
+	products, err := uc.queries.ListLoanProducts(ctx, repository.ListLoanProductsParams{
+		ApplicationType: query.ApplicationType,
+		CurrencyCode:    query.CurrencyCode,
+		IncludeInactive: query.IncludeInactive,
+		LimitCount:      limit,
+		OffsetCount:     offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan products from db: %w", err)
+	}
+
+	productIds := make([]int64, len(products))
+	for index, product := range products {
+		productIds[index] = product.ID
+	}
+
+	rates, err := uc.queries.ListLoanProductRates(ctx, productIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan product rates from db: %w", err)
+	}
+
+	result := make([]*dto.LoanProduct, len(products))
+	for index, product := range products {
+		result[index] = productFromRow(product, rates)
+	}
+
+	return result, nil
+}
+
+func (uc *LoanUsecase) CountProducts(ctx context.Context, query dto.ProductQuery) (*int64, error) {
+	productCount, err := uc.queries.CountLoanProducts(ctx, repository.CountLoanProductsParams{
+		ApplicationType: query.ApplicationType,
+		CurrencyCode:    query.CurrencyCode,
+		IncludeInactive: query.IncludeInactive,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count loan products: %w", err)
+	}
+
+	return &productCount, nil
+}
+
+func (uc *LoanUsecase) GetProduct(ctx context.Context, id int64) (*dto.LoanProduct, error) {
+	product, err := uc.queries.GetLoanProduct(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan product from db: %w", err)
+	}
+
+	rates, err := uc.queries.ListLoanProductRates(ctx, []int64{product.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan product rates from db: %w", err)
+	}
+
+	return productFromRow(product, rates), nil
+}
+
+func (uc *LoanUsecase) CreateProduct(ctx context.Context, product *dto.LoanProduct) (*dto.LoanProduct, error) {
+	if err := uc.validateProduct(product); err != nil {
+		return nil, err
+	}
+
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	createdProduct, err := qtx.CreateLoanProduct(ctx, repository.CreateLoanProductParams{
+		Code:                  product.Code,
+		Name:                  product.Name,
+		ApplicationType:       repository.ApplicationType(product.ApplicationType),
+		CurrencyCode:          product.CurrencyCode,
+		RepaymentMethod:       repository.RepaymentMethod(product.RepaymentMethod),
+		TermMonths:            termsToRow(product.TermMonths),
+		MinAmount:             product.MinAmount,
+		MaxAmount:             product.MaxAmount,
+		MinDownPaymentPercent: product.MinDownPaymentPercent,
+		Active:                product.Active,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, productCodeTaken(product.Code)
+		}
+		return nil, fmt.Errorf("failed to create loan product in db: %w", err)
+	}
+
+	if err := createProductRates(ctx, qtx, createdProduct.ID, product.Rates); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return uc.GetProduct(ctx, createdProduct.ID)
+}
+
+// UpdateProduct replaces a product, rate grid included. Applications already
+// created keep the figures they were created with.
+func (uc *LoanUsecase) UpdateProduct(ctx context.Context, product *dto.LoanProduct) (*dto.LoanProduct, error) {
+	if err := uc.validateProduct(product); err != nil {
+		return nil, err
+	}
+
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	_, err = qtx.UpdateLoanProduct(ctx, repository.UpdateLoanProductParams{
+		ID:                    product.Id,
+		Code:                  product.Code,
+		Name:                  product.Name,
+		ApplicationType:       repository.ApplicationType(product.ApplicationType),
+		CurrencyCode:          product.CurrencyCode,
+		RepaymentMethod:       repository.RepaymentMethod(product.RepaymentMethod),
+		TermMonths:            termsToRow(product.TermMonths),
+		MinAmount:             product.MinAmount,
+		MaxAmount:             product.MaxAmount,
+		MinDownPaymentPercent: product.MinDownPaymentPercent,
+		Active:                product.Active,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, productCodeTaken(product.Code)
+		}
+		return nil, fmt.Errorf("failed to update loan product in db: %w", err)
+	}
+
+	if err := qtx.DeleteLoanProductRates(ctx, product.Id); err != nil {
+		return nil, fmt.Errorf("failed to delete loan product rates in db: %w", err)
+	}
+
+	if err := createProductRates(ctx, qtx, product.Id, product.Rates); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return uc.GetProduct(ctx, product.Id)
+}
+
+// DeleteProduct removes a product no application refers to. A product that
+// has applications can only be deactivated.
+func (uc *LoanUsecase) DeleteProduct(ctx context.Context, id int64) error {
+	deleted, err := uc.queries.DeleteLoanProduct(ctx, id)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("%w: deactivate product %d instead", ErrProductInUse, id)
+		}
+		return fmt.Errorf("failed to delete loan product in db: %w", err)
+	}
+
+	if deleted == 0 {
+		return fmt.Errorf("failed to delete loan product in db: %w", sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+func createProductRates(ctx context.Context, qtx *repository.Queries, productId int64, rates []dto.ProductRate) error {
+	for _, rate := range rates {
+		err := qtx.CreateLoanProductRate(ctx, repository.CreateLoanProductRateParams{
+			ProductID:             productId,
+			MaxTermMonths:         int64(rate.MaxTermMonths),
+			MinDownPaymentPercent: rate.MinDownPaymentPercent,
+			MarginRate:            rate.MarginRate,
+		})
+		if err != nil {
+			if isUniqueViolation(err) {
+				return (fieldErrors{{Field: "rates", Description: fmt.Sprintf("more than one rate for up to %d months from %g%% down", rate.MaxTermMonths, rate.MinDownPaymentPercent)}}).err(ErrInvalidProduct)
+			}
+			return fmt.Errorf("failed to create loan product rate in db: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func productCodeTaken(code string) error {
+	return (fieldErrors{{Field: "code", Description: fmt.Sprintf("%q is taken by another product", code)}}).err(ErrInvalidProduct)
+}
+
+// validateProduct checks a product before it is saved. Every term the product
+// offers must have a rate at its minimum down payment, so every application
+// the product accepts can be priced. Sharia-compliant products need a charity
+// account to route their late charges to.
+func (uc *LoanUsecase) validateProduct(product *dto.LoanProduct) error {
+	var fields fieldErrors
+
+	product.Code = strings.TrimSpace(product.Code)
+	if product.Code == "" {
+		fields.add("code", "is required")
+	}
+
+	if strings.TrimSpace(product.Name) == "" {
+		fields.add("name", "is required")
+	}
+
+	switch repository.ApplicationType(product.ApplicationType) {
+	case repository.ApplicationTypeAUTO, repository.ApplicationTypePERSONAL:
+	default:
+		fields.add("application_type", "must be %s or %s", repository.ApplicationTypeAUTO, repository.ApplicationTypePERSONAL)
+	}
+
+	if err := money.Validate(product.CurrencyCode); err != nil {
+		fields.add("currency_code", "%s", err)
+	}
+
+	if product.RepaymentMethod == "" {
+		product.RepaymentMethod = string(calculator.MethodFlat)
+	}
+	if _, err := calculator.ForMethod(product.RepaymentMethod); err != nil {
+		fields.add("repayment_method", "%s", err)
+	} else if calculator.ShariaCompliant(product.RepaymentMethod) && uc.penaltyRules.CharityAccount == "" {
+		fields.add("repayment_method", "%s needs penalties.charity_account to be configured", product.RepaymentMethod)
+	}
+
+	slices.Sort(product.TermMonths)
+	product.TermMonths = slices.Compact(product.TermMonths)
+	if len(product.TermMonths) == 0 || product.TermMonths[0] <= 0 {
+		fields.add("term_months", "must list at least one term, all positive")
+	}
+
+	if product.MinAmount <= 0 || product.MaxAmount < product.MinAmount {
+		fields.add("max_amount", "amounts must be positive and max_amount at least min_amount")
+	}
+
+	if product.MinDownPaymentPercent < 0 || product.MinDownPaymentPercent >= 100 {
+		fields.add("min_down_payment_percent", "must be from 0 up to 100")
+	}
+
+	for _, rate := range product.Rates {
+		if rate.MaxTermMonths <= 0 || rate.MarginRate < 0 || rate.MinDownPaymentPercent < 0 || rate.MinDownPaymentPercent >= 100 {
+			fields.add("rates", "rate %+v must have a positive term, a down payment from 0 up to 100%% and a non-negative margin rate", rate)
+		}
+	}
+
+	if len(fields) == 0 {
+		for _, termMonths := range product.TermMonths {
+			if _, ok := productRate(product, termMonths, product.MinDownPaymentPercent); !ok {
+				fields.add("rates", "no rate for %d months with a %g%% down payment", termMonths, product.MinDownPaymentPercent)
+			}
+		}
+	}
+
+	return fields.err(ErrInvalidProduct)
+}
+
+// productRate looks up the margin rate of a product for a term and a down
+// payment, in percent of the price: the cell with the shortest term covering
+// termMonths and, of those, the largest down payment not above
+// downPaymentPercent.
+func productRate(product *dto.LoanProduct, termMonths int32, downPaymentPercent float64) (float64, bool) {
+	var best *dto.ProductRate
+	for index, rate := range product.Rates {
+		if rate.MaxTermMonths < termMonths || rate.MinDownPaymentPercent > downPaymentPercent+percentEpsilon {
+			continue
+		}
+
+		if best == nil ||
+			rate.MaxTermMonths < best.MaxTermMonths ||
+			rate.MaxTermMonths == best.MaxTermMonths && rate.MinDownPaymentPercent > best.MinDownPaymentPercent {
+			best = &product.Rates[index]
+		}
+	}
+
+	if best == nil {
+		return 0, false
+	}
+
+	return best.MarginRate, true
+}
+
+func termsToRow(terms []int32) []int64 {
+	result := make([]int64, len(terms))
+	for index, term := range terms {
+		result[index] = int64(term)
+	}
+
+	return result
+}
+
+// productFromRow maps a product row with its cells of rates, which may hold
+// the rates of other products too.
+func productFromRow(product repository.LoanProduct, rates []repository.LoanProductRate) *dto.LoanProduct {
+	terms := make([]int32, len(product.TermMonths))
+	for index, term := range product.TermMonths {
+		terms[index] = int32(term)
+	}
+
+	var productRates []dto.ProductRate
+	for _, rate := range rates {
+		if rate.ProductID != product.ID {
+			continue
+		}
+		productRates = append(productRates, dto.ProductRate{
+			MaxTermMonths:         int32(rate.MaxTermMonths),
+			MinDownPaymentPercent: rate.MinDownPaymentPercent,
+			MarginRate:            rate.MarginRate,
+		})
+	}
+
+	return &dto.LoanProduct{
+		Id:                    product.ID,
+		Code:                  product.Code,
+		Name:                  product.Name,
+		ApplicationType:       string(product.ApplicationType),
+		CurrencyCode:          product.CurrencyCode,
+		RepaymentMethod:       string(product.RepaymentMethod),
+		TermMonths:            terms,
+		MinAmount:             product.MinAmount,
+		MaxAmount:             product.MaxAmount,
+		MinDownPaymentPercent: product.MinDownPaymentPercent,
+		Rates:                 productRates,
+		Active:                product.Active,
+		CreatedAt:             product.CreatedAt,
+		UpdatedAt:             product.UpdatedAt,
+	}
+}
@@ -0,0 +1,374 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"loan_service/internal/clients"
+	"loan_service/internal/dto"
+	"loan_service/internal/repository"
+	"loan_service/pkg/money"
+	"loan_service/pkg/utils"
+	"log"
+)
+
+// asrContractStatusClosed is the status ASR Leasing gives a fully repaid
+// contract; it corresponds to a PAID loan.
+const asrContractStatusClosed = "CLOSED"
+
+// reconciliationPolicy is what a reconciliation run may fix on its own.
+type reconciliationPolicy struct {
+	autoCorrect bool
+	tolerance   money.Amount
+}
+
+type reconciliationStats struct {
+	loansChecked int64
+	issuesFound  int64
+	corrected    int64
+	failed       int64
+}
+
+// ReconcileLoans compares every loan that has an ASR Leasing contract with
+// the balance and status ASR Leasing holds for it, batch by batch, and stores
+// each discrepancy as an OPEN reconciliation issue. Issues whose discrepancy
+// is gone are resolved. With autoCorrect, a balance that differs by at most
+// tolerance is overwritten with ASR Leasing's and its issue closed as
+// CORRECTED. Loans ASR Leasing fails to answer for are counted and skipped;
+// the run stops when ASR Leasing is unavailable. The run is stored with its
+// totals either way.
+func (uc *LoanUsecase) ReconcileLoans(ctx context.Context, batchSize int32, autoCorrect bool, tolerance money.Amount) (*dto.ReconciliationRun, error) {
+	run, err := uc.queries.CreateReconciliationRun(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reconciliation run in db: %w", err)
+	}
+
+	policy := reconciliationPolicy{autoCorrect: autoCorrect, tolerance: tolerance}
+
+	var stats reconciliationStats
+	var runError *string
+	reconcileErr := uc.reconcileBatches(ctx, run.ID, batchSize, policy, &stats)
+	if reconcileErr != nil {
+		message := reconcileErr.Error()
+		runError = &message
+	}
+
+	finishedRun, err := uc.queries.FinishReconciliationRun(ctx, repository.FinishReconciliationRunParams{
+		ID:           run.ID,
+		LoansChecked: stats.loansChecked,
+		IssuesFound:  stats.issuesFound,
+		Corrected:    stats.corrected,
+		Failed:       stats.failed,
+		Error:        runError,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to finish reconciliation run in db: %w", err)
+	}
+
+	return reconciliationRunFromRow(finishedRun), reconcileErr
+}
+
+func (uc *LoanUsecase) reconcileBatches(ctx context.Context, runId int64, batchSize int32, policy reconciliationPolicy, stats *reconciliationStats) error {
+	var afterId int64
+	for {
+		loans, err := uc.queries.ListLoansForReconciliation(ctx, repository.ListLoansForReconciliationParams{
+			AfterID:   afterId,
+			BatchSize: batchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get loans from db: %w", err)
+		}
+
+		for _, loan := range loans {
+			err := uc.reconcileLoan(ctx, runId, loan, policy, stats)
+			if errors.Is(err, clients.ErrAsrUnavailable) {
+				return err
+			}
+			if err != nil {
+				stats.failed++
+				log.Printf("Failed to reconcile loan %d: %s", loan.ID, err)
+				continue
+			}
+			stats.loansChecked++
+		}
+
+		if len(loans) < int(batchSize) {
+			return nil
+		}
+		afterId = loans[len(loans)-1].ID
+	}
+}
+
+// reconcileLoan checks one loan against its ASR Leasing contract. A status
+// mismatch means one side considers the loan repaid and the other does not;
+// ACTIVE and OVERDUE are not told apart, as ASR Leasing tracks arrears on its
+// own terms.
+func (uc *LoanUsecase) reconcileLoan(ctx context.Context, runId int64, loan repository.Loan, policy reconciliationPolicy, stats *reconciliationStats) error {
+	contractNumber := utils.NilToValueType(loan.ContractNumber)
+	issue := repository.UpsertReconciliationIssueParams{
+		RunID:          runId,
+		LoanID:         loan.ID,
+		ContractNumber: contractNumber,
+		CurrencyCode:   loan.CurrencyCode,
+		LocalBalance:   utils.NilToValueType(loan.RemainingBalance),
+		LocalStatus:    string(loan.Status.LoanStatus),
+	}
+
+	contract, err := uc.asrLeasingClient.GetContract(ctx, contractNumber)
+	if errors.Is(err, clients.ErrAsrNotFound) {
+		issue.Kind = repository.ReconciliationIssueKindCONTRACTNOTFOUND
+		return uc.openReconciliationIssue(ctx, issue, stats)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get contract %s from asr leasing: %w", contractNumber, err)
+	}
+
+	if err := uc.resolveReconciliationIssue(ctx, loan.ID, repository.ReconciliationIssueKindCONTRACTNOTFOUND); err != nil {
+		return err
+	}
+
+	issue.RemoteBalance = &contract.RemainingBalance
+	issue.RemoteStatus = &contract.Status
+
+	loanPaid := loan.Status.LoanStatus == repository.LoanStatusPAID
+	statusMatches := loanPaid == (contract.Status == asrContractStatusClosed)
+	if statusMatches {
+		err = uc.resolveReconciliationIssue(ctx, loan.ID, repository.ReconciliationIssueKindSTATUSMISMATCH)
+	} else {
+		statusIssue := issue
+		statusIssue.Kind = repository.ReconciliationIssueKindSTATUSMISMATCH
+		err = uc.openReconciliationIssue(ctx, statusIssue, stats)
+	}
+	if err != nil {
+		return err
+	}
+
+	// A contract in another currency cannot be compared, let alone corrected.
+	sameCurrency := contract.CurrencyCode == "" || contract.CurrencyCode == loan.CurrencyCode
+	difference := contract.RemainingBalance - issue.LocalBalance
+	if sameCurrency && difference == 0 {
+		return uc.resolveReconciliationIssue(ctx, loan.ID, repository.ReconciliationIssueKindBALANCEMISMATCH)
+	}
+
+	issue.Kind = repository.ReconciliationIssueKindBALANCEMISMATCH
+	if err := uc.openReconciliationIssue(ctx, issue, stats); err != nil {
+		return err
+	}
+
+	correctable := policy.autoCorrect && sameCurrency && statusMatches && !loanPaid &&
+		max(difference, -difference) <= policy.tolerance
+	if !correctable {
+		return nil
+	}
+
+	corrected, err := uc.correctLoanBalance(ctx, loan, contract.RemainingBalance)
+	if err != nil {
+		return err
+	}
+	if corrected {
+		stats.corrected++
+	}
+
+	return nil
+}
+
+// correctLoanBalance sets the loan's remaining balance to balance and closes
+// its balance issue as CORRECTED. The difference is posted to the margin of
+// the loan's last open installment, so the balance stays the sum of what its
+// installments leave unpaid. Nothing is changed, and false returned, if the
+// loan has moved on since it was compared or the last installment cannot
+// absorb the difference; the issue then stays OPEN.
+func (uc *LoanUsecase) correctLoanBalance(ctx context.Context, loan repository.Loan, balance money.Amount) (bool, error) {
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	current, err := qtx.GetLoanForUpdate(ctx, loan.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get loan from db: %w", err)
+	}
+
+	if utils.NilToValueType(current.RemainingBalance) != utils.NilToValueType(loan.RemainingBalance) || current.Status != loan.Status {
+		return false, nil
+	}
+
+	if adjusted, err := adjustLastInstallment(ctx, qtx, current.ID, balance-utils.NilToValueType(current.RemainingBalance)); err != nil || !adjusted {
+		return false, err
+	}
+
+	_, err = qtx.UpdateLoanBalance(ctx, repository.UpdateLoanBalanceParams{
+		ID:               current.ID,
+		RemainingBalance: &balance,
+		Status:           current.Status,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to update loan balance in db: %w", err)
+	}
+
+	_, err = qtx.CloseReconciliationIssue(ctx, repository.CloseReconciliationIssueParams{
+		LoanID: current.ID,
+		Kind:   repository.ReconciliationIssueKindBALANCEMISMATCH,
+		Status: repository.ReconciliationIssueStatusCORRECTED,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to close reconciliation issue in db: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// adjustLastInstallment adds difference to the margin of a loan's last open
+// installment. It reports false if the installment would owe less margin than
+// has been paid on it or nothing at all. Loans originated before installments
+// were stored have none to adjust.
+func adjustLastInstallment(ctx context.Context, qtx *repository.Queries, loanId int64, difference money.Amount) (bool, error) {
+	open, err := qtx.ListOpenInstallmentsForUpdate(ctx, loanId)
+	if err != nil {
+		return false, fmt.Errorf("failed to get open installments from db: %w", err)
+	}
+
+	if len(open) == 0 {
+		installments, err := qtx.ListInstallmentsByLoan(ctx, loanId)
+		if err != nil {
+			return false, fmt.Errorf("failed to get installments from db: %w", err)
+		}
+		return len(installments) == 0, nil
+	}
+
+	last := open[len(open)-1]
+	marginDue := last.MarginDue + difference
+	if marginDue < last.MarginPaid || last.PrincipalDue+marginDue <= last.PrincipalPaid+last.MarginPaid {
+		return false, nil
+	}
+
+	_, err = qtx.AdjustInstallmentMargin(ctx, repository.AdjustInstallmentMarginParams{
+		ID:        last.ID,
+		MarginDue: marginDue,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to adjust installment in db: %w", err)
+	}
+
+	return true, nil
+}
+
+func (uc *LoanUsecase) openReconciliationIssue(ctx context.Context, issue repository.UpsertReconciliationIssueParams, stats *reconciliationStats) error {
+	_, err := uc.queries.UpsertReconciliationIssue(ctx, issue)
+	if err != nil {
+		return fmt.Errorf("failed to upsert reconciliation issue in db: %w", err)
+	}
+
+	stats.issuesFound++
+	return nil
+}
+
+func (uc *LoanUsecase) resolveReconciliationIssue(ctx context.Context, loanId int64, kind repository.ReconciliationIssueKind) error {
+	_, err := uc.queries.CloseReconciliationIssue(ctx, repository.CloseReconciliationIssueParams{
+		LoanID: loanId,
+		Kind:   kind,
+		Status: repository.ReconciliationIssueStatusRESOLVED,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to close reconciliation issue in db: %w", err)
+	}
+
+	return nil
+}
+
+// GetReconciliationSummary returns the latest reconciliation run, if any, and
+// the number of issues of each kind and status.
+func (uc *LoanUsecase) GetReconciliationSummary(ctx context.Context) (*dto.ReconciliationSummary, error) {
+	summary := &dto.ReconciliationSummary{}
+
+	run, err := uc.queries.GetLastReconciliationRun(ctx)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return nil, fmt.Errorf("failed to get last reconciliation run from db: %w", err)
+	default:
+		summary.LastRun = reconciliationRunFromRow(run)
+	}
+
+	counts, err := uc.queries.CountReconciliationIssuesByKind(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count reconciliation issues from db: %w", err)
+	}
+
+	summary.Counts = make([]dto.ReconciliationIssueCount, len(counts))
+	for index, count := range counts {
+		summary.Counts[index] = dto.ReconciliationIssueCount{
+			Kind:   string(count.Kind),
+			Status: string(count.Status),
+			Count:  count.Count,
+		}
+	}
+
+	return summary, nil
+}
+
+func (uc *LoanUsecase) ListReconciliationIssues(ctx context.Context, status string, limit, offset int32) ([]*dto.ReconciliationIssue, error) {
+	issues, err := uc.queries.ListReconciliationIssues(ctx, repository.ListReconciliationIssuesParams{
+		Status: repository.ReconciliationIssueStatus(status),
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reconciliation issues from db: %w", err)
+	}
+
+	result := make([]*dto.ReconciliationIssue, len(issues))
+	for index, issue := range issues {
+		result[index] = reconciliationIssueFromRow(issue)
+	}
+
+	return result, nil
+}
+
+func (uc *LoanUsecase) CountReconciliationIssues(ctx context.Context, status string) (*int64, error) {
+	countIssues, err := uc.queries.CountReconciliationIssues(ctx, repository.ReconciliationIssueStatus(status))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count reconciliation issues from db: %w", err)
+	}
+
+	return &countIssues, nil
+}
+
+func reconciliationRunFromRow(run repository.ReconciliationRun) *dto.ReconciliationRun {
+	return &dto.ReconciliationRun{
+		Id:           run.ID,
+		StartedAt:    run.StartedAt,
+		FinishedAt:   utils.NilToValueType(run.FinishedAt),
+		LoansChecked: int32(run.LoansChecked),
+		IssuesFound:  int32(run.IssuesFound),
+		Corrected:    int32(run.Corrected),
+		Failed:       int32(run.Failed),
+		Error:        utils.NilToValueType(run.Error),
+	}
+}
+
+func reconciliationIssueFromRow(issue repository.ReconciliationIssue) *dto.ReconciliationIssue {
+	return &dto.ReconciliationIssue{
+		Id:             issue.ID,
+		RunId:          issue.RunID,
+		LoanId:         issue.LoanID,
+		ContractNumber: issue.ContractNumber,
+		CurrencyCode:   issue.CurrencyCode,
+		Kind:           string(issue.Kind),
+		Status:         string(issue.Status),
+		LocalBalance:   issue.LocalBalance,
+		RemoteBalance:  utils.NilToValueType(issue.RemoteBalance),
+		LocalStatus:    issue.LocalStatus,
+		RemoteStatus:   utils.NilToValueType(issue.RemoteStatus),
+		DetectedAt:     issue.DetectedAt,
+		ResolvedAt:     utils.NilToValueType(issue.ResolvedAt),
+	}
+}
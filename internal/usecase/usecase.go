@@ -2,39 +2,166 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"loan_service/configs"
+	"loan_service/internal/calculator"
+	"loan_service/internal/catalog"
 	"loan_service/internal/clients"
 	"loan_service/internal/dto"
+	"loan_service/internal/exchange"
+	"loan_service/internal/penalty"
 	"loan_service/internal/repository"
+	"loan_service/pkg/money"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type LoanUsecase struct {
-	queries          *repository.Queries
-	asrLeasingClient *clients.AsrLeasingClient
-	koinotAutoClient *clients.KoinotAutoClient
+	db                   *pgxpool.Pool
+	queries              *repository.Queries
+	asrLeasingClient     *clients.AsrLeasingClient
+	koinotAutoClient     *clients.KoinotAutoClient
+	paymentServiceClient *clients.PaymentServiceClient
+	rates                exchange.ExchangeRateProvider
+	vehicleCatalog       *catalog.Cache
+	allocationOrder      []allocationComponent
+	penaltyRules         penalty.Rules
+	payoffCfg            configs.PayoffConfig
+	vehicleHoldTTL       time.Duration
+	figureTolerance      money.Amount
 }
 
 func New(
+	db *pgxpool.Pool,
 	queries *repository.Queries,
 	asrLeasingClient *clients.AsrLeasingClient,
 	koinotAutoClient *clients.KoinotAutoClient,
-) *LoanUsecase {
+	paymentServiceClient *clients.PaymentServiceClient,
+	rates exchange.ExchangeRateProvider,
+	vehicleCatalog *catalog.Cache,
+	paymentsCfg configs.PaymentsConfig,
+	penaltiesCfg configs.PenaltiesConfig,
+	payoffCfg configs.PayoffConfig,
+	vehicleHoldCfg configs.VehicleHoldConfig,
+	applicationsCfg configs.ApplicationsConfig,
+) (*LoanUsecase, error) {
+	allocationOrder, err := parseAllocationOrder(paymentsCfg.AllocationOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	penaltyRules, err := penalty.NewRules(penaltiesCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if payoffCfg.ValidityDays < 0 || payoffCfg.SettlementFeeRate < 0 || payoffCfg.IbraPercent < 0 {
+		return nil, fmt.Errorf("payoff settings must not be negative: %+v", payoffCfg)
+	}
+	if payoffCfg.IbraPercent > 100 {
+		return nil, fmt.Errorf("ibra percent must be at most 100, got %v", payoffCfg.IbraPercent)
+	}
+
+	vehicleHoldTTL, err := time.ParseDuration(vehicleHoldCfg.TTL)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid ttl format for vehicle holds: %w", err)
+	}
+	if vehicleHoldTTL <= 0 {
+		return nil, fmt.Errorf("vehicle hold ttl must be positive: %s", vehicleHoldTTL)
+	}
+
+	if applicationsCfg.Tolerance < 0 {
+		return nil, fmt.Errorf("application tolerance must not be negative, got %v", applicationsCfg.Tolerance)
+	}
+
 	return &LoanUsecase{
-		queries:          queries,
-		asrLeasingClient: asrLeasingClient,
-		koinotAutoClient: koinotAutoClient,
+		db:                   db,
+		queries:              queries,
+		asrLeasingClient:     asrLeasingClient,
+		koinotAutoClient:     koinotAutoClient,
+		paymentServiceClient: paymentServiceClient,
+		rates:                rates,
+		vehicleCatalog:       vehicleCatalog,
+		allocationOrder:      allocationOrder,
+		penaltyRules:         penaltyRules,
+		payoffCfg:            payoffCfg,
+		vehicleHoldTTL:       vehicleHoldTTL,
+		figureTolerance:      money.FromFloat(applicationsCfg.Tolerance),
+	}, nil
+}
+
+// Calculate quotes financing price in currencyCode under a product. A price in
+// another currency is converted at the current rate first; downPayment is
+// already in currencyCode. A repayment method or margin rate left out is taken
+// from the product and its rate grid. Without a product the quote is priced on
+// the default product of applicationType, or of AUTO applications if that is
+// empty too.
+func (uc *LoanUsecase) Calculate(ctx context.Context, productId int64, applicationType, repaymentMethod, currencyCode string, price money.Money, downPayment money.Amount, termMonths int32, marginRate float64) (*dto.Calculation, error) {
+	if err := money.Validate(currencyCode); err != nil {
+		return nil, err
 	}
+
+	if applicationType == "" {
+		applicationType = string(repository.ApplicationTypeAUTO)
+	}
+
+	product, err := uc.productFor(ctx, productId, applicationType, currencyCode, ErrInvalidQuote)
+	if err != nil {
+		return nil, err
+	}
+
+	converted, rate, err := uc.convertPrice(ctx, price, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	f := financing{
+		repaymentMethod: repaymentMethod,
+		price:           converted,
+		downPayment:     downPayment,
+		termMonths:      termMonths,
+		marginRate:      marginRate,
+	}
+	if err := checkFinancing(product, &f).err(ErrInvalidQuote); err != nil {
+		return nil, err
+	}
+
+	schedule, err := uc.CalculateSchedule(f.repaymentMethod, currencyCode, converted, downPayment, termMonths, f.marginRate, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	monthly, total := calculator.Quote(schedule)
+	return &dto.Calculation{
+		ProductId:       product.Id,
+		CurrencyCode:    currencyCode,
+		RepaymentMethod: f.repaymentMethod,
+		MarginRate:      f.marginRate,
+		Price:           converted,
+		ExchangeRate:    rate,
+		NetPrice:        converted - downPayment,
+		MonthlyPayment:  monthly,
+		TotalAmount:     total,
+	}, nil
 }
 
-func (uc *LoanUsecase) Calculate(price, downPayment int64, termMonths int32, marginRate float64) (int64, int64, int64) {
-	net := price - downPayment
-	years := float64(termMonths) / 12
-	margin := int64((float64(net)*marginRate/100)*years + 0.5)
-	total := net + margin
-	monthly := int64((float64(total) / float64(termMonths)) + 0.5)
+func (uc *LoanUsecase) CalculateSchedule(repaymentMethod, currencyCode string, price, downPayment money.Amount, termMonths int32, marginRate float64, start time.Time) ([]dto.RepaymentInstallment, error) {
+	calc, err := calculator.ForMethod(repaymentMethod)
+	if err != nil {
+		return nil, err
+	}
 
-	return net, monthly, total
+	return calc.Schedule(money.New(price-downPayment, currencyCode), termMonths, marginRate, start), nil
 }
 
-func (uc *LoanUsecase) ListVehicles(ctx context.Context) ([]dto.Vehicle, error) {
-	return uc.koinotAutoClient.ListVehicles(ctx)
+// convertPrice expresses price in currencyCode at the rate effective now and
+// returns the rate it applied.
+func (uc *LoanUsecase) convertPrice(ctx context.Context, price money.Money, currencyCode string) (money.Amount, float64, error) {
+	rate, err := uc.rates.Rate(ctx, price.Currency, currencyCode, time.Now())
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return price.Convert(currencyCode, rate).Amount, rate, nil
 }
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"loan_service/internal/clients"
+	"loan_service/internal/dto"
+	"loan_service/internal/repository"
+	"log"
+	"time"
+)
+
+// reserveVehicle asks Koinot Auto to hold the vehicle for an application
+// about to be created. A vehicle already held for another application is
+// refused without asking Koinot Auto.
+func (uc *LoanUsecase) reserveVehicle(ctx context.Context, vin string) (*dto.VehicleHold, error) {
+	held, err := uc.queries.GetActiveVehicleHoldByVin(ctx, vin)
+	switch {
+	case err == nil:
+		return nil, fmt.Errorf("%w: %s is held for application %d until %s", clients.ErrVehicleHeld, vin, held.ApplicationID, held.ExpiresAt.Format(time.RFC3339))
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, fmt.Errorf("failed to get vehicle hold from db: %w", err)
+	}
+
+	hold, err := uc.koinotAutoClient.ReserveVehicle(ctx, vin, uc.vehicleHoldTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve vehicle %s with koinot auto: %w", vin, err)
+	}
+
+	return hold, nil
+}
+
+// createVehicleHold records the hold Koinot Auto keeps for an application.
+// Holds of the vehicle that have lapsed are expired first, so only a hold
+// that is still valid blocks the vehicle.
+func createVehicleHold(ctx context.Context, qtx *repository.Queries, applicationId int64, hold *dto.VehicleHold) error {
+	if err := qtx.ExpireVehicleHoldsByVin(ctx, hold.Vin); err != nil {
+		return fmt.Errorf("failed to expire vehicle holds in db: %w", err)
+	}
+
+	_, err := qtx.CreateVehicleHold(ctx, repository.CreateVehicleHoldParams{
+		ApplicationID: applicationId,
+		VehicleVin:    hold.Vin,
+		KoinotHoldID:  hold.HoldId,
+		ExpiresAt:     hold.ExpiresAt,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%w: %s is held for another application", clients.ErrVehicleHeld, hold.Vin)
+		}
+		return fmt.Errorf("failed to create vehicle hold in db: %w", err)
+	}
+
+	return nil
+}
+
+// releaseVehicle gives a held vehicle back to Koinot Auto. It is best effort:
+// a hold that cannot be released lapses on its own.
+func (uc *LoanUsecase) releaseVehicle(ctx context.Context, vin, holdId string) {
+	if err := uc.koinotAutoClient.ReleaseVehicleHold(context.WithoutCancel(ctx), vin, holdId); err != nil {
+		log.Printf("Failed to release hold %s on vehicle %s: %s", holdId, vin, err)
+	}
+}
+
+// sellVehicle converts the vehicle hold of an approved application into a
+// sale. A hold that has lapsed is renewed first, which fails if the vehicle
+// has been held for another application since. It reports whether Koinot Auto
+// sold the vehicle; the caller marks the hold SOLD along with the loan, and
+// until then a retry sells the same hold again, which Koinot Auto confirms.
+// Applications without a hold, or whose hold is already SOLD, are left alone.
+func (uc *LoanUsecase) sellVehicle(ctx context.Context, applicationId int64) (bool, error) {
+	hold, err := uc.queries.GetVehicleHoldByApplication(ctx, applicationId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get vehicle hold from db: %w", err)
+	}
+
+	if hold.Status == repository.VehicleHoldStatusSOLD {
+		return false, nil
+	}
+
+	if hold.Status != repository.VehicleHoldStatusACTIVE || !hold.ExpiresAt.After(time.Now()) {
+		hold, err = uc.renewVehicleHold(ctx, hold)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if err := uc.koinotAutoClient.SellVehicle(ctx, hold.VehicleVin, hold.KoinotHoldID); err != nil {
+		return false, fmt.Errorf("failed to sell vehicle %s with koinot auto: %w", hold.VehicleVin, err)
+	}
+
+	return true, nil
+}
+
+// renewVehicleHold asks Koinot Auto for a new hold in place of one that has
+// lapsed or been released.
+func (uc *LoanUsecase) renewVehicleHold(ctx context.Context, hold repository.VehicleHold) (repository.VehicleHold, error) {
+	renewed, err := uc.reserveVehicle(ctx, hold.VehicleVin)
+	if err != nil {
+		return repository.VehicleHold{}, err
+	}
+
+	tx, err := uc.db.Begin(ctx)
+	if err != nil {
+		uc.releaseVehicle(ctx, renewed.Vin, renewed.HoldId)
+		return repository.VehicleHold{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	if err := qtx.ExpireVehicleHoldsByVin(ctx, hold.VehicleVin); err != nil {
+		uc.releaseVehicle(ctx, renewed.Vin, renewed.HoldId)
+		return repository.VehicleHold{}, fmt.Errorf("failed to expire vehicle holds in db: %w", err)
+	}
+
+	updatedHold, err := qtx.RenewVehicleHold(ctx, repository.RenewVehicleHoldParams{
+		ApplicationID: hold.ApplicationID,
+		KoinotHoldID:  renewed.HoldId,
+		ExpiresAt:     renewed.ExpiresAt,
+	})
+	if err != nil {
+		uc.releaseVehicle(ctx, renewed.Vin, renewed.HoldId)
+		if isUniqueViolation(err) {
+			return repository.VehicleHold{}, fmt.Errorf("%w: %s is held for another application", clients.ErrVehicleHeld, hold.VehicleVin)
+		}
+		return repository.VehicleHold{}, fmt.Errorf("failed to renew vehicle hold in db: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		uc.releaseVehicle(ctx, renewed.Vin, renewed.HoldId)
+		return repository.VehicleHold{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return updatedHold, nil
+}
+
+// ExpireVehicleHolds marks the holds Koinot Auto has let lapse as EXPIRED and
+// returns how many there were. Koinot Auto expires its holds itself, so it is
+// not asked to release them.
+func (uc *LoanUsecase) ExpireVehicleHolds(ctx context.Context) (int64, error) {
+	expired, err := uc.queries.ExpireVehicleHolds(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire vehicle holds in db: %w", err)
+	}
+
+	return expired, nil
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"loan_service/internal/dto"
+	"loan_service/pkg/money"
+	"loan_service/pkg/vin"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidVehicleQuery = errors.New("invalid vehicle query")
+	ErrVehicleUnavailable  = errors.New("vehicle is not available")
+	ErrVehicleMismatch     = errors.New("application does not match the vehicle catalog")
+)
+
+const vehicleStatusAvailable = "AVAILABLE"
+
+const (
+	vehicleSortName      = "NAME"
+	vehicleSortPriceAsc  = "PRICE_ASC"
+	vehicleSortPriceDesc = "PRICE_DESC"
+)
+
+type pricedVehicle struct {
+	vehicle dto.Vehicle
+	price   money.Amount // in the currency prices are compared in
+}
+
+// ListVehicles returns a page of the cached vehicle catalog matching query,
+// and the number of vehicles that match. Prices are compared in the currency
+// of the price range or, without one, in query.CurrencyCode; vehicles priced
+// in another currency are converted at the current rate. When there is no
+// such currency, sorting by price orders vehicles by currency first.
+func (uc *LoanUsecase) ListVehicles(ctx context.Context, query dto.VehicleQuery, limit, offset int32) ([]dto.Vehicle, int64, error) {
+	if query.Sort == "" {
+		query.Sort = vehicleSortName
+	}
+
+	if err := validateVehicleQuery(query); err != nil {
+		return nil, 0, err
+	}
+
+	vehicles, err := uc.vehicleCatalog.Vehicles(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	priceCurrency := cmp.Or(query.MinPrice.Currency, query.MaxPrice.Currency, query.CurrencyCode)
+	search := strings.ToLower(strings.TrimSpace(query.Search))
+
+	// Rates are looked up once per currency.
+	rates := make(map[string]float64)
+	matched := make([]pricedVehicle, 0, len(vehicles))
+	for _, vehicle := range vehicles {
+		if query.EngineType != "" && !strings.EqualFold(vehicle.EngineType, query.EngineType) {
+			continue
+		}
+		if query.CurrencyCode != "" && vehicle.CurrencyCode != query.CurrencyCode {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(vehicle.Name), search) {
+			continue
+		}
+
+		price := vehicle.Price
+		if priceCurrency != "" && vehicle.CurrencyCode != priceCurrency {
+			rate, ok := rates[vehicle.CurrencyCode]
+			if !ok {
+				rate, err = uc.rates.Rate(ctx, vehicle.CurrencyCode, priceCurrency, time.Now())
+				if err != nil {
+					return nil, 0, err
+				}
+				rates[vehicle.CurrencyCode] = rate
+			}
+			price = money.New(vehicle.Price, vehicle.CurrencyCode).Convert(priceCurrency, rate).Amount
+		}
+
+		if query.MinPrice.Amount > 0 && price < query.MinPrice.Amount {
+			continue
+		}
+		if query.MaxPrice.Amount > 0 && price > query.MaxPrice.Amount {
+			continue
+		}
+
+		matched = append(matched, pricedVehicle{vehicle: vehicle, price: price})
+	}
+
+	byCurrency := priceCurrency == ""
+	slices.SortStableFunc(matched, func(a, b pricedVehicle) int {
+		if query.Sort == vehicleSortName {
+			return cmp.Or(cmp.Compare(strings.ToLower(a.vehicle.Name), strings.ToLower(b.vehicle.Name)), cmp.Compare(a.vehicle.Vin, b.vehicle.Vin))
+		}
+
+		if byCurrency {
+			if order := cmp.Compare(a.vehicle.CurrencyCode, b.vehicle.CurrencyCode); order != 0 {
+				return order
+			}
+		}
+
+		if query.Sort == vehicleSortPriceDesc {
+			return cmp.Compare(b.price, a.price)
+		}
+		return cmp.Compare(a.price, b.price)
+	})
+
+	total := int64(len(matched))
+	start := min(int(offset), len(matched))
+	end := min(start+int(limit), len(matched))
+
+	page := make([]dto.Vehicle, 0, end-start)
+	for _, match := range matched[start:end] {
+		page = append(page, match.vehicle)
+	}
+
+	return page, total, nil
+}
+
+func validateVehicleQuery(query dto.VehicleQuery) error {
+	switch query.Sort {
+	case vehicleSortName, vehicleSortPriceAsc, vehicleSortPriceDesc:
+	default:
+		return fmt.Errorf("%w: unknown sort %q, expected %s, %s or %s", ErrInvalidVehicleQuery, query.Sort, vehicleSortName, vehicleSortPriceAsc, vehicleSortPriceDesc)
+	}
+
+	if query.MinPrice.Amount < 0 || query.MaxPrice.Amount < 0 {
+		return fmt.Errorf("%w: price range must not be negative", ErrInvalidVehicleQuery)
+	}
+
+	if query.MinPrice.Currency != "" && query.MaxPrice.Currency != "" && query.MinPrice.Currency != query.MaxPrice.Currency {
+		return fmt.Errorf("%w: min price is in %s, max price in %s", ErrInvalidVehicleQuery, query.MinPrice.Currency, query.MaxPrice.Currency)
+	}
+
+	if query.MaxPrice.Amount > 0 && query.MinPrice.Amount > query.MaxPrice.Amount {
+		return fmt.Errorf("%w: min price %s is above max price %s", ErrInvalidVehicleQuery, query.MinPrice, query.MaxPrice)
+	}
+
+	return nil
+}
+
+// GetVehicle returns the vehicle with the VIN as Koinot Auto currently lists
+// it. Unlike ListVehicles it bypasses the catalog cache, so availability is
+// up to date.
+func (uc *LoanUsecase) GetVehicle(ctx context.Context, vinCode string) (*dto.Vehicle, error) {
+	if err := vin.Validate(vinCode); err != nil {
+		return nil, err
+	}
+
+	return uc.koinotAutoClient.GetVehicle(ctx, vin.Normalize(vinCode))
+}
+
+// verifyVehicle checks an AUTO application against Koinot Auto: the vehicle
+// must be listed and available, and the application must carry its price in
+// its currency. The VIN and name of the application are taken from the
+// catalog.
+func (uc *LoanUsecase) verifyVehicle(ctx context.Context, loanApp *dto.LoanApplication) error {
+	vehicle, err := uc.GetVehicle(ctx, loanApp.VehicleVin)
+	if err != nil {
+		return err
+	}
+
+	// Dealers that do not report availability list available vehicles only.
+	if vehicle.Status != "" && vehicle.Status != vehicleStatusAvailable {
+		return fmt.Errorf("%w: %s is %s", ErrVehicleUnavailable, vehicle.Vin, vehicle.Status)
+	}
+
+	if loanApp.VehicleCurrencyCode != vehicle.CurrencyCode || loanApp.VehiclePrice != vehicle.Price {
+		return fmt.Errorf("%w: price %s, catalog price of %s is %s", ErrVehicleMismatch,
+			money.New(loanApp.VehiclePrice, loanApp.VehicleCurrencyCode), vehicle.Vin, money.New(vehicle.Price, vehicle.CurrencyCode))
+	}
+
+	loanApp.VehicleVin = vehicle.Vin
+	loanApp.VehicleName = vehicle.Name
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"loan_service/configs"
+	"loan_service/internal/usecase"
+	"log"
+	"time"
+)
+
+// DealerNotifier delivers new applications to the Koinot Auto dealer. Every
+// pass works through the applications that are due batch by batch.
+type DealerNotifier struct {
+	loanUC        *usecase.LoanUsecase
+	interval      time.Duration
+	batchSize     int32
+	retryDelay    time.Duration
+	maxRetryDelay time.Duration
+	maxAttempts   int32
+}
+
+func NewDealerNotifier(loanUC *usecase.LoanUsecase, cfg configs.DealerNotifierConfig) (*DealerNotifier, error) {
+	interval, err := time.ParseDuration(cfg.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid interval format for dealer notifier: %w", err)
+	}
+
+	retryDelay, err := time.ParseDuration(cfg.RetryDelay)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid retry delay format for dealer notifier: %w", err)
+	}
+
+	maxRetryDelay, err := time.ParseDuration(cfg.MaxRetryDelay)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid max retry delay format for dealer notifier: %w", err)
+	}
+
+	if cfg.BatchSize <= 0 {
+		return nil, fmt.Errorf("dealer notifier batch size must be positive, got %d", cfg.BatchSize)
+	}
+
+	if cfg.MaxAttempts <= 0 {
+		return nil, fmt.Errorf("dealer notifier max attempts must be positive, got %d", cfg.MaxAttempts)
+	}
+
+	return &DealerNotifier{
+		loanUC:        loanUC,
+		interval:      interval,
+		batchSize:     cfg.BatchSize,
+		retryDelay:    retryDelay,
+		maxRetryDelay: maxRetryDelay,
+		maxAttempts:   cfg.MaxAttempts,
+	}, nil
+}
+
+// Run performs a pass right away and then one per interval until ctx is done.
+func (n *DealerNotifier) Run(ctx context.Context) {
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+
+	for {
+		n.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (n *DealerNotifier) runOnce(ctx context.Context) {
+	for ctx.Err() == nil {
+		sent, failed, err := n.loanUC.NotifyDealers(ctx, n.batchSize, n.maxAttempts, n.retryDelay, n.maxRetryDelay)
+		if err != nil {
+			log.Printf("Dealer notifier failed: %s", err)
+			return
+		}
+		if sent > 0 || failed > 0 {
+			log.Printf("Sent %d applications to Koinot Auto, %d failed", sent, failed)
+		}
+		if sent+failed < int(n.batchSize) {
+			return
+		}
+	}
+}
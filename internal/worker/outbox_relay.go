@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"loan_service/configs"
+	"loan_service/internal/usecase"
+	"log"
+	"time"
+)
+
+// OutboxRelay publishes the events stored in the outbox to the message
+// broker. Every pass drains the outbox batch by batch until it is empty or a
+// publish fails.
+type OutboxRelay struct {
+	loanUC        *usecase.LoanUsecase
+	publisher     usecase.EventPublisher
+	interval      time.Duration
+	batchSize     int32
+	retryDelay    time.Duration
+	maxRetryDelay time.Duration
+}
+
+func NewOutboxRelay(loanUC *usecase.LoanUsecase, publisher usecase.EventPublisher, cfg configs.OutboxRelayConfig) (*OutboxRelay, error) {
+	interval, err := time.ParseDuration(cfg.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid interval format for outbox relay: %w", err)
+	}
+
+	retryDelay, err := time.ParseDuration(cfg.RetryDelay)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid retry delay format for outbox relay: %w", err)
+	}
+
+	maxRetryDelay, err := time.ParseDuration(cfg.MaxRetryDelay)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid max retry delay format for outbox relay: %w", err)
+	}
+
+	if cfg.BatchSize <= 0 {
+		return nil, fmt.Errorf("outbox relay batch size must be positive, got %d", cfg.BatchSize)
+	}
+
+	return &OutboxRelay{
+		loanUC:        loanUC,
+		publisher:     publisher,
+		interval:      interval,
+		batchSize:     cfg.BatchSize,
+		retryDelay:    retryDelay,
+		maxRetryDelay: maxRetryDelay,
+	}, nil
+}
+
+// Run performs a pass right away and then one per interval until ctx is done.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *OutboxRelay) runOnce(ctx context.Context) {
+	for ctx.Err() == nil {
+		published, err := r.loanUC.RelayOutbox(ctx, r.publisher, r.batchSize, r.retryDelay, r.maxRetryDelay)
+		if err != nil {
+			log.Printf("Outbox relay failed: %s", err)
+			return
+		}
+		if published > 0 {
+			log.Printf("Published %d outbox events", published)
+		}
+		if published < int(r.batchSize) {
+			return
+		}
+	}
+}
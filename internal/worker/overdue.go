@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"loan_service/configs"
+	"loan_service/internal/usecase"
+	"log"
+	"time"
+)
+
+// OverdueWorker periodically moves loans with missed installments to OVERDUE
+// and back to ACTIVE once their arrears are cleared, and accrues the daily
+// late-payment charges of overdue loans.
+type OverdueWorker struct {
+	loanUC   *usecase.LoanUsecase
+	interval time.Duration
+}
+
+func NewOverdueWorker(loanUC *usecase.LoanUsecase, cfg configs.WorkerConfig) (*OverdueWorker, error) {
+	interval, err := time.ParseDuration(cfg.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid interval format for overdue worker: %w", err)
+	}
+
+	return &OverdueWorker{
+		loanUC:   loanUC,
+		interval: interval,
+	}, nil
+}
+
+// Run performs a pass right away and then one per interval until ctx is done.
+func (w *OverdueWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *OverdueWorker) runOnce(ctx context.Context) {
+	overdue, restored, err := w.loanUC.RefreshOverdueLoans(ctx, time.Now())
+	if err != nil {
+		log.Printf("Overdue worker failed: %s", err)
+		return
+	}
+
+	for _, loan := range overdue {
+		log.Printf("Loan %d is overdue by %d days", loan.Id, loan.DaysPastDue)
+	}
+	for _, loan := range restored {
+		log.Printf("Loan %d is back to ACTIVE", loan.Id)
+	}
+
+	accrued, err := w.loanUC.AccruePenalties(ctx, time.Now())
+	if err != nil {
+		log.Printf("Penalty accrual failed: %s", err)
+		return
+	}
+	if accrued > 0 {
+		log.Printf("Accrued %d late-payment charges", accrued)
+	}
+}
@@ -0,0 +1,73 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"loan_service/configs"
+	"loan_service/internal/usecase"
+	"loan_service/pkg/money"
+	"log"
+	"time"
+)
+
+// ReconciliationWorker periodically compares loans with their contracts in
+// ASR Leasing and records the discrepancies it finds.
+type ReconciliationWorker struct {
+	loanUC      *usecase.LoanUsecase
+	interval    time.Duration
+	batchSize   int32
+	autoCorrect bool
+	tolerance   money.Amount
+}
+
+func NewReconciliationWorker(loanUC *usecase.LoanUsecase, cfg configs.ReconciliationConfig) (*ReconciliationWorker, error) {
+	interval, err := time.ParseDuration(cfg.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid interval format for reconciliation worker: %w", err)
+	}
+
+	if cfg.BatchSize <= 0 {
+		return nil, fmt.Errorf("reconciliation batch size must be positive, got %d", cfg.BatchSize)
+	}
+
+	if cfg.Tolerance < 0 {
+		return nil, fmt.Errorf("reconciliation tolerance must not be negative, got %v", cfg.Tolerance)
+	}
+
+	return &ReconciliationWorker{
+		loanUC:      loanUC,
+		interval:    interval,
+		batchSize:   cfg.BatchSize,
+		autoCorrect: cfg.AutoCorrect,
+		tolerance:   money.FromFloat(cfg.Tolerance),
+	}, nil
+}
+
+// Run performs a pass right away and then one per interval until ctx is done.
+func (w *ReconciliationWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *ReconciliationWorker) runOnce(ctx context.Context) {
+	run, err := w.loanUC.ReconcileLoans(ctx, w.batchSize, w.autoCorrect, w.tolerance)
+	if err != nil {
+		log.Printf("Reconciliation failed: %s", err)
+	}
+	if run == nil {
+		return
+	}
+
+	log.Printf("Reconciliation run %d: %d loans checked, %d issues found, %d corrected, %d failed",
+		run.Id, run.LoansChecked, run.IssuesFound, run.Corrected, run.Failed)
+}
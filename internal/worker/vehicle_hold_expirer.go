@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"loan_service/configs"
+	"loan_service/internal/usecase"
+	"log"
+	"time"
+)
+
+// VehicleHoldExpirer periodically marks the vehicle holds Koinot Auto has let
+// lapse as EXPIRED.
+type VehicleHoldExpirer struct {
+	loanUC   *usecase.LoanUsecase
+	interval time.Duration
+}
+
+func NewVehicleHoldExpirer(loanUC *usecase.LoanUsecase, cfg configs.WorkerConfig) (*VehicleHoldExpirer, error) {
+	interval, err := time.ParseDuration(cfg.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid interval format for vehicle hold expirer: %w", err)
+	}
+
+	return &VehicleHoldExpirer{
+		loanUC:   loanUC,
+		interval: interval,
+	}, nil
+}
+
+// Run performs a pass right away and then one per interval until ctx is done.
+func (w *VehicleHoldExpirer) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.runOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *VehicleHoldExpirer) runOnce(ctx context.Context) {
+	expired, err := w.loanUC.ExpireVehicleHolds(ctx)
+	if err != nil {
+		log.Printf("Vehicle hold expirer failed: %s", err)
+		return
+	}
+
+	if expired > 0 {
+		log.Printf("Expired %d vehicle holds", expired)
+	}
+}
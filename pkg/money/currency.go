@@ -0,0 +1,116 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+)
+
+// RoundingMode decides which way a value exactly halfway between two
+// representable amounts goes.
+type RoundingMode string
+
+const (
+	// HalfUp rounds halves away from zero: 2.5 → 3.
+	HalfUp RoundingMode = "HALF_UP"
+	// HalfEven rounds halves to the even neighbour (banker's rounding): 2.5 → 2.
+	HalfEven RoundingMode = "HALF_EVEN"
+)
+
+// Currency holds the rounding rules of a currency.
+type Currency struct {
+	Code string
+	// MinorUnits is the number of decimal places amounts in the currency are
+	// rounded to. It cannot exceed Scale.
+	MinorUnits int
+	Rounding   RoundingMode
+}
+
+var (
+	ErrUnknownCurrency = errors.New("unknown currency")
+	// ErrUnsupportedCurrency is returned for ISO 4217 currencies with more
+	// decimal places than amounts are kept with, such as BHD or KWD.
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+)
+
+var (
+	mu         sync.RWMutex
+	currencies = map[string]Currency{}
+)
+
+// Validate checks that code is an active ISO 4217 currency code whose
+// amounts fit in Scale decimal places.
+func Validate(code string) error {
+	units, ok := iso4217[code]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnknownCurrency, code)
+	}
+
+	if units > Scale {
+		return fmt.Errorf("%w %s: it has %d decimal places, amounts are kept with %d", ErrUnsupportedCurrency, code, units, Scale)
+	}
+
+	return nil
+}
+
+// Register adds the rules of a currency or replaces the existing ones.
+func Register(currency Currency) error {
+	if err := Validate(currency.Code); err != nil {
+		return err
+	}
+
+	if currency.MinorUnits < 0 || currency.MinorUnits > Scale {
+		return fmt.Errorf("currency %s: minor units must be between 0 and %d, got %d", currency.Code, Scale, currency.MinorUnits)
+	}
+
+	switch currency.Rounding {
+	case "":
+		currency.Rounding = HalfUp
+	case HalfUp, HalfEven:
+	default:
+		return fmt.Errorf("currency %s: unknown rounding mode %q", currency.Code, currency.Rounding)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	currencies[currency.Code] = currency
+	return nil
+}
+
+// Lookup returns the rules registered for code. Other currencies Validate
+// accepts are rounded half up to their standard minor units. Codes Validate
+// refuses, unknown ones and those with more decimal places than Scale alike,
+// have no rules: they are rounded half up to Scale decimal places, and
+// amounts in them must be refused before they get here.
+func Lookup(code string) Currency {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if currency, ok := currencies[code]; ok {
+		return currency
+	}
+
+	if err := Validate(code); err != nil {
+		return Currency{Code: code, MinorUnits: Scale, Rounding: HalfUp}
+	}
+
+	return Currency{Code: code, MinorUnits: iso4217[code], Rounding: HalfUp}
+}
+
+// Round turns v, a value in hundredths of the major unit, into an Amount
+// rounded to the currency's minor units with its rounding mode.
+func (c Currency) Round(v float64) Amount {
+	step := math.Pow10(Scale - c.MinorUnits)
+
+	var steps float64
+	switch c.Rounding {
+	case HalfEven:
+		steps = math.RoundToEven(v / step)
+	default:
+		steps = math.Round(v / step)
+	}
+
+	return Amount(steps * step)
+}
@@ -0,0 +1,92 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCurrencyRound(t *testing.T) {
+	tests := []struct {
+		name     string
+		currency Currency
+		in       float64
+		want     Amount
+	}{
+		{"half up below half", Currency{"TJS", 2, HalfUp}, 1234.4, 1234},
+		{"half up at half", Currency{"TJS", 2, HalfUp}, 1234.5, 1235},
+		{"half up at even half", Currency{"TJS", 2, HalfUp}, 1235.5, 1236},
+		{"half up negative half", Currency{"TJS", 2, HalfUp}, -1234.5, -1235},
+		{"half even at odd half", Currency{"TJS", 2, HalfEven}, 1234.5, 1234},
+		{"half even at even half", Currency{"TJS", 2, HalfEven}, 1235.5, 1236},
+		{"half even above half", Currency{"TJS", 2, HalfEven}, 1234.51, 1235},
+		{"half even negative half", Currency{"TJS", 2, HalfEven}, -1234.5, -1234},
+		{"no minor units half up", Currency{"JPY", 0, HalfUp}, 12350, 12400},
+		{"no minor units half even", Currency{"JPY", 0, HalfEven}, 12250, 12200},
+		{"no minor units below half", Currency{"JPY", 0, HalfEven}, 12349.99, 12300},
+		{"one minor unit half up", Currency{"TJS", 1, HalfUp}, 125, 130},
+		{"one minor unit half even", Currency{"TJS", 1, HalfEven}, 125, 120},
+		{"default mode", Currency{"TJS", 2, ""}, 0.5, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.currency.Round(tt.in); got != tt.want {
+				t.Errorf("Round(%g) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, code := range []string{"TJS", "USD", "JPY", "RUB"} {
+		if err := Validate(code); err != nil {
+			t.Errorf("Validate(%s): %v", code, err)
+		}
+	}
+
+	if err := Validate("XYZ"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Validate(XYZ) error = %v, want %v", err, ErrUnknownCurrency)
+	}
+	if err := Validate("KWD"); !errors.Is(err, ErrUnsupportedCurrency) {
+		t.Errorf("Validate(KWD) error = %v, want %v", err, ErrUnsupportedCurrency)
+	}
+}
+
+func TestRegisterAndLookup(t *testing.T) {
+	t.Cleanup(func() {
+		mu.Lock()
+		delete(currencies, "CHF")
+		mu.Unlock()
+	})
+
+	if got := Lookup("CHF"); got != (Currency{"CHF", 2, HalfUp}) {
+		t.Errorf("Lookup(CHF) before Register = %+v, want the ISO 4217 defaults", got)
+	}
+
+	if err := Register(Currency{Code: "CHF", MinorUnits: 2, Rounding: HalfEven}); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if got := Lookup("CHF"); got.Rounding != HalfEven {
+		t.Errorf("Lookup(CHF) = %+v, want HALF_EVEN", got)
+	}
+
+	if got := Lookup("JPY"); got.MinorUnits != 0 {
+		t.Errorf("Lookup(JPY) has %d minor units, want 0", got.MinorUnits)
+	}
+	if got := Lookup("XYZ"); got != (Currency{"XYZ", Scale, HalfUp}) {
+		t.Errorf("Lookup(XYZ) = %+v, want rounding to Scale", got)
+	}
+
+	rejected := []Currency{
+		{Code: "XYZ", MinorUnits: 2},
+		{Code: "KWD", MinorUnits: 2},
+		{Code: "CHF", MinorUnits: 3},
+		{Code: "CHF", MinorUnits: -1},
+		{Code: "CHF", MinorUnits: 2, Rounding: "HALF_DOWN"},
+	}
+	for _, currency := range rejected {
+		if err := Register(currency); err == nil {
+			t.Errorf("Register(%+v) succeeded", currency)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Scale is the number of decimal places amounts are kept with. It matches
+// the NUMERIC(18,2) columns amounts are stored in.
+const Scale = 2
+
+const scaleFactor = 100
+
+// Amount is a sum of money in hundredths of the major currency unit, so
+// 123456 is 1234.56. Keeping amounts as integers means sums never drift the
+// way float64 did.
+type Amount int64
+
+// FromMajor converts whole currency units to an Amount.
+func FromMajor(units int64) Amount {
+	return Amount(units * scaleFactor)
+}
+
+// Major returns the amount in whole major units, rounded half away from zero.
+// It only feeds the deprecated whole-unit fields of the API.
+func (a Amount) Major() int64 {
+	return int64(math.Round(float64(a) / scaleFactor))
+}
+
+// FromFloat rounds a float number of major units half away from zero.
+func FromFloat(units float64) Amount {
+	return Amount(math.Round(units * scaleFactor))
+}
+
+// Float returns the amount in major units. It is only meant for ratios and
+// rates, never for storing money.
+func (a Amount) Float() float64 {
+	return float64(a) / scaleFactor
+}
+
+// String formats the amount as a plain decimal, e.g. "-1234.50".
+func (a Amount) String() string {
+	sign := ""
+	v := int64(a)
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, v/scaleFactor, v%scaleFactor)
+}
+
+// ParseAmount parses a plain decimal with at most Scale fractional digits.
+func ParseAmount(s string) (Amount, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+
+	r.Mul(r, big.NewRat(scaleFactor, 1))
+	if !r.IsInt() {
+		return 0, fmt.Errorf("amount %q has more than %d decimal places", s, Scale)
+	}
+	if !r.Num().IsInt64() {
+		return 0, fmt.Errorf("amount %q is out of range", s)
+	}
+
+	return Amount(r.Num().Int64()), nil
+}
+
+// MarshalJSON writes the amount as a JSON number of major units, which is
+// how partner systems exchange prices.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON reads a JSON number of major units.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+
+	amount, err := ParseAmount(s)
+	if err != nil {
+		return err
+	}
+
+	*a = amount
+	return nil
+}
+
+// ScanNumeric implements pgtype.NumericScanner.
+func (a *Amount) ScanNumeric(v pgtype.Numeric) error {
+	if !v.Valid {
+		return fmt.Errorf("cannot scan NULL into %T", a)
+	}
+	if v.NaN || v.InfinityModifier != pgtype.Finite {
+		return fmt.Errorf("cannot scan non-finite numeric into %T", a)
+	}
+
+	r := new(big.Rat).SetInt(v.Int)
+	exp := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(v.Exp+Scale))), nil)
+	if v.Exp+Scale >= 0 {
+		r.Mul(r, new(big.Rat).SetInt(exp))
+	} else {
+		r.Quo(r, new(big.Rat).SetInt(exp))
+	}
+
+	if !r.IsInt() || !r.Num().IsInt64() {
+		return fmt.Errorf("numeric %s does not fit %T", r.FloatString(Scale), a)
+	}
+
+	*a = Amount(r.Num().Int64())
+	return nil
+}
+
+// NumericValue implements pgtype.NumericValuer.
+func (a Amount) NumericValue() (pgtype.Numeric, error) {
+	return pgtype.Numeric{
+		Int:   big.NewInt(int64(a)),
+		Exp:   -Scale,
+		Valid: true,
+	}, nil
+}
+
+func abs(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// Money is an amount in a specific currency.
+type Money struct {
+	Amount   Amount
+	Currency string
+}
+
+func New(amount Amount, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+func (m Money) String() string {
+	return m.Amount.String() + " " + m.Currency
+}
+
+// Convert turns m into currency to at rate units of to per unit of m's
+// currency, rounded with the rules of to.
+func (m Money) Convert(to string, rate float64) Money {
+	if m.Currency == to {
+		return m
+	}
+
+	return New(Lookup(to).Round(float64(m.Amount)*rate), to)
+}
@@ -0,0 +1,203 @@
+package money
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Amount
+	}{
+		{"0", 0},
+		{"1234.56", 123456},
+		{"1234.5", 123450},
+		{"1234.50", 123450},
+		{"1234", 123400},
+		{" 12.30 ", 1230},
+		{"-0.01", -1},
+		{"-1234.56", -123456},
+		{"0.000", 0},
+		{"1.230", 123},
+		{"92233720368547758.07", 9223372036854775807},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseAmount(tt.in)
+		if err != nil {
+			t.Errorf("ParseAmount(%q): %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseAmount(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseAmountRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"three decimals", "1.234"},
+		{"many decimals", "0.0001"},
+		{"negative with three decimals", "-12.005"},
+		{"fraction", "1/3"},
+		{"empty", ""},
+		{"not a number", "abc"},
+		{"grouped", "1,234.56"},
+		{"out of range", "92233720368547758.08"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := ParseAmount(tt.in); err == nil {
+				t.Errorf("ParseAmount(%q) = %d, want an error", tt.in, got)
+			}
+		})
+	}
+}
+
+func TestAmountString(t *testing.T) {
+	tests := []struct {
+		in   Amount
+		want string
+	}{
+		{0, "0.00"},
+		{5, "0.05"},
+		{123450, "1234.50"},
+		{-1, "-0.01"},
+		{-123456, "-1234.56"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.String(); got != tt.want {
+			t.Errorf("Amount(%d).String() = %q, want %q", int64(tt.in), got, tt.want)
+		}
+	}
+}
+
+func TestAmountMajor(t *testing.T) {
+	tests := []struct {
+		in   Amount
+		want int64
+	}{
+		{123449, 1234},
+		{123450, 1235},
+		{-123450, -1235},
+		{FromMajor(42), 42},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.Major(); got != tt.want {
+			t.Errorf("Amount(%s).Major() = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+
+	if got := FromFloat(0.125); got != 13 {
+		t.Errorf("FromFloat(0.125) = %s, want 0.13", got)
+	}
+}
+
+func TestAmountJSON(t *testing.T) {
+	body, err := json.Marshal(struct{ Price Amount }{123450})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(body) != `{"Price":1234.50}` {
+		t.Errorf("Marshal = %s, want {\"Price\":1234.50}", body)
+	}
+
+	tests := []struct {
+		in   string
+		want Amount
+	}{
+		{`1234.5`, 123450},
+		{`"1234.56"`, 123456},
+		{`7`, 700},
+	}
+
+	for _, tt := range tests {
+		var a Amount
+		if err := json.Unmarshal([]byte(tt.in), &a); err != nil {
+			t.Errorf("Unmarshal(%s): %v", tt.in, err)
+			continue
+		}
+		if a != tt.want {
+			t.Errorf("Unmarshal(%s) = %s, want %s", tt.in, a, tt.want)
+		}
+	}
+
+	var a Amount
+	if err := json.Unmarshal([]byte(`1.005`), &a); err == nil {
+		t.Errorf("Unmarshal(1.005) = %s, want an error", a)
+	}
+}
+
+func TestAmountNumeric(t *testing.T) {
+	tests := []struct {
+		in   pgtype.Numeric
+		want Amount
+	}{
+		{pgtype.Numeric{Int: big.NewInt(123456), Exp: -2, Valid: true}, 123456},
+		{pgtype.Numeric{Int: big.NewInt(12345), Exp: -1, Valid: true}, 123450},
+		{pgtype.Numeric{Int: big.NewInt(12), Exp: 2, Valid: true}, 120000},
+		{pgtype.Numeric{Int: big.NewInt(1234500), Exp: -4, Valid: true}, 12345},
+	}
+
+	for _, tt := range tests {
+		var a Amount
+		if err := a.ScanNumeric(tt.in); err != nil {
+			t.Errorf("ScanNumeric(%s e%d): %v", tt.in.Int, tt.in.Exp, err)
+			continue
+		}
+		if a != tt.want {
+			t.Errorf("ScanNumeric(%s e%d) = %s, want %s", tt.in.Int, tt.in.Exp, a, tt.want)
+		}
+
+		n, err := a.NumericValue()
+		if err != nil {
+			t.Fatalf("NumericValue: %v", err)
+		}
+		var back Amount
+		if err := back.ScanNumeric(n); err != nil || back != a {
+			t.Errorf("round trip of %s = %s, %v", a, back, err)
+		}
+	}
+
+	rejected := []pgtype.Numeric{
+		{},
+		{Int: big.NewInt(12345), Exp: -3, Valid: true},
+		{NaN: true, Valid: true},
+	}
+	for _, n := range rejected {
+		var a Amount
+		if err := a.ScanNumeric(n); err == nil {
+			t.Errorf("ScanNumeric(%+v) = %s, want an error", n, a)
+		}
+	}
+}
+
+func TestMoneyConvert(t *testing.T) {
+	tests := []struct {
+		from Money
+		to   string
+		rate float64
+		want Money
+	}{
+		{New(100000, "USD"), "TJS", 10.95, New(1095000, "TJS")},
+		{New(1095000, "TJS"), "USD", 1 / 10.95, New(100000, "USD")},
+		{New(100000, "USD"), "JPY", 151.234, New(15123400, "JPY")},
+		{New(100000, "TJS"), "TJS", 2, New(100000, "TJS")},
+	}
+
+	for _, tt := range tests {
+		if got := tt.from.Convert(tt.to, tt.rate); got != tt.want {
+			t.Errorf("%s.Convert(%s, %g) = %s, want %s", tt.from, tt.to, tt.rate, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Package vin validates vehicle identification numbers (ISO 3779).
+package vin
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidVin = errors.New("invalid VIN")
+
+// Length is the number of characters of a VIN.
+const Length = 17
+
+// checkDigitPosition is the index of the check digit within a VIN.
+const checkDigitPosition = 8
+
+// transliteration gives the value of every character allowed in a VIN. I, O
+// and Q are not used, as they are easily mistaken for 1 and 0.
+var transliteration = map[rune]int{
+	'0': 0, '1': 1, '2': 2, '3': 3, '4': 4, '5': 5, '6': 6, '7': 7, '8': 8, '9': 9,
+	'A': 1, 'B': 2, 'C': 3, 'D': 4, 'E': 5, 'F': 6, 'G': 7, 'H': 8,
+	'J': 1, 'K': 2, 'L': 3, 'M': 4, 'N': 5, 'P': 7, 'R': 9,
+	'S': 2, 'T': 3, 'U': 4, 'V': 5, 'W': 6, 'X': 7, 'Y': 8, 'Z': 9,
+}
+
+// weights are the weights of the positions in the check digit sum; the check
+// digit itself weighs 0.
+var weights = [Length]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// Normalize upper-cases vin and strips surrounding spaces.
+func Normalize(vin string) string {
+	return strings.ToUpper(strings.TrimSpace(vin))
+}
+
+// Validate checks that vin, once normalized, is 17 characters long, uses only
+// the characters allowed in a VIN and carries a correct check digit in its
+// ninth position.
+func Validate(vin string) error {
+	vin = Normalize(vin)
+	if len(vin) != Length {
+		return fmt.Errorf("%w: %q must be %d characters long", ErrInvalidVin, vin, Length)
+	}
+
+	var sum int
+	for position, char := range vin {
+		value, ok := transliteration[char]
+		if !ok {
+			return fmt.Errorf("%w: %q contains %q", ErrInvalidVin, vin, char)
+		}
+		sum += value * weights[position]
+	}
+
+	if expected := checkDigit(sum); rune(vin[checkDigitPosition]) != expected {
+		return fmt.Errorf("%w: %q has check digit %c, expected %c", ErrInvalidVin, vin, vin[checkDigitPosition], expected)
+	}
+
+	return nil
+}
+
+// checkDigit is the check digit of a VIN whose weighted sum is sum: the
+// remainder of its division by 11, with 10 written as X.
+func checkDigit(sum int) rune {
+	remainder := sum % 11
+	if remainder == 10 {
+		return 'X'
+	}
+	return rune('0' + remainder)
+}
@@ -0,0 +1,51 @@
+package vin
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	valid := []string{
+		"1M8GDM9AXKP042788",
+		"11111111111111111",
+		"5GZCZ43D13S812715",
+		" 1m8gdm9axkp042788 ",
+	}
+
+	for _, vin := range valid {
+		if err := Validate(vin); err != nil {
+			t.Errorf("Validate(%q): %v", vin, err)
+		}
+	}
+}
+
+func TestValidateRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		vin  string
+	}{
+		{"empty", ""},
+		{"too short", "1M8GDM9AXKP04278"},
+		{"too long", "1M8GDM9AXKP0427880"},
+		{"wrong check digit", "1M8GDM9A1KP042788"},
+		{"letter I", "1M8GDM9AXKI042788"},
+		{"letter O", "1M8GDM9AXKO042788"},
+		{"letter Q", "1M8GDM9AXKQ042788"},
+		{"inner space", "1M8GDM9AX P042788"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(tt.vin); !errors.Is(err, ErrInvalidVin) {
+				t.Errorf("Validate(%q) error = %v, want %v", tt.vin, err, ErrInvalidVin)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	if got := Normalize("  jtdbr32e720123456\t"); got != "JTDBR32E720123456" {
+		t.Errorf("Normalize = %q, want JTDBR32E720123456", got)
+	}
+}